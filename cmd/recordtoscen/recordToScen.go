@@ -0,0 +1,98 @@
+// Converts a recorded smart contract call into a self-contained scenario test.
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/multiversx/mx-chain-vm-go/replay"
+	cli "github.com/urfave/cli/v2"
+)
+
+func main() {
+	app := &cli.App{
+		Name:      "recordtoscen",
+		Usage:     "converts a recorded smart contract call into a .scen.json regression test",
+		ArgsUsage: "<record.json>",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "output",
+				Usage: "path of the generated scenario, defaults to the record path with the .scen.json extension",
+			},
+			&cli.StringFlag{
+				Name:  "name",
+				Usage: "name of the generated scenario",
+			},
+			&cli.StringFlag{
+				Name:  "code",
+				Usage: "wasm file used as the code of the called contract, when the code was not read during recording",
+			},
+		},
+		Action: runConversion,
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func runConversion(cCtx *cli.Context) error {
+	if cCtx.NArg() != 1 {
+		return fmt.Errorf("expected exactly one record file, got %d arguments", cCtx.NArg())
+	}
+
+	recordPath := cCtx.Args().First()
+	record, err := replay.LoadExecutionRecord(recordPath)
+	if err != nil {
+		return err
+	}
+	if record.Output == nil {
+		return fmt.Errorf("the record holds no VMOutput, execution failed with: %s", record.Error)
+	}
+
+	preState, err := replay.PreStateFromRecord(record)
+	if err != nil {
+		return err
+	}
+
+	if len(cCtx.String("code")) > 0 {
+		code, errRead := os.ReadFile(cCtx.String("code"))
+		if errRead != nil {
+			return errRead
+		}
+		recipient := preState.FindAccount(record.Input.RecipientAddr)
+		if recipient == nil {
+			recipient = &replay.AccountPreState{Address: record.Input.RecipientAddr}
+			preState.Accounts = append(preState.Accounts, recipient)
+		}
+		recipient.Code = code
+	}
+
+	baseName := strings.TrimSuffix(filepath.Base(recordPath), filepath.Ext(recordPath))
+	name := cCtx.String("name")
+	if len(name) == 0 {
+		name = baseName
+	}
+
+	scenario, err := replay.NewScenarioFromExecution(name, record.Input, preState, record.Output.ToVMOutput())
+	if err != nil {
+		return err
+	}
+
+	outputPath := cCtx.String("output")
+	if len(outputPath) == 0 {
+		outputPath = filepath.Join(filepath.Dir(recordPath), baseName+".scen.json")
+	}
+
+	err = replay.WriteScenarioFile(scenario, outputPath)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("scenario written to %s\n", outputPath)
+	return nil
+}
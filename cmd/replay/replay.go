@@ -0,0 +1,205 @@
+// Re-executes a recorded smart contract call and reports the differences from the recorded result.
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-common-go/parsers"
+	"github.com/multiversx/mx-chain-vm-go/config"
+	"github.com/multiversx/mx-chain-vm-go/eventabi"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/multiversx/mx-chain-vm-go/replay"
+	vmscenario "github.com/multiversx/mx-chain-vm-go/scenario"
+	gasSchedules "github.com/multiversx/mx-chain-vm-go/scenario/gasSchedules"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/hostCore"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/mock"
+	"github.com/multiversx/mx-chain-vm-go/wasmer"
+	"github.com/multiversx/mx-chain-vm-go/wasmer2"
+	cli "github.com/urfave/cli/v2"
+)
+
+const blockGasLimit = uint64(10000000)
+
+func main() {
+	app := &cli.App{
+		Name:      "replay",
+		Usage:     "re-executes a recorded smart contract call and reports the differences from the recorded VMOutput",
+		ArgsUsage: "<record.json>",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "wasmer1",
+				Usage: "use the wasmer1 executor",
+			},
+			&cli.BoolFlag{
+				Name:  "wasmer2",
+				Usage: "use the wasmer2 executor",
+			},
+			&cli.StringFlag{
+				Name:  "gas-schedule",
+				Value: "v4",
+				Usage: "gas schedule used when the record does not contain one: v3, v4 or dummy",
+			},
+			&cli.StringSliceFlag{
+				Name:  "event-abi",
+				Usage: "contract ABI JSON whose events are decoded from the replayed logs; can be repeated",
+			},
+		},
+		Action: runReplay,
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func runReplay(cCtx *cli.Context) error {
+	if cCtx.NArg() != 1 {
+		return fmt.Errorf("expected exactly one record file, got %d arguments", cCtx.NArg())
+	}
+
+	record, err := replay.LoadExecutionRecord(cCtx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	gasSchedule := record.GasSchedule
+	if gasSchedule == nil {
+		gasSchedule, err = loadGasSchedule(cCtx.String("gas-schedule"))
+		if err != nil {
+			return err
+		}
+	}
+
+	var eventDecoder *eventabi.EventDecoder
+	if len(cCtx.StringSlice("event-abi")) > 0 {
+		eventDecoder, err = eventabi.LoadEventDecoder(cCtx.StringSlice("event-abi"))
+		if err != nil {
+			return err
+		}
+	}
+
+	var executorFactory executor.ExecutorAbstractFactory
+	if cCtx.Bool("wasmer1") {
+		executorFactory = wasmer.ExecutorFactory()
+	}
+	if cCtx.Bool("wasmer2") {
+		executorFactory = wasmer2.ExecutorFactory()
+	}
+
+	var enableEpochsHandler vmhost.EnableEpochsHandler
+	if record.ActiveFlags != nil {
+		enableEpochsHandler = record.NewEnableEpochsHandler()
+	} else {
+		fmt.Println("the record does not contain the active flags, replaying with all flags enabled")
+		enableEpochsHandler = worldmock.EnableEpochsHandlerStubAllFlags()
+	}
+
+	result, err := replay.Replay(record, func(blockchainHook vmcommon.BlockchainHook) (vmcommon.VMExecutionHandler, error) {
+		return newReplayVM(blockchainHook, gasSchedule, enableEpochsHandler, executorFactory)
+	})
+	if err != nil {
+		return err
+	}
+
+	printResult(result)
+	if eventDecoder != nil && result.VMOutput != nil {
+		printDecodedEvents(eventDecoder, result.VMOutput.Logs)
+	}
+	if !result.IsIdentical() {
+		return fmt.Errorf("replayed execution differs from the recorded one")
+	}
+
+	return nil
+}
+
+func loadGasSchedule(name string) (config.GasScheduleMap, error) {
+	switch name {
+	case "v3":
+		return gasSchedules.LoadGasScheduleConfig(gasSchedules.GetV3())
+	case "v4":
+		return gasSchedules.LoadGasScheduleConfig(gasSchedules.GetV4())
+	case "dummy":
+		return config.MakeGasMapForTests(), nil
+	default:
+		return nil, fmt.Errorf("unknown gas schedule: %s", name)
+	}
+}
+
+func newReplayVM(
+	blockchainHook vmcommon.BlockchainHook,
+	gasSchedule config.GasScheduleMap,
+	enableEpochsHandler vmhost.EnableEpochsHandler,
+	executorFactory executor.ExecutorAbstractFactory,
+) (vmhost.VMHost, error) {
+	// the mock world only provides the built-in function container, the
+	// built-in functions themselves are answered by the replay hook
+	world := worldmock.NewMockWorld()
+	err := world.InitBuiltinFunctions(gasSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtTransferParser, _ := parsers.NewESDTTransferParser(worldmock.WorldMarshalizer)
+
+	return hostCore.NewVMHost(
+		blockchainHook,
+		&vmhost.VMHostParameters{
+			VMType:                              vmscenario.DefaultVMType,
+			OverrideVMExecutor:                  executorFactory,
+			BlockGasLimit:                       blockGasLimit,
+			GasSchedule:                         gasSchedule,
+			BuiltInFuncContainer:                world.BuiltinFuncs.Container,
+			ProtectedKeyPrefix:                  []byte(core.ProtectedKeyPrefix),
+			ESDTTransferParser:                  esdtTransferParser,
+			EpochNotifier:                       &mock.EpochNotifierStub{},
+			EnableEpochsHandler:                 enableEpochsHandler,
+			WasmerSIGSEGVPassthrough:            false,
+			Hasher:                              worldmock.DefaultHasher,
+			MapOpcodeAddressIsAllowed:           map[string]map[string]struct{}{},
+			TimeOutForSCExecutionInMilliseconds: vmscenario.DefaultTimeOutForSCExecutionInMilliseconds,
+		})
+}
+
+func printResult(result *replay.Result) {
+	if result.Error != nil {
+		fmt.Printf("execution error: %s\n", result.Error)
+	}
+	if result.VMOutput != nil {
+		fmt.Printf("return code: %s\n", result.VMOutput.ReturnCode)
+		fmt.Printf("return message: %s\n", result.VMOutput.ReturnMessage)
+		fmt.Printf("gas remaining: %d\n", result.VMOutput.GasRemaining)
+		for i, data := range result.VMOutput.ReturnData {
+			fmt.Printf("return data [%d]: 0x%s\n", i, hex.EncodeToString(data))
+		}
+	}
+
+	for _, missing := range result.MissingResponses {
+		fmt.Printf("missing recorded response: %s\n", missing)
+	}
+	for _, difference := range result.Differences {
+		fmt.Printf("difference: %s\n", difference)
+	}
+	if result.IsIdentical() {
+		fmt.Println("replayed execution is identical to the recorded one")
+	}
+}
+
+func printDecodedEvents(eventDecoder *eventabi.EventDecoder, logEntries []*vmcommon.LogEntry) {
+	events, err := eventDecoder.DecodeLogs(logEntries)
+	if err != nil {
+		fmt.Printf("could not decode events: %s\n", err)
+		return
+	}
+
+	for _, event := range events {
+		fmt.Printf("event %s from %s: %v\n", event.Identifier, event.Address, event.Fields)
+	}
+}
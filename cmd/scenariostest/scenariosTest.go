@@ -1,10 +1,19 @@
 package main
 
 import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
 	scenclibase "github.com/multiversx/mx-chain-scenario-go/clibase"
 	scenio "github.com/multiversx/mx-chain-scenario-go/scenario/io"
 
+	"github.com/multiversx/mx-chain-vm-go/eventabi"
 	vmscenario "github.com/multiversx/mx-chain-vm-go/scenario"
+	"github.com/multiversx/mx-chain-vm-go/testcommon"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/contexts"
 	"github.com/multiversx/mx-chain-vm-go/wasmer"
 	"github.com/multiversx/mx-chain-vm-go/wasmer2"
 	cli "github.com/urfave/cli/v2"
@@ -12,11 +21,69 @@ import (
 
 var _ scenclibase.CLIRunConfig = (*vm15Flags)(nil)
 
+const asyncGraphFileName = "async-call-graph"
+
 func main() {
-	scenclibase.ScenariosCLI("VM 1.5 internal", &vm15Flags{})
+	flags := &vm15Flags{}
+	scenariosCLI("VM 1.5 internal", flags)
 }
 
-type vm15Flags struct{}
+// scenariosCLI mirrors scenclibase.ScenariosCLI, but writes the async call graph and the decoded
+// events before exiting, so they are also available when scenarios fail
+func scenariosCLI(version string, flags *vm15Flags) {
+	app := cli.NewApp()
+	app.Version = version
+	app.Commands = []*cli.Command{
+		{
+			Name:    "version",
+			Aliases: []string{"v"},
+			Usage:   "print the tool version",
+			Action: func(cCtx *cli.Context) error {
+				fmt.Println(app.Version)
+				return nil
+			},
+		},
+		{
+			Name:  "run",
+			Usage: "complete a task on the list",
+			Flags: flags.GetFlags(),
+			Action: func(cCtx *cli.Context) error {
+				args := cCtx.Args()
+				if args.Len() != 1 {
+					return errors.New("one path argument required to run scenarios")
+				}
+				path := cCtx.Args().First()
+
+				err := scenclibase.RunScenariosAtPath(path, flags.ParseFlags(cCtx))
+				flags.renderAsyncCallGraph()
+				flags.writeDecodedEvents()
+				return err
+			},
+		},
+		{
+			Name:  "fmt",
+			Usage: "format all scenario files in a folder ( .scen.json / .step.json / .steps.json )",
+			Action: func(cCtx *cli.Context) error {
+				args := cCtx.Args()
+				if args.Len() != 1 {
+					return errors.New("one path argument required to format scenarios")
+				}
+				return scenio.FormatAllInFolder(cCtx.Args().First())
+			},
+		},
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type vm15Flags struct {
+	asyncGraphFolder     string
+	asyncCallGraphTracer vmhost.AsyncCallGraphTracer
+	eventsFile           string
+	eventRecorder        *eventabi.EventRecorder
+}
 
 func (*vm15Flags) GetFlags() []cli.Flag {
 	return []cli.Flag{
@@ -33,10 +100,23 @@ func (*vm15Flags) GetFlags() []cli.Flag {
 			Name:  "wasmer2",
 			Usage: "use the wasmer2 executor`",
 		},
+		&cli.StringFlag{
+			Name:  "async-graph",
+			Usage: "traces the sync calls, async calls and callbacks of the run and draws them as an SVG in the given folder`",
+		},
+		&cli.StringSliceFlag{
+			Name:  "event-abi",
+			Usage: "contract ABI JSON whose events are decoded from the logs of the run; can be repeated",
+		},
+		&cli.StringFlag{
+			Name:  "events-out",
+			Value: "events.json",
+			Usage: "file receiving the events decoded with --event-abi",
+		},
 	}
 }
 
-func (*vm15Flags) ParseFlags(cCtx *cli.Context) scenclibase.CLIRunOptions {
+func (flags *vm15Flags) ParseFlags(cCtx *cli.Context) scenclibase.CLIRunOptions {
 	runOptions := &scenio.RunScenarioOptions{
 		ForceTraceGas: cCtx.Bool("force-trace-gas"),
 	}
@@ -48,9 +128,57 @@ func (*vm15Flags) ParseFlags(cCtx *cli.Context) scenclibase.CLIRunOptions {
 	if cCtx.Bool("wasmer2") {
 		vmBuilder.OverrideVMExecutor = wasmer2.ExecutorFactory()
 	}
+	if len(cCtx.String("async-graph")) > 0 {
+		flags.asyncGraphFolder = cCtx.String("async-graph")
+		flags.asyncCallGraphTracer = contexts.NewAsyncCallGraphTracer()
+		vmBuilder.AsyncCallGraphTracer = flags.asyncCallGraphTracer
+	}
+	if len(cCtx.StringSlice("event-abi")) > 0 {
+		decoder, err := eventabi.LoadEventDecoder(cCtx.StringSlice("event-abi"))
+		if err != nil {
+			fmt.Printf("could not load the event ABIs: %s\n", err.Error())
+			os.Exit(1)
+		}
+		flags.eventsFile = cCtx.String("events-out")
+		flags.eventRecorder = eventabi.NewEventRecorder(decoder)
+		vmBuilder.EventRecorder = flags.eventRecorder
+	}
 
 	return scenclibase.CLIRunOptions{
 		RunOptions: runOptions,
 		VMBuilder:  vmBuilder,
 	}
 }
+
+func (flags *vm15Flags) renderAsyncCallGraph() {
+	if flags.asyncCallGraphTracer == nil {
+		return
+	}
+
+	err := os.MkdirAll(flags.asyncGraphFolder, os.ModePerm)
+	if err != nil {
+		fmt.Printf("could not create the async call graph folder: %s\n", err.Error())
+		return
+	}
+
+	folder := flags.asyncGraphFolder + string(os.PathSeparator)
+	testcommon.GenerateSVGforExecutionGraph(flags.asyncCallGraphTracer.GetExecutionGraph(), folder, asyncGraphFileName)
+	fmt.Printf("async call graph written to %s%s.svg\n", folder, asyncGraphFileName)
+}
+
+func (flags *vm15Flags) writeDecodedEvents() {
+	if flags.eventRecorder == nil {
+		return
+	}
+
+	err := flags.eventRecorder.WriteJSON(flags.eventsFile)
+	if err != nil {
+		fmt.Printf("could not write the decoded events: %s\n", err.Error())
+		return
+	}
+
+	fmt.Printf("%d decoded events written to %s\n", len(flags.eventRecorder.Events()), flags.eventsFile)
+	for _, decodeError := range flags.eventRecorder.DecodeErrors() {
+		fmt.Printf("could not decode event: %s\n", decodeError)
+	}
+}
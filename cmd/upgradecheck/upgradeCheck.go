@@ -0,0 +1,99 @@
+// Reports, as JSON, the endpoints removed and added by a contract upgrade, and the
+// callbacks of pending async calls which the new code no longer exports.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/contexts"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/upgradecheck"
+	cli "github.com/urfave/cli/v2"
+)
+
+func main() {
+	app := &cli.App{
+		Name:      "upgradecheck",
+		Usage:     "reports the breaking changes of upgrading a contract from the old to the new code",
+		ArgsUsage: "<old.wasm> <new.wasm>",
+		Flags: []cli.Flag{
+			&cli.StringSliceFlag{
+				Name:  "async-context",
+				Usage: "file holding an async context, as persisted in the storage of the contract",
+			},
+			&cli.StringSliceFlag{
+				Name:  "callback",
+				Usage: "name of a callback still expected by a pending async call",
+			},
+			&cli.BoolFlag{
+				Name:  "strict",
+				Usage: "fail if the upgrade removes endpoints, not only pending callbacks",
+			},
+		},
+		Action: runCheck,
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func runCheck(cCtx *cli.Context) error {
+	if cCtx.NArg() != 2 {
+		return fmt.Errorf("expected the old and the new wasm files, got %d arguments", cCtx.NArg())
+	}
+
+	oldCode, err := os.ReadFile(cCtx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	newCode, err := os.ReadFile(cCtx.Args().Get(1))
+	if err != nil {
+		return err
+	}
+
+	pendingCallbacks, err := loadPendingCallbacks(cCtx.StringSlice("async-context"))
+	if err != nil {
+		return err
+	}
+	pendingCallbacks = append(pendingCallbacks, cCtx.StringSlice("callback")...)
+
+	report, err := upgradecheck.Check(oldCode, newCode, pendingCallbacks)
+	if err != nil {
+		return err
+	}
+
+	serialized, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(serialized))
+
+	if report.HasMissingCallbacks() || (cCtx.Bool("strict") && report.IsBreaking()) {
+		return fmt.Errorf("the upgrade is not compatible")
+	}
+	return nil
+}
+
+func loadPendingCallbacks(paths []string) ([]string, error) {
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	pendingCallbacks := make([]string, 0)
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		callbacks, err := contexts.PendingCallbacksOfPersistedContext(data, marshalizer)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		pendingCallbacks = append(pendingCallbacks, callbacks...)
+	}
+
+	return pendingCallbacks, nil
+}
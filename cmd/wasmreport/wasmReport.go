@@ -0,0 +1,142 @@
+// Reports what a contract imports and exports, and whether the VM accepts it, as JSON.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	"github.com/multiversx/mx-chain-vm-go/config"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	gasSchedules "github.com/multiversx/mx-chain-vm-go/scenario/gasSchedules"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/contexts"
+	"github.com/multiversx/mx-chain-vm-go/wasmer"
+	"github.com/multiversx/mx-chain-vm-go/wasmer2"
+	"github.com/multiversx/mx-chain-vm-go/wasmreport"
+	cli "github.com/urfave/cli/v2"
+)
+
+const analysisGasLimit = uint64(10000000)
+
+func main() {
+	app := &cli.App{
+		Name:      "wasmreport",
+		Usage:     "reports the VM hooks, endpoints, memory and opcodes of a contract, and whether the VM accepts it",
+		ArgsUsage: "<contract.wasm>",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "wasmer1",
+				Usage: "use the wasmer1 executor instead of wasmer2",
+			},
+			&cli.StringFlag{
+				Name:  "gas-schedule",
+				Value: "v4",
+				Usage: "gas schedule providing the opcode costs and memory limits: v3, v4 or dummy",
+			},
+			&cli.StringFlag{
+				Name:  "output",
+				Usage: "path of the JSON report, defaults to the standard output",
+			},
+		},
+		Action: runReport,
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func runReport(cCtx *cli.Context) error {
+	if cCtx.NArg() != 1 {
+		return fmt.Errorf("expected exactly one wasm file, got %d arguments", cCtx.NArg())
+	}
+
+	code, err := os.ReadFile(cCtx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	analyzer, err := newAnalyzer(cCtx.String("gas-schedule"), cCtx.Bool("wasmer1"))
+	if err != nil {
+		return err
+	}
+
+	report, err := analyzer.Analyze(code)
+	if err != nil {
+		return err
+	}
+
+	serialized, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	serialized = append(serialized, '\n')
+
+	if len(cCtx.String("output")) > 0 {
+		return os.WriteFile(cCtx.String("output"), serialized, 0644)
+	}
+	_, err = os.Stdout.Write(serialized)
+	return err
+}
+
+func newAnalyzer(gasScheduleName string, useWasmer1 bool) (*wasmreport.Analyzer, error) {
+	gasSchedule, err := loadGasSchedule(gasScheduleName)
+	if err != nil {
+		return nil, err
+	}
+	gasCost, err := config.CreateGasConfig(gasSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	var executorFactory executor.ExecutorAbstractFactory = wasmer2.ExecutorFactory()
+	if useWasmer1 {
+		executorFactory = wasmer.ExecutorFactory()
+	}
+	// the code is only instantiated, never executed, so no VM hooks are needed
+	vmExecutor, err := executorFactory.CreateExecutor(executor.ExecutorFactoryArgs{
+		OpcodeCosts: gasCost.WASMOpcodeCost,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	world := worldmock.NewMockWorld()
+	err = world.InitBuiltinFunctions(gasSchedule)
+	if err != nil {
+		return nil, err
+	}
+	codeValidator, err := contexts.NewCodeValidator(vmExecutor.FunctionNames(), world.BuiltinFuncs.Container)
+	if err != nil {
+		return nil, err
+	}
+
+	return wasmreport.NewAnalyzer(wasmreport.ArgsNewAnalyzer{
+		Executor:      vmExecutor,
+		CodeValidator: codeValidator,
+		CompilationOptions: executor.CompilationOptions{
+			GasLimit:           analysisGasLimit,
+			UnmeteredLocals:    uint64(gasCost.WASMOpcodeCost.LocalsUnmetered),
+			MaxMemoryGrow:      uint64(gasCost.WASMOpcodeCost.MaxMemoryGrow),
+			MaxMemoryGrowDelta: uint64(gasCost.WASMOpcodeCost.MaxMemoryGrowDelta),
+			Metering:           true,
+			RuntimeBreakpoints: true,
+		},
+	})
+}
+
+func loadGasSchedule(name string) (config.GasScheduleMap, error) {
+	switch name {
+	case "v3":
+		return gasSchedules.LoadGasScheduleConfig(gasSchedules.GetV3())
+	case "v4":
+		return gasSchedules.LoadGasScheduleConfig(gasSchedules.GetV4())
+	case "dummy":
+		return config.MakeGasMapForTests(), nil
+	default:
+		return nil, fmt.Errorf("unknown gas schedule: %s", name)
+	}
+}
@@ -27,6 +27,12 @@ type BaseOperationCost struct {
 	CompilePerByte    uint64
 	AoTPreparePerByte uint64
 	GetCode           uint64
+
+	// the storage deposit is disabled when its costs are 0
+	StorageDepositPerByte uint64 `zeroAllowed:"true"`
+	StorageRefundPerByte  uint64 `zeroAllowed:"true"`
+	ClearStorageRefund    uint64
+	MaxGasRefundQuotient  uint64
 }
 
 // BaseOpsAPICost defines the API operations gas cost config structure
@@ -83,6 +89,10 @@ type BaseOpsAPICost struct {
 	GetCodeMetadata         uint64
 	IsBuiltinFunction       uint64
 	IsReservedFunctionName 	uint64
+	LogPerTopic             uint64
+	GetCodeHash             uint64
+	GetAccountNonce         uint64
+	GetContractOwner        uint64
 }
 
 // DynamicStorageLoadCostCoefficients holds the signed coefficients of the func that will compute the gas cost
@@ -218,6 +228,10 @@ type ManagedBufferAPICost struct {
 	MBufferGetArgument        uint64
 	MBufferFinish             uint64
 	MBufferSetRandom          uint64
+	MBufferStorageIterate     uint64
+	MBufferStorageIterateKey  uint64
+	MBufferTransientStore     uint64
+	MBufferTransientLoad      uint64
 }
 
 // ManagedMapAPICost defines the managed map operations gas cost config structure
@@ -177,6 +177,9 @@ func checkForZeroUint64Fields(arg interface{}) error {
 		if field.Kind() != reflect.Uint64 && field.Kind() != reflect.Uint32 {
 			continue
 		}
+		if v.Type().Field(i).Tag.Get("zeroAllowed") == "true" {
+			continue
+		}
 		if field.Uint() == 0 {
 			name := v.Type().Field(i).Name
 			return fmt.Errorf("gas cost for operation %s has been set to 0 or is not set", name)
@@ -255,6 +258,10 @@ func FillGasMapBaseOperationCosts(value uint64) map[string]uint64 {
 	gasMap["CompilePerByte"] = value
 	gasMap["AoTPreparePerByte"] = value
 	gasMap["GetCode"] = value
+	gasMap["StorageDepositPerByte"] = value
+	gasMap["StorageRefundPerByte"] = value
+	gasMap["ClearStorageRefund"] = value
+	gasMap["MaxGasRefundQuotient"] = value
 
 	return gasMap
 }
@@ -314,6 +321,10 @@ func FillGasMapBaseOpsAPICosts(value, asyncCallbackGasLock uint64) map[string]ui
 	gasMap["GetCodeMetadata"] = value
 	gasMap["IsBuiltinFunction"] = value
 	gasMap["IsReservedFunctionName"] = value
+	gasMap["LogPerTopic"] = value
+	gasMap["GetCodeHash"] = value
+	gasMap["GetAccountNonce"] = value
+	gasMap["GetContractOwner"] = value
 
 	return gasMap
 }
@@ -479,6 +490,10 @@ func FillGasMapManagedBufferAPICosts(value uint64) map[string]uint64 {
 	gasMap["MBufferGetArgument"] = value
 	gasMap["MBufferFinish"] = value
 	gasMap["MBufferSetRandom"] = value
+	gasMap["MBufferStorageIterate"] = value
+	gasMap["MBufferStorageIterateKey"] = value
+	gasMap["MBufferTransientStore"] = value
+	gasMap["MBufferTransientLoad"] = value
 
 	return gasMap
 }
@@ -76,6 +76,20 @@ func TestDecode_ZeroGasCostError(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestDecode_ZeroStorageDepositAllowed(t *testing.T) {
+	gasMap := MakeGasMapForTests()
+	gasMap["BaseOperationCost"]["StorageDepositPerByte"] = 0
+	gasMap["BaseOperationCost"]["StorageRefundPerByte"] = 0
+
+	gasCost, err := CreateGasConfig(gasMap)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), gasCost.BaseOperationCost.StorageDepositPerByte)
+
+	gasMap["BaseOperationCost"]["ClearStorageRefund"] = 0
+	_, err = CreateGasConfig(gasMap)
+	assert.Error(t, err)
+}
+
 func Test_getSignedCoefficient(t *testing.T) {
 	gasScheduleMap := MakeGasMap(1, 1)
 
@@ -0,0 +1,69 @@
+// Package eventabi decodes the log entries of contracts into named, typed fields,
+// using the event schemas of their ABI.
+package eventabi
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// ContractABI holds the parts of a contract ABI JSON describing its events
+type ContractABI struct {
+	Name   string                     `json:"name"`
+	Events []*EventDefinition         `json:"events"`
+	Types  map[string]*TypeDefinition `json:"types"`
+}
+
+// EventDefinition is the schema of an event, as found in the "events" section of the ABI
+type EventDefinition struct {
+	Identifier string        `json:"identifier"`
+	Inputs     []*EventInput `json:"inputs"`
+}
+
+// EventInput is an argument of an event; the indexed ones are written as topics, the others as data
+type EventInput struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Indexed bool   `json:"indexed"`
+}
+
+// TypeDefinition is a struct or enum defined in the "types" section of the ABI
+type TypeDefinition struct {
+	Type     string             `json:"type"`
+	Fields   []*FieldDefinition `json:"fields"`
+	Variants []*EnumVariant     `json:"variants"`
+}
+
+// FieldDefinition is a field of a struct or of an enum variant
+type FieldDefinition struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// EnumVariant is a variant of an enum, identified by its discriminant
+type EnumVariant struct {
+	Name         string             `json:"name"`
+	Discriminant int                `json:"discriminant"`
+	Fields       []*FieldDefinition `json:"fields"`
+}
+
+// ParseContractABI parses the JSON of a contract ABI
+func ParseContractABI(data []byte) (*ContractABI, error) {
+	contractABI := &ContractABI{}
+	err := json.Unmarshal(data, contractABI)
+	if err != nil {
+		return nil, err
+	}
+
+	return contractABI, nil
+}
+
+// LoadContractABI reads and parses a contract ABI JSON file
+func LoadContractABI(path string) (*ContractABI, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseContractABI(data)
+}
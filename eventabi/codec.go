@@ -0,0 +1,339 @@
+package eventabi
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	twos "github.com/multiversx/mx-components-big-int/twos-complement"
+)
+
+const (
+	optionPrefix = "Option<"
+	listPrefix   = "List<"
+	lengthSize   = 4
+	addressSize  = 32
+)
+
+var unsignedSizes = map[string]int{"u8": 1, "u16": 2, "u32": 4, "usize": 4, "u64": 8}
+var signedSizes = map[string]int{"i8": 1, "i16": 2, "i32": 4, "isize": 4, "i64": 8}
+var bytesTypes = map[string]struct{}{"bytes": {}, "BoxedBytes": {}, "ManagedBuffer": {}}
+var stringTypes = map[string]struct{}{
+	"utf-8 string":              {},
+	"String":                    {},
+	"&str":                      {},
+	"TokenIdentifier":           {},
+	"EgldOrEsdtTokenIdentifier": {},
+}
+var addressTypes = map[string]struct{}{"Address": {}, "ManagedAddress": {}, "H256": {}}
+
+// HexBytes is a decoded byte array, shown in hexadecimal
+type HexBytes []byte
+
+// String returns the bytes in hexadecimal, prefixed by 0x
+func (hexBytes HexBytes) String() string {
+	return "0x" + hex.EncodeToString(hexBytes)
+}
+
+// MarshalJSON writes the bytes as a hexadecimal string
+func (hexBytes HexBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hexBytes.String())
+}
+
+// DecodedField is a named value decoded from a log entry. The values are bool,
+// uint64, int64, *big.Int, string, HexBytes, nil for an absent Option,
+// []interface{} for a List, []*DecodedField for a struct and *DecodedEnum
+type DecodedField struct {
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// String returns the name and the value of the field
+func (field *DecodedField) String() string {
+	return fmt.Sprintf("%s: %v", field.Name, field.Value)
+}
+
+// DecodedEnum is the variant of a decoded enum, with its fields
+type DecodedEnum struct {
+	Variant string          `json:"variant"`
+	Fields  []*DecodedField `json:"fields,omitempty"`
+}
+
+// String returns the name of the variant, followed by its fields if it has any
+func (enum *DecodedEnum) String() string {
+	if len(enum.Fields) == 0 {
+		return enum.Variant
+	}
+	return fmt.Sprintf("%s%v", enum.Variant, enum.Fields)
+}
+
+// valueDecoder decodes values serialized with the MultiversX codec, where a
+// top-level value takes the whole input, while a nested one is prefixed by its
+// length if it is not of fixed size
+type valueDecoder struct {
+	types map[string]*TypeDefinition
+}
+
+func (decoder *valueDecoder) decodeTopLevel(typeName string, data []byte) (interface{}, error) {
+	if size, ok := unsignedSizes[typeName]; ok {
+		if len(data) > size {
+			return nil, fmt.Errorf("%w for %s", ErrTrailingData, typeName)
+		}
+		return big.NewInt(0).SetBytes(data).Uint64(), nil
+	}
+	if size, ok := signedSizes[typeName]; ok {
+		if len(data) > size {
+			return nil, fmt.Errorf("%w for %s", ErrTrailingData, typeName)
+		}
+		return twos.FromBytes(data).Int64(), nil
+	}
+
+	switch {
+	case typeName == "BigUint":
+		return big.NewInt(0).SetBytes(data), nil
+	case typeName == "BigInt":
+		return twos.FromBytes(data), nil
+	case typeName == "bool":
+		if len(data) == 0 {
+			return false, nil
+		}
+		return decoder.decodeBool(data)
+	case isBytesType(typeName):
+		return HexBytes(copyBytes(data)), nil
+	case isStringType(typeName):
+		return string(data), nil
+	case strings.HasPrefix(typeName, optionPrefix) && len(data) == 0:
+		return nil, nil
+	case strings.HasPrefix(typeName, listPrefix):
+		return decoder.decodeListItems(genericArgument(typeName, listPrefix), data)
+	case decoder.isEnum(typeName) && len(data) == 0:
+		return decoder.decodeEnumVariant(typeName, 0, data)
+	}
+
+	value, rest, err := decoder.decodeNested(typeName, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("%w for %s", ErrTrailingData, typeName)
+	}
+
+	return value, nil
+}
+
+func (decoder *valueDecoder) decodeNested(typeName string, data []byte) (interface{}, []byte, error) {
+	if size, ok := unsignedSizes[typeName]; ok {
+		valueBytes, rest, err := splitBytes(typeName, data, size)
+		if err != nil {
+			return nil, nil, err
+		}
+		return big.NewInt(0).SetBytes(valueBytes).Uint64(), rest, nil
+	}
+	if size, ok := signedSizes[typeName]; ok {
+		valueBytes, rest, err := splitBytes(typeName, data, size)
+		if err != nil {
+			return nil, nil, err
+		}
+		return twos.FromBytes(valueBytes).Int64(), rest, nil
+	}
+
+	switch {
+	case typeName == "bool":
+		valueBytes, rest, err := splitBytes(typeName, data, 1)
+		if err != nil {
+			return nil, nil, err
+		}
+		value, err := decoder.decodeBool(valueBytes)
+		return value, rest, err
+	case isAddressType(typeName):
+		valueBytes, rest, err := splitBytes(typeName, data, addressSize)
+		return HexBytes(copyBytes(valueBytes)), rest, err
+	case typeName == "BigUint" || typeName == "BigInt" || isBytesType(typeName) || isStringType(typeName):
+		valueBytes, rest, err := splitLengthPrefixed(typeName, data)
+		if err != nil {
+			return nil, nil, err
+		}
+		value, err := decoder.decodeTopLevel(typeName, valueBytes)
+		return value, rest, err
+	case strings.HasPrefix(typeName, optionPrefix):
+		return decoder.decodeNestedOption(genericArgument(typeName, optionPrefix), data)
+	case strings.HasPrefix(typeName, listPrefix):
+		return decoder.decodeNestedList(genericArgument(typeName, listPrefix), data)
+	}
+
+	typeDefinition, ok := decoder.types[typeName]
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %s", ErrUnknownType, typeName)
+	}
+
+	switch typeDefinition.Type {
+	case "struct":
+		return decoder.decodeFields(typeDefinition.Fields, data)
+	case "enum":
+		discriminant, rest, err := splitBytes(typeName, data, 1)
+		if err != nil {
+			return nil, nil, err
+		}
+		return decoder.decodeNestedEnumVariant(typeName, int(discriminant[0]), rest)
+	default:
+		return nil, nil, fmt.Errorf("%w: %s of kind %s", ErrUnknownType, typeName, typeDefinition.Type)
+	}
+}
+
+func (decoder *valueDecoder) decodeBool(data []byte) (bool, error) {
+	if len(data) != 1 || data[0] > 1 {
+		return false, fmt.Errorf("invalid bool value 0x%s", hex.EncodeToString(data))
+	}
+	return data[0] == 1, nil
+}
+
+func (decoder *valueDecoder) decodeNestedOption(itemType string, data []byte) (interface{}, []byte, error) {
+	isSome, rest, err := splitBytes(optionPrefix+itemType+">", data, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch isSome[0] {
+	case 0:
+		return nil, rest, nil
+	case 1:
+		return decoder.decodeNested(itemType, rest)
+	default:
+		return nil, nil, fmt.Errorf("invalid Option prefix 0x%02x", isSome[0])
+	}
+}
+
+func (decoder *valueDecoder) decodeNestedList(itemType string, data []byte) (interface{}, []byte, error) {
+	lengthBytes, rest, err := splitBytes(listPrefix+itemType+">", data, lengthSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	length := binary.BigEndian.Uint32(lengthBytes)
+	items := make([]interface{}, 0)
+	for i := uint32(0); i < length; i++ {
+		var item interface{}
+		item, rest, err = decoder.decodeNested(itemType, rest)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, rest, nil
+}
+
+func (decoder *valueDecoder) decodeListItems(itemType string, data []byte) ([]interface{}, error) {
+	items := make([]interface{}, 0)
+	for len(data) > 0 {
+		var item interface{}
+		var err error
+		item, data, err = decoder.decodeNested(itemType, data)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (decoder *valueDecoder) decodeFields(fields []*FieldDefinition, data []byte) ([]*DecodedField, []byte, error) {
+	decodedFields := make([]*DecodedField, 0, len(fields))
+	for _, field := range fields {
+		value, rest, err := decoder.decodeNested(field.Type, data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+
+		decodedFields = append(decodedFields, &DecodedField{
+			Name:  field.Name,
+			Type:  field.Type,
+			Value: value,
+		})
+		data = rest
+	}
+
+	return decodedFields, data, nil
+}
+
+func (decoder *valueDecoder) decodeEnumVariant(typeName string, discriminant int, data []byte) (*DecodedEnum, error) {
+	value, rest, err := decoder.decodeNestedEnumVariant(typeName, discriminant, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("%w for %s", ErrTrailingData, typeName)
+	}
+
+	return value, nil
+}
+
+func (decoder *valueDecoder) decodeNestedEnumVariant(typeName string, discriminant int, data []byte) (*DecodedEnum, []byte, error) {
+	for _, variant := range decoder.types[typeName].Variants {
+		if variant.Discriminant != discriminant {
+			continue
+		}
+
+		fields, rest, err := decoder.decodeFields(variant.Fields, data)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return &DecodedEnum{Variant: variant.Name, Fields: fields}, rest, nil
+	}
+
+	return nil, nil, fmt.Errorf("%w %d for %s", ErrUnknownEnumDiscriminant, discriminant, typeName)
+}
+
+func (decoder *valueDecoder) isEnum(typeName string) bool {
+	typeDefinition, ok := decoder.types[typeName]
+	return ok && typeDefinition.Type == "enum"
+}
+
+func splitBytes(typeName string, data []byte, size int) ([]byte, []byte, error) {
+	if len(data) < size {
+		return nil, nil, fmt.Errorf("%w for %s", ErrUnexpectedEndOfData, typeName)
+	}
+	return data[:size], data[size:], nil
+}
+
+func splitLengthPrefixed(typeName string, data []byte) ([]byte, []byte, error) {
+	lengthBytes, rest, err := splitBytes(typeName, data, lengthSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	length := binary.BigEndian.Uint32(lengthBytes)
+	if uint64(len(rest)) < uint64(length) {
+		return nil, nil, fmt.Errorf("%w for %s", ErrUnexpectedEndOfData, typeName)
+	}
+	return rest[:length], rest[length:], nil
+}
+
+func genericArgument(typeName string, prefix string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(typeName, prefix), ">")
+}
+
+func isBytesType(typeName string) bool {
+	_, ok := bytesTypes[typeName]
+	return ok
+}
+
+func isStringType(typeName string) bool {
+	_, ok := stringTypes[typeName]
+	return ok
+}
+
+func isAddressType(typeName string) bool {
+	_, ok := addressTypes[typeName]
+	return ok
+}
+
+func copyBytes(data []byte) []byte {
+	return append(make([]byte, 0, len(data)), data...)
+}
@@ -0,0 +1,242 @@
+package eventabi
+
+import (
+	"fmt"
+
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// DecodedEvent is a log entry decoded with the schema of its event
+type DecodedEvent struct {
+	Address    HexBytes        `json:"address"`
+	Identifier string          `json:"identifier"`
+	Fields     []*DecodedField `json:"fields"`
+}
+
+// Field returns the decoded field with the given name, or nil if the event has no such field
+func (event *DecodedEvent) Field(name string) *DecodedField {
+	for _, field := range event.Fields {
+		if field.Name == name {
+			return field
+		}
+	}
+	return nil
+}
+
+// Matches verifies whether the event has the identifier and whether each of the
+// expected fields, formatted with fmt, has the expected value
+func (event *DecodedEvent) Matches(identifier string, expectedFields map[string]string) bool {
+	if event.Identifier != identifier {
+		return false
+	}
+
+	for name, expectedValue := range expectedFields {
+		field := event.Field(name)
+		if field == nil || fmt.Sprint(field.Value) != expectedValue {
+			return false
+		}
+	}
+
+	return true
+}
+
+type registeredEvent struct {
+	definition *EventDefinition
+	decoder    *valueDecoder
+}
+
+// EventDecoder holds the registered event schemas and decodes log entries with them
+type EventDecoder struct {
+	events          map[string]*registeredEvent
+	contractsEvents map[string]map[string]*registeredEvent
+}
+
+// NewEventDecoder creates an EventDecoder without any registered event
+func NewEventDecoder() *EventDecoder {
+	return &EventDecoder{
+		events:          make(map[string]*registeredEvent),
+		contractsEvents: make(map[string]map[string]*registeredEvent),
+	}
+}
+
+// RegisterABI registers the events of the ABI for the log entries of all contracts
+func (decoder *EventDecoder) RegisterABI(contractABI *ContractABI) error {
+	return registerEvents(decoder.events, contractABI)
+}
+
+// RegisterContractABI registers the events of the ABI for the log entries of
+// the given contract only, taking precedence over the events of RegisterABI
+func (decoder *EventDecoder) RegisterContractABI(address []byte, contractABI *ContractABI) error {
+	contractEvents, ok := decoder.contractsEvents[string(address)]
+	if !ok {
+		contractEvents = make(map[string]*registeredEvent)
+		decoder.contractsEvents[string(address)] = contractEvents
+	}
+
+	return registerEvents(contractEvents, contractABI)
+}
+
+// LoadEventDecoder creates an EventDecoder registering the events of the given
+// ABI files for the log entries of all contracts
+func LoadEventDecoder(abiPaths []string) (*EventDecoder, error) {
+	decoder := NewEventDecoder()
+	for _, abiPath := range abiPaths {
+		contractABI, err := LoadContractABI(abiPath)
+		if err != nil {
+			return nil, err
+		}
+
+		err = decoder.RegisterABI(contractABI)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", abiPath, err)
+		}
+	}
+
+	return decoder, nil
+}
+
+func registerEvents(events map[string]*registeredEvent, contractABI *ContractABI) error {
+	if contractABI == nil {
+		return ErrNilContractABI
+	}
+
+	valueDecoder := &valueDecoder{types: contractABI.Types}
+	for _, event := range contractABI.Events {
+		if len(event.Identifier) == 0 {
+			return ErrEmptyEventIdentifier
+		}
+		events[event.Identifier] = &registeredEvent{
+			definition: event,
+			decoder:    valueDecoder,
+		}
+	}
+
+	return nil
+}
+
+// Decode decodes a log entry. Contracts write the event identifier as the first
+// topic, followed by the indexed arguments, while the protocol events are
+// identified by the Identifier of the log entry and have only arguments as topics.
+// The arguments which are not indexed are read from the data of the log entry.
+func (decoder *EventDecoder) Decode(logEntry *vmcommon.LogEntry) (*DecodedEvent, error) {
+	event, topics := decoder.findEvent(logEntry)
+	if event == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEvent, describeLogEntry(logEntry))
+	}
+
+	decodedEvent := &DecodedEvent{
+		Address:    HexBytes(logEntry.Address),
+		Identifier: event.definition.Identifier,
+		Fields:     make([]*DecodedField, 0, len(event.definition.Inputs)),
+	}
+
+	dataInputs := make([]*EventInput, 0)
+	for _, input := range event.definition.Inputs {
+		if !input.Indexed {
+			dataInputs = append(dataInputs, input)
+			continue
+		}
+		if len(topics) == 0 {
+			return nil, fmt.Errorf("%w: topic %s of %s", ErrMissingEventArgument, input.Name, decodedEvent.Identifier)
+		}
+
+		value, err := event.decoder.decodeTopLevel(input.Type, topics[0])
+		if err != nil {
+			return nil, fmt.Errorf("topic %s of %s: %w", input.Name, decodedEvent.Identifier, err)
+		}
+		decodedEvent.Fields = append(decodedEvent.Fields, &DecodedField{Name: input.Name, Type: input.Type, Value: value})
+		topics = topics[1:]
+	}
+
+	dataFields, err := decoder.decodeData(event, dataInputs, logEntry.Data)
+	if err != nil {
+		return nil, fmt.Errorf("data of %s: %w", decodedEvent.Identifier, err)
+	}
+	decodedEvent.Fields = append(decodedEvent.Fields, dataFields...)
+
+	return decodedEvent, nil
+}
+
+// DecodeLogs decodes the log entries with a registered schema, skipping the others
+func (decoder *EventDecoder) DecodeLogs(logEntries []*vmcommon.LogEntry) ([]*DecodedEvent, error) {
+	decodedEvents := make([]*DecodedEvent, 0, len(logEntries))
+	for _, logEntry := range logEntries {
+		event, _ := decoder.findEvent(logEntry)
+		if event == nil {
+			continue
+		}
+
+		decodedEvent, err := decoder.Decode(logEntry)
+		if err != nil {
+			return nil, err
+		}
+		decodedEvents = append(decodedEvents, decodedEvent)
+	}
+
+	return decodedEvents, nil
+}
+
+func (decoder *EventDecoder) findEvent(logEntry *vmcommon.LogEntry) (*registeredEvent, [][]byte) {
+	contractEvents := decoder.contractsEvents[string(logEntry.Address)]
+	for _, events := range []map[string]*registeredEvent{contractEvents, decoder.events} {
+		if len(logEntry.Topics) > 0 {
+			event, ok := events[string(logEntry.Topics[0])]
+			if ok {
+				return event, logEntry.Topics[1:]
+			}
+		}
+
+		event, ok := events[string(logEntry.Identifier)]
+		if ok {
+			return event, logEntry.Topics
+		}
+	}
+
+	return nil, nil
+}
+
+// decodeData decodes the arguments which are not indexed, either one per data
+// item or, when they share a single data item, nested-encoded one after another
+func (decoder *EventDecoder) decodeData(event *registeredEvent, inputs []*EventInput, data [][]byte) ([]*DecodedField, error) {
+	fields := make([]*DecodedField, 0, len(inputs))
+	if len(inputs) == 0 {
+		return fields, nil
+	}
+
+	if len(data) == len(inputs) {
+		for i, input := range inputs {
+			value, err := event.decoder.decodeTopLevel(input.Type, data[i])
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", input.Name, err)
+			}
+			fields = append(fields, &DecodedField{Name: input.Name, Type: input.Type, Value: value})
+		}
+		return fields, nil
+	}
+
+	if len(data) != 1 {
+		return nil, fmt.Errorf("%w: expected %d data items, got %d", ErrMissingEventArgument, len(inputs), len(data))
+	}
+
+	definitions := make([]*FieldDefinition, 0, len(inputs))
+	for _, input := range inputs {
+		definitions = append(definitions, &FieldDefinition{Name: input.Name, Type: input.Type})
+	}
+
+	fields, rest, err := event.decoder.decodeFields(definitions, data[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, ErrTrailingData
+	}
+
+	return fields, nil
+}
+
+func describeLogEntry(logEntry *vmcommon.LogEntry) string {
+	if len(logEntry.Topics) > 0 {
+		return fmt.Sprintf("identifier %s, first topic %s", logEntry.Identifier, HexBytes(logEntry.Topics[0]))
+	}
+	return fmt.Sprintf("identifier %s", logEntry.Identifier)
+}
@@ -0,0 +1,237 @@
+package eventabi
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+const testABI = `{
+	"name": "Auction",
+	"events": [
+		{
+			"identifier": "bid",
+			"inputs": [
+				{"name": "bidder", "type": "Address", "indexed": true},
+				{"name": "round", "type": "u64", "indexed": true},
+				{"name": "amount", "type": "BigUint"}
+			]
+		},
+		{
+			"identifier": "auctionState",
+			"inputs": [
+				{"name": "status", "type": "Status", "indexed": true},
+				{"name": "info", "type": "AuctionInfo"}
+			]
+		}
+	],
+	"types": {
+		"Status": {
+			"type": "enum",
+			"variants": [
+				{"name": "Open", "discriminant": 0},
+				{"name": "Closed", "discriminant": 1, "fields": [{"name": "0", "type": "u32"}]}
+			]
+		},
+		"AuctionInfo": {
+			"type": "struct",
+			"fields": [
+				{"name": "token", "type": "TokenIdentifier"},
+				{"name": "winner", "type": "Option<Address>"},
+				{"name": "bids", "type": "List<u16>"}
+			]
+		}
+	}
+}`
+
+func newTestDecoder(t *testing.T) *EventDecoder {
+	contractABI, err := ParseContractABI([]byte(testABI))
+	require.Nil(t, err)
+
+	decoder := NewEventDecoder()
+	err = decoder.RegisterABI(contractABI)
+	require.Nil(t, err)
+
+	return decoder
+}
+
+func TestEventDecoder_DecodeIndexedAndDataArguments(t *testing.T) {
+	t.Parallel()
+
+	decoder := newTestDecoder(t)
+	bidder := bytes.Repeat([]byte{0xaa}, 32)
+	logEntry := &vmcommon.LogEntry{
+		Identifier: []byte("placeBid"),
+		Address:    []byte("auction"),
+		Topics:     [][]byte{[]byte("bid"), bidder, {0x07}},
+		Data:       [][]byte{big.NewInt(1000).Bytes()},
+	}
+
+	event, err := decoder.Decode(logEntry)
+	require.Nil(t, err)
+	require.Equal(t, "bid", event.Identifier)
+	require.Equal(t, HexBytes(bidder), event.Field("bidder").Value)
+	require.Equal(t, uint64(7), event.Field("round").Value)
+	require.Equal(t, big.NewInt(1000), event.Field("amount").Value)
+	require.True(t, event.Matches("bid", map[string]string{"round": "7", "amount": "1000"}))
+	require.False(t, event.Matches("bid", map[string]string{"round": "8"}))
+	require.False(t, event.Matches("bid", map[string]string{"missing": "1"}))
+}
+
+func TestEventDecoder_DecodeCustomTypes(t *testing.T) {
+	t.Parallel()
+
+	decoder := newTestDecoder(t)
+	winner := bytes.Repeat([]byte{0xbb}, 32)
+	info := []byte{0, 0, 0, 4}
+	info = append(info, []byte("EGLD")...)
+	info = append(info, 1)
+	info = append(info, winner...)
+	info = append(info, 0, 0, 0, 2, 0, 5, 1, 0)
+
+	logEntry := &vmcommon.LogEntry{
+		Topics: [][]byte{[]byte("auctionState"), {1, 0, 0, 0, 3}},
+		Data:   [][]byte{info},
+	}
+
+	event, err := decoder.Decode(logEntry)
+	require.Nil(t, err)
+	require.Equal(t, "Closed[0: 3]", event.Field("status").Value.(*DecodedEnum).String())
+
+	fields := event.Field("info").Value.([]*DecodedField)
+	require.Equal(t, "EGLD", fields[0].Value)
+	require.Equal(t, HexBytes(winner), fields[1].Value)
+	require.Equal(t, []interface{}{uint64(5), uint64(256)}, fields[2].Value)
+
+	// an empty top-level enum is its first variant
+	logEntry.Topics[1] = []byte{}
+	event, err = decoder.Decode(logEntry)
+	require.Nil(t, err)
+	require.Equal(t, "Open", event.Field("status").Value.(*DecodedEnum).String())
+}
+
+func TestEventDecoder_ContractABITakesPrecedence(t *testing.T) {
+	t.Parallel()
+
+	decoder := newTestDecoder(t)
+	err := decoder.RegisterContractABI([]byte("other"), &ContractABI{
+		Events: []*EventDefinition{
+			{Identifier: "bid", Inputs: []*EventInput{{Name: "note", Type: "utf-8 string", Indexed: true}}},
+		},
+	})
+	require.Nil(t, err)
+
+	logEntry := &vmcommon.LogEntry{
+		Address: []byte("other"),
+		Topics:  [][]byte{[]byte("bid"), []byte("hello")},
+	}
+	event, err := decoder.Decode(logEntry)
+	require.Nil(t, err)
+	require.Equal(t, "hello", event.Field("note").Value)
+
+	err = decoder.RegisterContractABI([]byte("other"), nil)
+	require.Equal(t, ErrNilContractABI, err)
+}
+
+func TestEventDecoder_ProtocolEventIdentifiedByLogIdentifier(t *testing.T) {
+	t.Parallel()
+
+	decoder := NewEventDecoder()
+	err := decoder.RegisterABI(&ContractABI{
+		Events: []*EventDefinition{
+			{
+				Identifier: "ESDTTransfer",
+				Inputs: []*EventInput{
+					{Name: "token", Type: "TokenIdentifier", Indexed: true},
+					{Name: "nonce", Type: "u64", Indexed: true},
+					{Name: "value", Type: "BigUint", Indexed: true},
+				},
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	logEntry := &vmcommon.LogEntry{
+		Identifier: []byte("ESDTTransfer"),
+		Topics:     [][]byte{[]byte("TOKEN-123456"), {}, {0x01, 0x00}},
+	}
+	event, err := decoder.Decode(logEntry)
+	require.Nil(t, err)
+	require.True(t, event.Matches("ESDTTransfer", map[string]string{"token": "TOKEN-123456", "nonce": "0", "value": "256"}))
+}
+
+func TestEventDecoder_Errors(t *testing.T) {
+	t.Parallel()
+
+	decoder := newTestDecoder(t)
+
+	_, err := decoder.Decode(&vmcommon.LogEntry{Identifier: []byte("unknown")})
+	require.True(t, errors.Is(err, ErrUnknownEvent))
+
+	_, err = decoder.Decode(&vmcommon.LogEntry{Topics: [][]byte{[]byte("bid")}})
+	require.True(t, errors.Is(err, ErrMissingEventArgument))
+
+	_, err = decoder.Decode(&vmcommon.LogEntry{
+		Topics: [][]byte{[]byte("bid"), bytes.Repeat([]byte{1}, 31), {1}},
+		Data:   [][]byte{{1}},
+	})
+	require.True(t, errors.Is(err, ErrUnexpectedEndOfData))
+
+	_, err = decoder.Decode(&vmcommon.LogEntry{
+		Topics: [][]byte{[]byte("bid"), bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{1}, 9)},
+		Data:   [][]byte{{1}},
+	})
+	require.True(t, errors.Is(err, ErrTrailingData))
+
+	_, err = decoder.Decode(&vmcommon.LogEntry{
+		Topics: [][]byte{[]byte("auctionState"), {5}},
+		Data:   [][]byte{{}},
+	})
+	require.True(t, errors.Is(err, ErrUnknownEnumDiscriminant))
+
+	err = decoder.RegisterABI(&ContractABI{Events: []*EventDefinition{{Identifier: ""}}})
+	require.Equal(t, ErrEmptyEventIdentifier, err)
+}
+
+func TestEventDecoder_DecodeLogsSkipsUnknownEvents(t *testing.T) {
+	t.Parallel()
+
+	decoder := newTestDecoder(t)
+	logEntries := []*vmcommon.LogEntry{
+		{Identifier: []byte("writeLog"), Topics: [][]byte{[]byte("raw")}},
+		{Topics: [][]byte{[]byte("bid"), bytes.Repeat([]byte{1}, 32), {2}}, Data: [][]byte{{3}}},
+	}
+
+	events, err := decoder.DecodeLogs(logEntries)
+	require.Nil(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "bid", events[0].Identifier)
+}
+
+func TestValueDecoder_TopLevelSignedAndBool(t *testing.T) {
+	t.Parallel()
+
+	decoder := &valueDecoder{}
+
+	value, err := decoder.decodeTopLevel("i32", []byte{0xff})
+	require.Nil(t, err)
+	require.Equal(t, int64(-1), value)
+
+	value, err = decoder.decodeTopLevel("BigInt", []byte{0xff, 0x00})
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(-256), value)
+
+	value, err = decoder.decodeTopLevel("bool", []byte{})
+	require.Nil(t, err)
+	require.Equal(t, false, value)
+
+	_, err = decoder.decodeTopLevel("bool", []byte{2})
+	require.NotNil(t, err)
+
+	_, err = decoder.decodeTopLevel("Unknown", []byte{1})
+	require.True(t, errors.Is(err, ErrUnknownType))
+}
@@ -0,0 +1,27 @@
+package eventabi
+
+import "errors"
+
+// ErrNilContractABI signals that a nil contract ABI was provided
+var ErrNilContractABI = errors.New("nil contract ABI")
+
+// ErrEmptyEventIdentifier signals that an event of the ABI has no identifier
+var ErrEmptyEventIdentifier = errors.New("empty event identifier")
+
+// ErrUnknownEvent signals that no schema was registered for the event of a log entry
+var ErrUnknownEvent = errors.New("unknown event")
+
+// ErrUnknownType signals that a type is neither a known ABI type nor defined by the contract ABI
+var ErrUnknownType = errors.New("unknown ABI type")
+
+// ErrUnexpectedEndOfData signals that the encoded value is shorter than its type requires
+var ErrUnexpectedEndOfData = errors.New("unexpected end of data")
+
+// ErrTrailingData signals that the encoded value is longer than its type requires
+var ErrTrailingData = errors.New("trailing data after the decoded value")
+
+// ErrUnknownEnumDiscriminant signals that an enum value has a discriminant not defined by its type
+var ErrUnknownEnumDiscriminant = errors.New("unknown enum discriminant")
+
+// ErrMissingEventArgument signals that a log entry has fewer topics or data than its event schema
+var ErrMissingEventArgument = errors.New("missing event argument")
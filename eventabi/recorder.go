@@ -0,0 +1,94 @@
+package eventabi
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// EventRecorder decodes the log entries of a series of executions and keeps the
+// decoded events, along with the log entries it failed to decode
+type EventRecorder struct {
+	decoder *EventDecoder
+
+	mutEvents    sync.RWMutex
+	events       []*DecodedEvent
+	decodeErrors []string
+}
+
+// NewEventRecorder creates an EventRecorder decoding with the given EventDecoder
+func NewEventRecorder(decoder *EventDecoder) *EventRecorder {
+	return &EventRecorder{
+		decoder:      decoder,
+		events:       make([]*DecodedEvent, 0),
+		decodeErrors: make([]string, 0),
+	}
+}
+
+// RecordLogs decodes and keeps the log entries which have a registered event schema
+func (recorder *EventRecorder) RecordLogs(logEntries []*vmcommon.LogEntry) {
+	recorder.mutEvents.Lock()
+	defer recorder.mutEvents.Unlock()
+
+	for _, logEntry := range logEntries {
+		event, _ := recorder.decoder.findEvent(logEntry)
+		if event == nil {
+			continue
+		}
+
+		decodedEvent, err := recorder.decoder.Decode(logEntry)
+		if err != nil {
+			recorder.decodeErrors = append(recorder.decodeErrors, err.Error())
+			continue
+		}
+		recorder.events = append(recorder.events, decodedEvent)
+	}
+}
+
+// Events returns the decoded events, in the order of their executions
+func (recorder *EventRecorder) Events() []*DecodedEvent {
+	recorder.mutEvents.RLock()
+	defer recorder.mutEvents.RUnlock()
+
+	return append(make([]*DecodedEvent, 0, len(recorder.events)), recorder.events...)
+}
+
+// DecodeErrors returns the errors of the log entries which could not be decoded with their schema
+func (recorder *EventRecorder) DecodeErrors() []string {
+	recorder.mutEvents.RLock()
+	defer recorder.mutEvents.RUnlock()
+
+	return append(make([]string, 0, len(recorder.decodeErrors)), recorder.decodeErrors...)
+}
+
+// FindEvents returns the decoded events which match the identifier and the expected fields
+func (recorder *EventRecorder) FindEvents(identifier string, expectedFields map[string]string) []*DecodedEvent {
+	matchingEvents := make([]*DecodedEvent, 0)
+	for _, event := range recorder.Events() {
+		if event.Matches(identifier, expectedFields) {
+			matchingEvents = append(matchingEvents, event)
+		}
+	}
+
+	return matchingEvents
+}
+
+// WriteJSON writes the decoded events and the decoding errors to a JSON file
+func (recorder *EventRecorder) WriteJSON(path string) error {
+	trace := struct {
+		Events       []*DecodedEvent `json:"events"`
+		DecodeErrors []string        `json:"decodeErrors,omitempty"`
+	}{
+		Events:       recorder.Events(),
+		DecodeErrors: recorder.DecodeErrors(),
+	}
+
+	data, err := json.MarshalIndent(trace, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
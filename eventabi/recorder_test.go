@@ -0,0 +1,44 @@
+package eventabi
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventRecorder_RecordLogs(t *testing.T) {
+	t.Parallel()
+
+	recorder := NewEventRecorder(newTestDecoder(t))
+	recorder.RecordLogs([]*vmcommon.LogEntry{
+		{Topics: [][]byte{[]byte("bid"), bytes.Repeat([]byte{1}, 32), {2}}, Data: [][]byte{{3}}},
+		{Topics: [][]byte{[]byte("bid")}},
+		{Identifier: []byte("writeLog")},
+	})
+	recorder.RecordLogs([]*vmcommon.LogEntry{
+		{Topics: [][]byte{[]byte("bid"), bytes.Repeat([]byte{1}, 32), {4}}, Data: [][]byte{{5}}},
+	})
+
+	require.Len(t, recorder.Events(), 2)
+	require.Len(t, recorder.DecodeErrors(), 1)
+	require.Len(t, recorder.FindEvents("bid", map[string]string{"round": "4"}), 1)
+	require.Empty(t, recorder.FindEvents("bid", map[string]string{"round": "3"}))
+
+	path := filepath.Join(t.TempDir(), "events.json")
+	err := recorder.WriteJSON(path)
+	require.Nil(t, err)
+
+	data, err := os.ReadFile(path)
+	require.Nil(t, err)
+
+	trace := make(map[string]interface{})
+	err = json.Unmarshal(data, &trace)
+	require.Nil(t, err)
+	require.Len(t, trace["events"], 2)
+	require.Len(t, trace["decodeErrors"], 1)
+}
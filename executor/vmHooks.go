@@ -121,6 +121,7 @@ type ManagedVMHooks interface {
 	ManagedGetESDTTokenData(addressHandle int32, tokenIDHandle int32, nonce int64, valueHandle int32, propertiesHandle int32, hashHandle int32, nameHandle int32, attributesHandle int32, creatorHandle int32, royaltiesHandle int32, urisHandle int32)
 	ManagedAsyncCall(destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32)
 	ManagedCreateAsyncCall(destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, successOffset MemPtr, successLength MemLength, errorOffset MemPtr, errorLength MemLength, gas int64, extraGasForCallback int64, callbackClosureHandle int32) int32
+	ManagedCreateAsyncCallWithDeadline(destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, successOffset MemPtr, successLength MemLength, errorOffset MemPtr, errorLength MemLength, gas int64, extraGasForCallback int64, callbackClosureHandle int32, deadlineRound int64, deadlineTimestamp int64) int32
 	ManagedGetCallbackClosure(callbackClosureHandle int32)
 	ManagedUpgradeFromSourceContract(destHandle int32, gas int64, valueHandle int32, addressHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32)
 	ManagedUpgradeContract(destHandle int32, gas int64, valueHandle int32, codeHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32)
@@ -139,6 +140,13 @@ type ManagedVMHooks interface {
 	ManagedBufferToHex(sourceHandle int32, destHandle int32)
 	ManagedGetCodeMetadata(addressHandle int32, responseHandle int32)
 	ManagedIsBuiltinFunction(functionNameHandle int32) int32
+	ManagedUpdateNFTAttributes(tokenIDHandle int32, nonce int64, attributesHandle int32)
+	ManagedAddNFTURIs(tokenIDHandle int32, nonce int64, urisHandle int32)
+	ManagedModifyNFTRoyalties(tokenIDHandle int32, nonce int64, royalties int64)
+	ManagedGetCodeHash(addressHandle int32, resultHandle int32)
+	ManagedGetCodeMetadataFlags(addressHandle int32) int64
+	ManagedGetAccountNonce(addressHandle int32) int64
+	ManagedGetContractOwner(addressHandle int32, resultHandle int32)
 }
 
 type BigFloatVMHooks interface {
@@ -231,6 +239,9 @@ type ManagedBufferVMHooks interface {
 	MBufferFromBigFloat(mBufferHandle int32, bigFloatHandle int32) int32
 	MBufferStorageStore(keyHandle int32, sourceHandle int32) int32
 	MBufferStorageLoad(keyHandle int32, destinationHandle int32) int32
+	MBufferStorageIterateKeys(prefixHandle int32, cursorHandle int32, maxKeys int32, keysHandle int32) int32
+	MBufferTransientStore(keyHandle int32, sourceHandle int32) int32
+	MBufferTransientLoad(keyHandle int32, destinationHandle int32) int32
 	MBufferStorageLoadFromAddress(addressHandle int32, keyHandle int32, destinationHandle int32)
 	MBufferGetArgument(id int32, destinationHandle int32) int32
 	MBufferFinish(sourceHandle int32) int32
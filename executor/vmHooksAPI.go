@@ -0,0 +1,37 @@
+package executor
+
+import (
+	_ "embed"
+	"encoding/json"
+)
+
+//go:embed vmHooksAPI.json
+var vmHooksAPIDescription []byte
+
+// VMHookDescription describes one VM hook, as imported by contracts
+type VMHookDescription struct {
+	Name           string                        `json:"name"`
+	Group          string                        `json:"group"`
+	Parameters     []*VMHookParameterDescription `json:"parameters"`
+	Returns        string                        `json:"returns,omitempty"`
+	GasCostFields  []string                      `json:"gasCostFields"`
+	ActivationFlag string                        `json:"activationFlag,omitempty"`
+}
+
+// VMHookParameterDescription describes one parameter of a VM hook
+type VMHookParameterDescription struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+}
+
+// LoadVMHooksAPIDescription decodes the generated description of all the VM hooks, in vmHooksAPI.json
+func LoadVMHooksAPIDescription() ([]*VMHookDescription, error) {
+	description := struct {
+		Hooks []*VMHookDescription `json:"hooks"`
+	}{}
+	err := json.Unmarshal(vmHooksAPIDescription, &description)
+	if err != nil {
+		return nil, err
+	}
+	return description.Hooks, nil
+}
@@ -0,0 +1,82 @@
+package executorwrapper
+
+import (
+	"encoding/json"
+)
+
+// VMHookCallRecorder is an optional extension of the ExecutorLogger, which
+// receives every VM hook call as structured data instead of a formatted string.
+type VMHookCallRecorder interface {
+	RecordVMHookCall(call *VMHookCall)
+}
+
+// VMHookCall is a language-neutral record of a single VM hook call. The name
+// is the one imported by contracts, e.g. "bigIntAdd", and all the arguments
+// are integers (handles, memory offsets and lengths, or plain values).
+type VMHookCall struct {
+	Name      string  `json:"name"`
+	Arguments []int64 `json:"arguments"`
+	Result    *int64  `json:"result,omitempty"`
+}
+
+// GoldenRecorder is an ExecutorLogger which keeps the sequence of VM hook calls,
+// to be saved as a golden file and replayed against other executor implementations.
+type GoldenRecorder struct {
+	NoLogger
+	calls []*VMHookCall
+}
+
+// NewGoldenRecorder creates a recorder without any calls
+func NewGoldenRecorder() *GoldenRecorder {
+	return &GoldenRecorder{
+		calls: make([]*VMHookCall, 0),
+	}
+}
+
+// RecordVMHookCall appends the call to the recorded sequence
+func (gr *GoldenRecorder) RecordVMHookCall(call *VMHookCall) {
+	gr.calls = append(gr.calls, call)
+}
+
+// Calls returns the recorded sequence of calls
+func (gr *GoldenRecorder) Calls() []*VMHookCall {
+	return gr.calls
+}
+
+// MarshalGolden serializes the recorded calls as indented JSON, one call per entry
+func (gr *GoldenRecorder) MarshalGolden() ([]byte, error) {
+	return json.MarshalIndent(gr.calls, "", "  ")
+}
+
+// UnmarshalGoldenCalls deserializes calls previously serialized with MarshalGolden
+func UnmarshalGoldenCalls(data []byte) ([]*VMHookCall, error) {
+	calls := make([]*VMHookCall, 0)
+	err := json.Unmarshal(data, &calls)
+	if err != nil {
+		return nil, err
+	}
+	return calls, nil
+}
+
+func (w *WrapperVMHooks) recordCall(name string, arguments []int64) {
+	recorder, ok := w.logger.(VMHookCallRecorder)
+	if !ok {
+		return
+	}
+	recorder.RecordVMHookCall(&VMHookCall{
+		Name:      name,
+		Arguments: arguments,
+	})
+}
+
+func (w *WrapperVMHooks) recordCallWithResult(name string, arguments []int64, result int64) {
+	recorder, ok := w.logger.(VMHookCallRecorder)
+	if !ok {
+		return
+	}
+	recorder.RecordVMHookCall(&VMHookCall{
+		Name:      name,
+		Arguments: arguments,
+		Result:    &result,
+	})
+}
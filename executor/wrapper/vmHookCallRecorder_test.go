@@ -0,0 +1,58 @@
+package executorwrapper
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/stretchr/testify/require"
+)
+
+type vmHooksStub struct {
+	executor.VMHooks
+}
+
+func (stub *vmHooksStub) GetGasLeft() int64 {
+	return 42
+}
+
+func (stub *vmHooksStub) BigIntAdd(_ int32, _ int32, _ int32) {
+}
+
+func TestGoldenRecorder_RecordsCalls(t *testing.T) {
+	t.Parallel()
+
+	recorder := NewGoldenRecorder()
+	vmHooks := &WrapperVMHooks{
+		logger:         recorder,
+		wrappedVMHooks: &vmHooksStub{},
+	}
+
+	require.Equal(t, int64(42), vmHooks.GetGasLeft())
+	vmHooks.BigIntAdd(1, 2, 3)
+
+	gasLeft := int64(42)
+	expectedCalls := []*VMHookCall{
+		{Name: "getGasLeft", Arguments: []int64{}, Result: &gasLeft},
+		{Name: "bigIntAdd", Arguments: []int64{1, 2, 3}},
+	}
+	require.Equal(t, expectedCalls, recorder.Calls())
+
+	serialized, err := recorder.MarshalGolden()
+	require.Nil(t, err)
+	calls, err := UnmarshalGoldenCalls(serialized)
+	require.Nil(t, err)
+	require.Equal(t, expectedCalls, calls)
+}
+
+func TestGoldenRecorder_IgnoredByOtherLoggers(t *testing.T) {
+	t.Parallel()
+
+	logger := NewStringLogger()
+	vmHooks := &WrapperVMHooks{
+		logger:         logger,
+		wrappedVMHooks: &vmHooksStub{},
+	}
+
+	vmHooks.BigIntAdd(1, 2, 3)
+	require.Contains(t, logger.String(), "BigIntAdd(1, 2, 3)")
+}
@@ -24,6 +24,7 @@ func (w *WrapperVMHooks) GetGasLeft() int64 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetGasLeft()
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getGasLeft", []int64{}, int64(result))
 	return result
 }
 
@@ -33,6 +34,7 @@ func (w *WrapperVMHooks) GetSCAddress(resultOffset executor.MemPtr) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.GetSCAddress(resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("getSCAddress", []int64{int64(resultOffset)})
 }
 
 // GetOwnerAddress VM hook wrapper
@@ -41,6 +43,7 @@ func (w *WrapperVMHooks) GetOwnerAddress(resultOffset executor.MemPtr) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.GetOwnerAddress(resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("getOwnerAddress", []int64{int64(resultOffset)})
 }
 
 // GetShardOfAddress VM hook wrapper
@@ -49,6 +52,7 @@ func (w *WrapperVMHooks) GetShardOfAddress(addressOffset executor.MemPtr) int32
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetShardOfAddress(addressOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getShardOfAddress", []int64{int64(addressOffset)}, int64(result))
 	return result
 }
 
@@ -58,6 +62,7 @@ func (w *WrapperVMHooks) IsSmartContract(addressOffset executor.MemPtr) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.IsSmartContract(addressOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("isSmartContract", []int64{int64(addressOffset)}, int64(result))
 	return result
 }
 
@@ -67,6 +72,7 @@ func (w *WrapperVMHooks) SignalError(messageOffset executor.MemPtr, messageLengt
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.SignalError(messageOffset, messageLength)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("signalError", []int64{int64(messageOffset), int64(messageLength)})
 }
 
 // GetExternalBalance VM hook wrapper
@@ -75,6 +81,7 @@ func (w *WrapperVMHooks) GetExternalBalance(addressOffset executor.MemPtr, resul
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.GetExternalBalance(addressOffset, resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("getExternalBalance", []int64{int64(addressOffset), int64(resultOffset)})
 }
 
 // GetBlockHash VM hook wrapper
@@ -83,6 +90,7 @@ func (w *WrapperVMHooks) GetBlockHash(nonce int64, resultOffset executor.MemPtr)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetBlockHash(nonce, resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getBlockHash", []int64{int64(nonce), int64(resultOffset)}, int64(result))
 	return result
 }
 
@@ -92,6 +100,7 @@ func (w *WrapperVMHooks) GetESDTBalance(addressOffset executor.MemPtr, tokenIDOf
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetESDTBalance(addressOffset, tokenIDOffset, tokenIDLen, nonce, resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getESDTBalance", []int64{int64(addressOffset), int64(tokenIDOffset), int64(tokenIDLen), int64(nonce), int64(resultOffset)}, int64(result))
 	return result
 }
 
@@ -101,6 +110,7 @@ func (w *WrapperVMHooks) GetESDTNFTNameLength(addressOffset executor.MemPtr, tok
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetESDTNFTNameLength(addressOffset, tokenIDOffset, tokenIDLen, nonce)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getESDTNFTNameLength", []int64{int64(addressOffset), int64(tokenIDOffset), int64(tokenIDLen), int64(nonce)}, int64(result))
 	return result
 }
 
@@ -110,6 +120,7 @@ func (w *WrapperVMHooks) GetESDTNFTAttributeLength(addressOffset executor.MemPtr
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetESDTNFTAttributeLength(addressOffset, tokenIDOffset, tokenIDLen, nonce)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getESDTNFTAttributeLength", []int64{int64(addressOffset), int64(tokenIDOffset), int64(tokenIDLen), int64(nonce)}, int64(result))
 	return result
 }
 
@@ -119,6 +130,7 @@ func (w *WrapperVMHooks) GetESDTNFTURILength(addressOffset executor.MemPtr, toke
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetESDTNFTURILength(addressOffset, tokenIDOffset, tokenIDLen, nonce)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getESDTNFTURILength", []int64{int64(addressOffset), int64(tokenIDOffset), int64(tokenIDLen), int64(nonce)}, int64(result))
 	return result
 }
 
@@ -128,6 +140,7 @@ func (w *WrapperVMHooks) GetESDTTokenData(addressOffset executor.MemPtr, tokenID
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetESDTTokenData(addressOffset, tokenIDOffset, tokenIDLen, nonce, valueHandle, propertiesOffset, hashOffset, nameOffset, attributesOffset, creatorOffset, royaltiesHandle, urisOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getESDTTokenData", []int64{int64(addressOffset), int64(tokenIDOffset), int64(tokenIDLen), int64(nonce), int64(valueHandle), int64(propertiesOffset), int64(hashOffset), int64(nameOffset), int64(attributesOffset), int64(creatorOffset), int64(royaltiesHandle), int64(urisOffset)}, int64(result))
 	return result
 }
 
@@ -137,6 +150,7 @@ func (w *WrapperVMHooks) GetESDTLocalRoles(tokenIdHandle int32) int64 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetESDTLocalRoles(tokenIdHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getESDTLocalRoles", []int64{int64(tokenIdHandle)}, int64(result))
 	return result
 }
 
@@ -146,6 +160,7 @@ func (w *WrapperVMHooks) ValidateTokenIdentifier(tokenIdHandle int32) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ValidateTokenIdentifier(tokenIdHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("validateTokenIdentifier", []int64{int64(tokenIdHandle)}, int64(result))
 	return result
 }
 
@@ -155,6 +170,7 @@ func (w *WrapperVMHooks) TransferValue(destOffset executor.MemPtr, valueOffset e
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.TransferValue(destOffset, valueOffset, dataOffset, length)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("transferValue", []int64{int64(destOffset), int64(valueOffset), int64(dataOffset), int64(length)}, int64(result))
 	return result
 }
 
@@ -164,6 +180,7 @@ func (w *WrapperVMHooks) TransferValueExecute(destOffset executor.MemPtr, valueO
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.TransferValueExecute(destOffset, valueOffset, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("transferValueExecute", []int64{int64(destOffset), int64(valueOffset), int64(gasLimit), int64(functionOffset), int64(functionLength), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)}, int64(result))
 	return result
 }
 
@@ -173,6 +190,7 @@ func (w *WrapperVMHooks) TransferESDTExecute(destOffset executor.MemPtr, tokenID
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.TransferESDTExecute(destOffset, tokenIDOffset, tokenIDLen, valueOffset, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("transferESDTExecute", []int64{int64(destOffset), int64(tokenIDOffset), int64(tokenIDLen), int64(valueOffset), int64(gasLimit), int64(functionOffset), int64(functionLength), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)}, int64(result))
 	return result
 }
 
@@ -182,6 +200,7 @@ func (w *WrapperVMHooks) TransferESDTNFTExecute(destOffset executor.MemPtr, toke
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.TransferESDTNFTExecute(destOffset, tokenIDOffset, tokenIDLen, valueOffset, nonce, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("transferESDTNFTExecute", []int64{int64(destOffset), int64(tokenIDOffset), int64(tokenIDLen), int64(valueOffset), int64(nonce), int64(gasLimit), int64(functionOffset), int64(functionLength), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)}, int64(result))
 	return result
 }
 
@@ -191,6 +210,7 @@ func (w *WrapperVMHooks) MultiTransferESDTNFTExecute(destOffset executor.MemPtr,
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MultiTransferESDTNFTExecute(destOffset, numTokenTransfers, tokenTransfersArgsLengthOffset, tokenTransferDataOffset, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("multiTransferESDTNFTExecute", []int64{int64(destOffset), int64(numTokenTransfers), int64(tokenTransfersArgsLengthOffset), int64(tokenTransferDataOffset), int64(gasLimit), int64(functionOffset), int64(functionLength), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)}, int64(result))
 	return result
 }
 
@@ -200,6 +220,7 @@ func (w *WrapperVMHooks) CreateAsyncCall(destOffset executor.MemPtr, valueOffset
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.CreateAsyncCall(destOffset, valueOffset, dataOffset, dataLength, successOffset, successLength, errorOffset, errorLength, gas, extraGasForCallback)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("createAsyncCall", []int64{int64(destOffset), int64(valueOffset), int64(dataOffset), int64(dataLength), int64(successOffset), int64(successLength), int64(errorOffset), int64(errorLength), int64(gas), int64(extraGasForCallback)}, int64(result))
 	return result
 }
 
@@ -209,6 +230,7 @@ func (w *WrapperVMHooks) SetAsyncContextCallback(callback executor.MemPtr, callb
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.SetAsyncContextCallback(callback, callbackLength, data, dataLength, gas)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("setAsyncContextCallback", []int64{int64(callback), int64(callbackLength), int64(data), int64(dataLength), int64(gas)}, int64(result))
 	return result
 }
 
@@ -218,6 +240,7 @@ func (w *WrapperVMHooks) UpgradeContract(destOffset executor.MemPtr, gasLimit in
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.UpgradeContract(destOffset, gasLimit, valueOffset, codeOffset, codeMetadataOffset, length, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("upgradeContract", []int64{int64(destOffset), int64(gasLimit), int64(valueOffset), int64(codeOffset), int64(codeMetadataOffset), int64(length), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)})
 }
 
 // UpgradeFromSourceContract VM hook wrapper
@@ -226,6 +249,7 @@ func (w *WrapperVMHooks) UpgradeFromSourceContract(destOffset executor.MemPtr, g
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.UpgradeFromSourceContract(destOffset, gasLimit, valueOffset, sourceContractAddressOffset, codeMetadataOffset, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("upgradeFromSourceContract", []int64{int64(destOffset), int64(gasLimit), int64(valueOffset), int64(sourceContractAddressOffset), int64(codeMetadataOffset), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)})
 }
 
 // DeleteContract VM hook wrapper
@@ -234,6 +258,7 @@ func (w *WrapperVMHooks) DeleteContract(destOffset executor.MemPtr, gasLimit int
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.DeleteContract(destOffset, gasLimit, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("deleteContract", []int64{int64(destOffset), int64(gasLimit), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)})
 }
 
 // AsyncCall VM hook wrapper
@@ -242,6 +267,7 @@ func (w *WrapperVMHooks) AsyncCall(destOffset executor.MemPtr, valueOffset execu
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.AsyncCall(destOffset, valueOffset, dataOffset, length)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("asyncCall", []int64{int64(destOffset), int64(valueOffset), int64(dataOffset), int64(length)})
 }
 
 // GetArgumentLength VM hook wrapper
@@ -250,6 +276,7 @@ func (w *WrapperVMHooks) GetArgumentLength(id int32) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetArgumentLength(id)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getArgumentLength", []int64{int64(id)}, int64(result))
 	return result
 }
 
@@ -259,6 +286,7 @@ func (w *WrapperVMHooks) GetArgument(id int32, argOffset executor.MemPtr) int32
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetArgument(id, argOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getArgument", []int64{int64(id), int64(argOffset)}, int64(result))
 	return result
 }
 
@@ -268,6 +296,7 @@ func (w *WrapperVMHooks) GetFunction(functionOffset executor.MemPtr) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetFunction(functionOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getFunction", []int64{int64(functionOffset)}, int64(result))
 	return result
 }
 
@@ -277,6 +306,7 @@ func (w *WrapperVMHooks) GetNumArguments() int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetNumArguments()
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getNumArguments", []int64{}, int64(result))
 	return result
 }
 
@@ -286,6 +316,7 @@ func (w *WrapperVMHooks) StorageStore(keyOffset executor.MemPtr, keyLength execu
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.StorageStore(keyOffset, keyLength, dataOffset, dataLength)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("storageStore", []int64{int64(keyOffset), int64(keyLength), int64(dataOffset), int64(dataLength)}, int64(result))
 	return result
 }
 
@@ -295,6 +326,7 @@ func (w *WrapperVMHooks) StorageLoadLength(keyOffset executor.MemPtr, keyLength
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.StorageLoadLength(keyOffset, keyLength)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("storageLoadLength", []int64{int64(keyOffset), int64(keyLength)}, int64(result))
 	return result
 }
 
@@ -304,6 +336,7 @@ func (w *WrapperVMHooks) StorageLoadFromAddress(addressOffset executor.MemPtr, k
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.StorageLoadFromAddress(addressOffset, keyOffset, keyLength, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("storageLoadFromAddress", []int64{int64(addressOffset), int64(keyOffset), int64(keyLength), int64(dataOffset)}, int64(result))
 	return result
 }
 
@@ -313,6 +346,7 @@ func (w *WrapperVMHooks) StorageLoad(keyOffset executor.MemPtr, keyLength execut
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.StorageLoad(keyOffset, keyLength, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("storageLoad", []int64{int64(keyOffset), int64(keyLength), int64(dataOffset)}, int64(result))
 	return result
 }
 
@@ -322,6 +356,7 @@ func (w *WrapperVMHooks) SetStorageLock(keyOffset executor.MemPtr, keyLength exe
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.SetStorageLock(keyOffset, keyLength, lockTimestamp)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("setStorageLock", []int64{int64(keyOffset), int64(keyLength), int64(lockTimestamp)}, int64(result))
 	return result
 }
 
@@ -331,6 +366,7 @@ func (w *WrapperVMHooks) GetStorageLock(keyOffset executor.MemPtr, keyLength exe
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetStorageLock(keyOffset, keyLength)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getStorageLock", []int64{int64(keyOffset), int64(keyLength)}, int64(result))
 	return result
 }
 
@@ -340,6 +376,7 @@ func (w *WrapperVMHooks) IsStorageLocked(keyOffset executor.MemPtr, keyLength ex
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.IsStorageLocked(keyOffset, keyLength)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("isStorageLocked", []int64{int64(keyOffset), int64(keyLength)}, int64(result))
 	return result
 }
 
@@ -349,6 +386,7 @@ func (w *WrapperVMHooks) ClearStorageLock(keyOffset executor.MemPtr, keyLength e
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ClearStorageLock(keyOffset, keyLength)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("clearStorageLock", []int64{int64(keyOffset), int64(keyLength)}, int64(result))
 	return result
 }
 
@@ -358,6 +396,7 @@ func (w *WrapperVMHooks) GetCaller(resultOffset executor.MemPtr) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.GetCaller(resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("getCaller", []int64{int64(resultOffset)})
 }
 
 // CheckNoPayment VM hook wrapper
@@ -366,6 +405,7 @@ func (w *WrapperVMHooks) CheckNoPayment() {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.CheckNoPayment()
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("checkNoPayment", []int64{})
 }
 
 // GetCallValue VM hook wrapper
@@ -374,6 +414,7 @@ func (w *WrapperVMHooks) GetCallValue(resultOffset executor.MemPtr) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetCallValue(resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getCallValue", []int64{int64(resultOffset)}, int64(result))
 	return result
 }
 
@@ -383,6 +424,7 @@ func (w *WrapperVMHooks) GetESDTValue(resultOffset executor.MemPtr) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetESDTValue(resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getESDTValue", []int64{int64(resultOffset)}, int64(result))
 	return result
 }
 
@@ -392,6 +434,7 @@ func (w *WrapperVMHooks) GetESDTValueByIndex(resultOffset executor.MemPtr, index
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetESDTValueByIndex(resultOffset, index)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getESDTValueByIndex", []int64{int64(resultOffset), int64(index)}, int64(result))
 	return result
 }
 
@@ -401,6 +444,7 @@ func (w *WrapperVMHooks) GetESDTTokenName(resultOffset executor.MemPtr) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetESDTTokenName(resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getESDTTokenName", []int64{int64(resultOffset)}, int64(result))
 	return result
 }
 
@@ -410,6 +454,7 @@ func (w *WrapperVMHooks) GetESDTTokenNameByIndex(resultOffset executor.MemPtr, i
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetESDTTokenNameByIndex(resultOffset, index)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getESDTTokenNameByIndex", []int64{int64(resultOffset), int64(index)}, int64(result))
 	return result
 }
 
@@ -419,6 +464,7 @@ func (w *WrapperVMHooks) GetESDTTokenNonce() int64 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetESDTTokenNonce()
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getESDTTokenNonce", []int64{}, int64(result))
 	return result
 }
 
@@ -428,6 +474,7 @@ func (w *WrapperVMHooks) GetESDTTokenNonceByIndex(index int32) int64 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetESDTTokenNonceByIndex(index)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getESDTTokenNonceByIndex", []int64{int64(index)}, int64(result))
 	return result
 }
 
@@ -437,6 +484,7 @@ func (w *WrapperVMHooks) GetCurrentESDTNFTNonce(addressOffset executor.MemPtr, t
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetCurrentESDTNFTNonce(addressOffset, tokenIDOffset, tokenIDLen)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getCurrentESDTNFTNonce", []int64{int64(addressOffset), int64(tokenIDOffset), int64(tokenIDLen)}, int64(result))
 	return result
 }
 
@@ -446,6 +494,7 @@ func (w *WrapperVMHooks) GetESDTTokenType() int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetESDTTokenType()
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getESDTTokenType", []int64{}, int64(result))
 	return result
 }
 
@@ -455,6 +504,7 @@ func (w *WrapperVMHooks) GetESDTTokenTypeByIndex(index int32) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetESDTTokenTypeByIndex(index)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getESDTTokenTypeByIndex", []int64{int64(index)}, int64(result))
 	return result
 }
 
@@ -464,6 +514,7 @@ func (w *WrapperVMHooks) GetNumESDTTransfers() int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetNumESDTTransfers()
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getNumESDTTransfers", []int64{}, int64(result))
 	return result
 }
 
@@ -473,6 +524,7 @@ func (w *WrapperVMHooks) GetCallValueTokenName(callValueOffset executor.MemPtr,
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetCallValueTokenName(callValueOffset, tokenNameOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getCallValueTokenName", []int64{int64(callValueOffset), int64(tokenNameOffset)}, int64(result))
 	return result
 }
 
@@ -482,6 +534,7 @@ func (w *WrapperVMHooks) GetCallValueTokenNameByIndex(callValueOffset executor.M
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetCallValueTokenNameByIndex(callValueOffset, tokenNameOffset, index)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getCallValueTokenNameByIndex", []int64{int64(callValueOffset), int64(tokenNameOffset), int64(index)}, int64(result))
 	return result
 }
 
@@ -491,6 +544,7 @@ func (w *WrapperVMHooks) IsReservedFunctionName(nameHandle int32) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.IsReservedFunctionName(nameHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("isReservedFunctionName", []int64{int64(nameHandle)}, int64(result))
 	return result
 }
 
@@ -500,6 +554,7 @@ func (w *WrapperVMHooks) WriteLog(dataPointer executor.MemPtr, dataLength execut
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.WriteLog(dataPointer, dataLength, topicPtr, numTopics)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("writeLog", []int64{int64(dataPointer), int64(dataLength), int64(topicPtr), int64(numTopics)})
 }
 
 // WriteEventLog VM hook wrapper
@@ -508,6 +563,7 @@ func (w *WrapperVMHooks) WriteEventLog(numTopics int32, topicLengthsOffset execu
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.WriteEventLog(numTopics, topicLengthsOffset, topicOffset, dataOffset, dataLength)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("writeEventLog", []int64{int64(numTopics), int64(topicLengthsOffset), int64(topicOffset), int64(dataOffset), int64(dataLength)})
 }
 
 // GetBlockTimestamp VM hook wrapper
@@ -516,6 +572,7 @@ func (w *WrapperVMHooks) GetBlockTimestamp() int64 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetBlockTimestamp()
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getBlockTimestamp", []int64{}, int64(result))
 	return result
 }
 
@@ -525,6 +582,7 @@ func (w *WrapperVMHooks) GetBlockNonce() int64 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetBlockNonce()
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getBlockNonce", []int64{}, int64(result))
 	return result
 }
 
@@ -534,6 +592,7 @@ func (w *WrapperVMHooks) GetBlockRound() int64 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetBlockRound()
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getBlockRound", []int64{}, int64(result))
 	return result
 }
 
@@ -543,6 +602,7 @@ func (w *WrapperVMHooks) GetBlockEpoch() int64 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetBlockEpoch()
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getBlockEpoch", []int64{}, int64(result))
 	return result
 }
 
@@ -552,6 +612,7 @@ func (w *WrapperVMHooks) GetBlockRandomSeed(pointer executor.MemPtr) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.GetBlockRandomSeed(pointer)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("getBlockRandomSeed", []int64{int64(pointer)})
 }
 
 // GetStateRootHash VM hook wrapper
@@ -560,6 +621,7 @@ func (w *WrapperVMHooks) GetStateRootHash(pointer executor.MemPtr) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.GetStateRootHash(pointer)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("getStateRootHash", []int64{int64(pointer)})
 }
 
 // GetPrevBlockTimestamp VM hook wrapper
@@ -568,6 +630,7 @@ func (w *WrapperVMHooks) GetPrevBlockTimestamp() int64 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetPrevBlockTimestamp()
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getPrevBlockTimestamp", []int64{}, int64(result))
 	return result
 }
 
@@ -577,6 +640,7 @@ func (w *WrapperVMHooks) GetPrevBlockNonce() int64 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetPrevBlockNonce()
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getPrevBlockNonce", []int64{}, int64(result))
 	return result
 }
 
@@ -586,6 +650,7 @@ func (w *WrapperVMHooks) GetPrevBlockRound() int64 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetPrevBlockRound()
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getPrevBlockRound", []int64{}, int64(result))
 	return result
 }
 
@@ -595,6 +660,7 @@ func (w *WrapperVMHooks) GetPrevBlockEpoch() int64 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetPrevBlockEpoch()
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getPrevBlockEpoch", []int64{}, int64(result))
 	return result
 }
 
@@ -604,6 +670,7 @@ func (w *WrapperVMHooks) GetPrevBlockRandomSeed(pointer executor.MemPtr) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.GetPrevBlockRandomSeed(pointer)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("getPrevBlockRandomSeed", []int64{int64(pointer)})
 }
 
 // Finish VM hook wrapper
@@ -612,6 +679,7 @@ func (w *WrapperVMHooks) Finish(pointer executor.MemPtr, length executor.MemLeng
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.Finish(pointer, length)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("finish", []int64{int64(pointer), int64(length)})
 }
 
 // ExecuteOnSameContext VM hook wrapper
@@ -620,6 +688,7 @@ func (w *WrapperVMHooks) ExecuteOnSameContext(gasLimit int64, addressOffset exec
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ExecuteOnSameContext(gasLimit, addressOffset, valueOffset, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("executeOnSameContext", []int64{int64(gasLimit), int64(addressOffset), int64(valueOffset), int64(functionOffset), int64(functionLength), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)}, int64(result))
 	return result
 }
 
@@ -629,6 +698,7 @@ func (w *WrapperVMHooks) ExecuteOnDestContext(gasLimit int64, addressOffset exec
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ExecuteOnDestContext(gasLimit, addressOffset, valueOffset, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("executeOnDestContext", []int64{int64(gasLimit), int64(addressOffset), int64(valueOffset), int64(functionOffset), int64(functionLength), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)}, int64(result))
 	return result
 }
 
@@ -638,6 +708,7 @@ func (w *WrapperVMHooks) ExecuteReadOnly(gasLimit int64, addressOffset executor.
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ExecuteReadOnly(gasLimit, addressOffset, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("executeReadOnly", []int64{int64(gasLimit), int64(addressOffset), int64(functionOffset), int64(functionLength), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)}, int64(result))
 	return result
 }
 
@@ -647,6 +718,7 @@ func (w *WrapperVMHooks) CreateContract(gasLimit int64, valueOffset executor.Mem
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.CreateContract(gasLimit, valueOffset, codeOffset, codeMetadataOffset, length, resultOffset, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("createContract", []int64{int64(gasLimit), int64(valueOffset), int64(codeOffset), int64(codeMetadataOffset), int64(length), int64(resultOffset), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)}, int64(result))
 	return result
 }
 
@@ -656,6 +728,7 @@ func (w *WrapperVMHooks) DeployFromSourceContract(gasLimit int64, valueOffset ex
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.DeployFromSourceContract(gasLimit, valueOffset, sourceContractAddressOffset, codeMetadataOffset, resultAddressOffset, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("deployFromSourceContract", []int64{int64(gasLimit), int64(valueOffset), int64(sourceContractAddressOffset), int64(codeMetadataOffset), int64(resultAddressOffset), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)}, int64(result))
 	return result
 }
 
@@ -665,6 +738,7 @@ func (w *WrapperVMHooks) GetNumReturnData() int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetNumReturnData()
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getNumReturnData", []int64{}, int64(result))
 	return result
 }
 
@@ -674,6 +748,7 @@ func (w *WrapperVMHooks) GetReturnDataSize(resultID int32) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetReturnDataSize(resultID)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getReturnDataSize", []int64{int64(resultID)}, int64(result))
 	return result
 }
 
@@ -683,6 +758,7 @@ func (w *WrapperVMHooks) GetReturnData(resultID int32, dataOffset executor.MemPt
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetReturnData(resultID, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getReturnData", []int64{int64(resultID), int64(dataOffset)}, int64(result))
 	return result
 }
 
@@ -692,6 +768,7 @@ func (w *WrapperVMHooks) CleanReturnData() {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.CleanReturnData()
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("cleanReturnData", []int64{})
 }
 
 // DeleteFromReturnData VM hook wrapper
@@ -700,6 +777,7 @@ func (w *WrapperVMHooks) DeleteFromReturnData(resultID int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.DeleteFromReturnData(resultID)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("deleteFromReturnData", []int64{int64(resultID)})
 }
 
 // GetOriginalTxHash VM hook wrapper
@@ -708,6 +786,7 @@ func (w *WrapperVMHooks) GetOriginalTxHash(dataOffset executor.MemPtr) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.GetOriginalTxHash(dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("getOriginalTxHash", []int64{int64(dataOffset)})
 }
 
 // GetCurrentTxHash VM hook wrapper
@@ -716,6 +795,7 @@ func (w *WrapperVMHooks) GetCurrentTxHash(dataOffset executor.MemPtr) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.GetCurrentTxHash(dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("getCurrentTxHash", []int64{int64(dataOffset)})
 }
 
 // GetPrevTxHash VM hook wrapper
@@ -724,6 +804,7 @@ func (w *WrapperVMHooks) GetPrevTxHash(dataOffset executor.MemPtr) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.GetPrevTxHash(dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("getPrevTxHash", []int64{int64(dataOffset)})
 }
 
 // ManagedSCAddress VM hook wrapper
@@ -732,6 +813,7 @@ func (w *WrapperVMHooks) ManagedSCAddress(destinationHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedSCAddress(destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedSCAddress", []int64{int64(destinationHandle)})
 }
 
 // ManagedOwnerAddress VM hook wrapper
@@ -740,6 +822,7 @@ func (w *WrapperVMHooks) ManagedOwnerAddress(destinationHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedOwnerAddress(destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedOwnerAddress", []int64{int64(destinationHandle)})
 }
 
 // ManagedCaller VM hook wrapper
@@ -748,6 +831,7 @@ func (w *WrapperVMHooks) ManagedCaller(destinationHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedCaller(destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedCaller", []int64{int64(destinationHandle)})
 }
 
 // ManagedGetOriginalCallerAddr VM hook wrapper
@@ -756,6 +840,7 @@ func (w *WrapperVMHooks) ManagedGetOriginalCallerAddr(destinationHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetOriginalCallerAddr(destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedGetOriginalCallerAddr", []int64{int64(destinationHandle)})
 }
 
 // ManagedGetRelayerAddr VM hook wrapper
@@ -764,6 +849,7 @@ func (w *WrapperVMHooks) ManagedGetRelayerAddr(destinationHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetRelayerAddr(destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedGetRelayerAddr", []int64{int64(destinationHandle)})
 }
 
 // ManagedSignalError VM hook wrapper
@@ -772,6 +858,7 @@ func (w *WrapperVMHooks) ManagedSignalError(errHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedSignalError(errHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedSignalError", []int64{int64(errHandle)})
 }
 
 // ManagedWriteLog VM hook wrapper
@@ -780,6 +867,7 @@ func (w *WrapperVMHooks) ManagedWriteLog(topicsHandle int32, dataHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedWriteLog(topicsHandle, dataHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedWriteLog", []int64{int64(topicsHandle), int64(dataHandle)})
 }
 
 // ManagedGetOriginalTxHash VM hook wrapper
@@ -788,6 +876,7 @@ func (w *WrapperVMHooks) ManagedGetOriginalTxHash(resultHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetOriginalTxHash(resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedGetOriginalTxHash", []int64{int64(resultHandle)})
 }
 
 // ManagedGetStateRootHash VM hook wrapper
@@ -796,6 +885,7 @@ func (w *WrapperVMHooks) ManagedGetStateRootHash(resultHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetStateRootHash(resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedGetStateRootHash", []int64{int64(resultHandle)})
 }
 
 // ManagedGetBlockRandomSeed VM hook wrapper
@@ -804,6 +894,7 @@ func (w *WrapperVMHooks) ManagedGetBlockRandomSeed(resultHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetBlockRandomSeed(resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedGetBlockRandomSeed", []int64{int64(resultHandle)})
 }
 
 // ManagedGetPrevBlockRandomSeed VM hook wrapper
@@ -812,6 +903,7 @@ func (w *WrapperVMHooks) ManagedGetPrevBlockRandomSeed(resultHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetPrevBlockRandomSeed(resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedGetPrevBlockRandomSeed", []int64{int64(resultHandle)})
 }
 
 // ManagedGetReturnData VM hook wrapper
@@ -820,6 +912,7 @@ func (w *WrapperVMHooks) ManagedGetReturnData(resultID int32, resultHandle int32
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetReturnData(resultID, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedGetReturnData", []int64{int64(resultID), int64(resultHandle)})
 }
 
 // ManagedGetMultiESDTCallValue VM hook wrapper
@@ -828,6 +921,7 @@ func (w *WrapperVMHooks) ManagedGetMultiESDTCallValue(multiCallValueHandle int32
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetMultiESDTCallValue(multiCallValueHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedGetMultiESDTCallValue", []int64{int64(multiCallValueHandle)})
 }
 
 // ManagedGetBackTransfers VM hook wrapper
@@ -836,6 +930,7 @@ func (w *WrapperVMHooks) ManagedGetBackTransfers(esdtTransfersValueHandle int32,
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetBackTransfers(esdtTransfersValueHandle, egldValueHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedGetBackTransfers", []int64{int64(esdtTransfersValueHandle), int64(egldValueHandle)})
 }
 
 // ManagedGetESDTBalance VM hook wrapper
@@ -844,6 +939,7 @@ func (w *WrapperVMHooks) ManagedGetESDTBalance(addressHandle int32, tokenIDHandl
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetESDTBalance(addressHandle, tokenIDHandle, nonce, valueHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedGetESDTBalance", []int64{int64(addressHandle), int64(tokenIDHandle), int64(nonce), int64(valueHandle)})
 }
 
 // ManagedGetESDTTokenData VM hook wrapper
@@ -852,6 +948,7 @@ func (w *WrapperVMHooks) ManagedGetESDTTokenData(addressHandle int32, tokenIDHan
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetESDTTokenData(addressHandle, tokenIDHandle, nonce, valueHandle, propertiesHandle, hashHandle, nameHandle, attributesHandle, creatorHandle, royaltiesHandle, urisHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedGetESDTTokenData", []int64{int64(addressHandle), int64(tokenIDHandle), int64(nonce), int64(valueHandle), int64(propertiesHandle), int64(hashHandle), int64(nameHandle), int64(attributesHandle), int64(creatorHandle), int64(royaltiesHandle), int64(urisHandle)})
 }
 
 // ManagedAsyncCall VM hook wrapper
@@ -860,6 +957,7 @@ func (w *WrapperVMHooks) ManagedAsyncCall(destHandle int32, valueHandle int32, f
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedAsyncCall(destHandle, valueHandle, functionHandle, argumentsHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedAsyncCall", []int64{int64(destHandle), int64(valueHandle), int64(functionHandle), int64(argumentsHandle)})
 }
 
 // ManagedCreateAsyncCall VM hook wrapper
@@ -868,6 +966,17 @@ func (w *WrapperVMHooks) ManagedCreateAsyncCall(destHandle int32, valueHandle in
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedCreateAsyncCall(destHandle, valueHandle, functionHandle, argumentsHandle, successOffset, successLength, errorOffset, errorLength, gas, extraGasForCallback, callbackClosureHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedCreateAsyncCall", []int64{int64(destHandle), int64(valueHandle), int64(functionHandle), int64(argumentsHandle), int64(successOffset), int64(successLength), int64(errorOffset), int64(errorLength), int64(gas), int64(extraGasForCallback), int64(callbackClosureHandle)}, int64(result))
+	return result
+}
+
+// ManagedCreateAsyncCallWithDeadline VM hook wrapper
+func (w *WrapperVMHooks) ManagedCreateAsyncCallWithDeadline(destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, successOffset executor.MemPtr, successLength executor.MemLength, errorOffset executor.MemPtr, errorLength executor.MemLength, gas int64, extraGasForCallback int64, callbackClosureHandle int32, deadlineRound int64, deadlineTimestamp int64) int32 {
+	callInfo := fmt.Sprintf("ManagedCreateAsyncCallWithDeadline(%d, %d, %d, %d, %d, %d, %d, %d, %d, %d, %d, %d, %d)", destHandle, valueHandle, functionHandle, argumentsHandle, successOffset, successLength, errorOffset, errorLength, gas, extraGasForCallback, callbackClosureHandle, deadlineRound, deadlineTimestamp)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedCreateAsyncCallWithDeadline(destHandle, valueHandle, functionHandle, argumentsHandle, successOffset, successLength, errorOffset, errorLength, gas, extraGasForCallback, callbackClosureHandle, deadlineRound, deadlineTimestamp)
+	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedCreateAsyncCallWithDeadline", []int64{int64(destHandle), int64(valueHandle), int64(functionHandle), int64(argumentsHandle), int64(successOffset), int64(successLength), int64(errorOffset), int64(errorLength), int64(gas), int64(extraGasForCallback), int64(callbackClosureHandle), int64(deadlineRound), int64(deadlineTimestamp)}, int64(result))
 	return result
 }
 
@@ -877,6 +986,7 @@ func (w *WrapperVMHooks) ManagedGetCallbackClosure(callbackClosureHandle int32)
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetCallbackClosure(callbackClosureHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedGetCallbackClosure", []int64{int64(callbackClosureHandle)})
 }
 
 // ManagedUpgradeFromSourceContract VM hook wrapper
@@ -885,6 +995,7 @@ func (w *WrapperVMHooks) ManagedUpgradeFromSourceContract(destHandle int32, gas
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedUpgradeFromSourceContract(destHandle, gas, valueHandle, addressHandle, codeMetadataHandle, argumentsHandle, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedUpgradeFromSourceContract", []int64{int64(destHandle), int64(gas), int64(valueHandle), int64(addressHandle), int64(codeMetadataHandle), int64(argumentsHandle), int64(resultHandle)})
 }
 
 // ManagedUpgradeContract VM hook wrapper
@@ -893,6 +1004,7 @@ func (w *WrapperVMHooks) ManagedUpgradeContract(destHandle int32, gas int64, val
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedUpgradeContract(destHandle, gas, valueHandle, codeHandle, codeMetadataHandle, argumentsHandle, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedUpgradeContract", []int64{int64(destHandle), int64(gas), int64(valueHandle), int64(codeHandle), int64(codeMetadataHandle), int64(argumentsHandle), int64(resultHandle)})
 }
 
 // ManagedDeleteContract VM hook wrapper
@@ -901,6 +1013,7 @@ func (w *WrapperVMHooks) ManagedDeleteContract(destHandle int32, gasLimit int64,
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedDeleteContract(destHandle, gasLimit, argumentsHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedDeleteContract", []int64{int64(destHandle), int64(gasLimit), int64(argumentsHandle)})
 }
 
 // ManagedDeployFromSourceContract VM hook wrapper
@@ -909,6 +1022,7 @@ func (w *WrapperVMHooks) ManagedDeployFromSourceContract(gas int64, valueHandle
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedDeployFromSourceContract(gas, valueHandle, addressHandle, codeMetadataHandle, argumentsHandle, resultAddressHandle, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedDeployFromSourceContract", []int64{int64(gas), int64(valueHandle), int64(addressHandle), int64(codeMetadataHandle), int64(argumentsHandle), int64(resultAddressHandle), int64(resultHandle)}, int64(result))
 	return result
 }
 
@@ -918,6 +1032,7 @@ func (w *WrapperVMHooks) ManagedCreateContract(gas int64, valueHandle int32, cod
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedCreateContract(gas, valueHandle, codeHandle, codeMetadataHandle, argumentsHandle, resultAddressHandle, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedCreateContract", []int64{int64(gas), int64(valueHandle), int64(codeHandle), int64(codeMetadataHandle), int64(argumentsHandle), int64(resultAddressHandle), int64(resultHandle)}, int64(result))
 	return result
 }
 
@@ -927,6 +1042,7 @@ func (w *WrapperVMHooks) ManagedExecuteReadOnly(gas int64, addressHandle int32,
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedExecuteReadOnly(gas, addressHandle, functionHandle, argumentsHandle, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedExecuteReadOnly", []int64{int64(gas), int64(addressHandle), int64(functionHandle), int64(argumentsHandle), int64(resultHandle)}, int64(result))
 	return result
 }
 
@@ -936,6 +1052,7 @@ func (w *WrapperVMHooks) ManagedExecuteOnSameContext(gas int64, addressHandle in
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedExecuteOnSameContext(gas, addressHandle, valueHandle, functionHandle, argumentsHandle, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedExecuteOnSameContext", []int64{int64(gas), int64(addressHandle), int64(valueHandle), int64(functionHandle), int64(argumentsHandle), int64(resultHandle)}, int64(result))
 	return result
 }
 
@@ -945,6 +1062,7 @@ func (w *WrapperVMHooks) ManagedExecuteOnDestContext(gas int64, addressHandle in
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedExecuteOnDestContext(gas, addressHandle, valueHandle, functionHandle, argumentsHandle, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedExecuteOnDestContext", []int64{int64(gas), int64(addressHandle), int64(valueHandle), int64(functionHandle), int64(argumentsHandle), int64(resultHandle)}, int64(result))
 	return result
 }
 
@@ -954,6 +1072,7 @@ func (w *WrapperVMHooks) ManagedMultiTransferESDTNFTExecute(dstHandle int32, tok
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedMultiTransferESDTNFTExecute(dstHandle, tokenTransfersHandle, gasLimit, functionHandle, argumentsHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedMultiTransferESDTNFTExecute", []int64{int64(dstHandle), int64(tokenTransfersHandle), int64(gasLimit), int64(functionHandle), int64(argumentsHandle)}, int64(result))
 	return result
 }
 
@@ -963,6 +1082,7 @@ func (w *WrapperVMHooks) ManagedMultiTransferESDTNFTExecuteByUser(userHandle int
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedMultiTransferESDTNFTExecuteByUser(userHandle, dstHandle, tokenTransfersHandle, gasLimit, functionHandle, argumentsHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedMultiTransferESDTNFTExecuteByUser", []int64{int64(userHandle), int64(dstHandle), int64(tokenTransfersHandle), int64(gasLimit), int64(functionHandle), int64(argumentsHandle)}, int64(result))
 	return result
 }
 
@@ -972,6 +1092,7 @@ func (w *WrapperVMHooks) ManagedTransferValueExecute(dstHandle int32, valueHandl
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedTransferValueExecute(dstHandle, valueHandle, gasLimit, functionHandle, argumentsHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedTransferValueExecute", []int64{int64(dstHandle), int64(valueHandle), int64(gasLimit), int64(functionHandle), int64(argumentsHandle)}, int64(result))
 	return result
 }
 
@@ -981,6 +1102,7 @@ func (w *WrapperVMHooks) ManagedIsESDTFrozen(addressHandle int32, tokenIDHandle
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedIsESDTFrozen(addressHandle, tokenIDHandle, nonce)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedIsESDTFrozen", []int64{int64(addressHandle), int64(tokenIDHandle), int64(nonce)}, int64(result))
 	return result
 }
 
@@ -990,6 +1112,7 @@ func (w *WrapperVMHooks) ManagedIsESDTLimitedTransfer(tokenIDHandle int32) int32
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedIsESDTLimitedTransfer(tokenIDHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedIsESDTLimitedTransfer", []int64{int64(tokenIDHandle)}, int64(result))
 	return result
 }
 
@@ -999,6 +1122,7 @@ func (w *WrapperVMHooks) ManagedIsESDTPaused(tokenIDHandle int32) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedIsESDTPaused(tokenIDHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedIsESDTPaused", []int64{int64(tokenIDHandle)}, int64(result))
 	return result
 }
 
@@ -1008,6 +1132,7 @@ func (w *WrapperVMHooks) ManagedBufferToHex(sourceHandle int32, destHandle int32
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedBufferToHex(sourceHandle, destHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedBufferToHex", []int64{int64(sourceHandle), int64(destHandle)})
 }
 
 // ManagedGetCodeMetadata VM hook wrapper
@@ -1016,6 +1141,7 @@ func (w *WrapperVMHooks) ManagedGetCodeMetadata(addressHandle int32, responseHan
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetCodeMetadata(addressHandle, responseHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedGetCodeMetadata", []int64{int64(addressHandle), int64(responseHandle)})
 }
 
 // ManagedIsBuiltinFunction VM hook wrapper
@@ -1024,15 +1150,82 @@ func (w *WrapperVMHooks) ManagedIsBuiltinFunction(functionNameHandle int32) int3
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedIsBuiltinFunction(functionNameHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedIsBuiltinFunction", []int64{int64(functionNameHandle)}, int64(result))
+	return result
+}
+
+// ManagedUpdateNFTAttributes VM hook wrapper
+func (w *WrapperVMHooks) ManagedUpdateNFTAttributes(tokenIDHandle int32, nonce int64, attributesHandle int32) {
+	callInfo := fmt.Sprintf("ManagedUpdateNFTAttributes(%d, %d, %d)", tokenIDHandle, nonce, attributesHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	w.wrappedVMHooks.ManagedUpdateNFTAttributes(tokenIDHandle, nonce, attributesHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedUpdateNFTAttributes", []int64{int64(tokenIDHandle), int64(nonce), int64(attributesHandle)})
+}
+
+// ManagedAddNFTURIs VM hook wrapper
+func (w *WrapperVMHooks) ManagedAddNFTURIs(tokenIDHandle int32, nonce int64, urisHandle int32) {
+	callInfo := fmt.Sprintf("ManagedAddNFTURIs(%d, %d, %d)", tokenIDHandle, nonce, urisHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	w.wrappedVMHooks.ManagedAddNFTURIs(tokenIDHandle, nonce, urisHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedAddNFTURIs", []int64{int64(tokenIDHandle), int64(nonce), int64(urisHandle)})
+}
+
+// ManagedModifyNFTRoyalties VM hook wrapper
+func (w *WrapperVMHooks) ManagedModifyNFTRoyalties(tokenIDHandle int32, nonce int64, royalties int64) {
+	callInfo := fmt.Sprintf("ManagedModifyNFTRoyalties(%d, %d, %d)", tokenIDHandle, nonce, royalties)
+	w.logger.LogVMHookCallBefore(callInfo)
+	w.wrappedVMHooks.ManagedModifyNFTRoyalties(tokenIDHandle, nonce, royalties)
+	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedModifyNFTRoyalties", []int64{int64(tokenIDHandle), int64(nonce), int64(royalties)})
+}
+
+// ManagedGetCodeHash VM hook wrapper
+func (w *WrapperVMHooks) ManagedGetCodeHash(addressHandle int32, resultHandle int32) {
+	callInfo := fmt.Sprintf("ManagedGetCodeHash(%d, %d)", addressHandle, resultHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	w.wrappedVMHooks.ManagedGetCodeHash(addressHandle, resultHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedGetCodeHash", []int64{int64(addressHandle), int64(resultHandle)})
+}
+
+// ManagedGetCodeMetadataFlags VM hook wrapper
+func (w *WrapperVMHooks) ManagedGetCodeMetadataFlags(addressHandle int32) int64 {
+	callInfo := fmt.Sprintf("ManagedGetCodeMetadataFlags(%d)", addressHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedGetCodeMetadataFlags(addressHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedGetCodeMetadataFlags", []int64{int64(addressHandle)}, int64(result))
 	return result
 }
 
+// ManagedGetAccountNonce VM hook wrapper
+func (w *WrapperVMHooks) ManagedGetAccountNonce(addressHandle int32) int64 {
+	callInfo := fmt.Sprintf("ManagedGetAccountNonce(%d)", addressHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedGetAccountNonce(addressHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedGetAccountNonce", []int64{int64(addressHandle)}, int64(result))
+	return result
+}
+
+// ManagedGetContractOwner VM hook wrapper
+func (w *WrapperVMHooks) ManagedGetContractOwner(addressHandle int32, resultHandle int32) {
+	callInfo := fmt.Sprintf("ManagedGetContractOwner(%d, %d)", addressHandle, resultHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	w.wrappedVMHooks.ManagedGetContractOwner(addressHandle, resultHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("managedGetContractOwner", []int64{int64(addressHandle), int64(resultHandle)})
+}
+
 // BigFloatNewFromParts VM hook wrapper
 func (w *WrapperVMHooks) BigFloatNewFromParts(integralPart int32, fractionalPart int32, exponent int32) int32 {
 	callInfo := fmt.Sprintf("BigFloatNewFromParts(%d, %d, %d)", integralPart, fractionalPart, exponent)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigFloatNewFromParts(integralPart, fractionalPart, exponent)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("bigFloatNewFromParts", []int64{int64(integralPart), int64(fractionalPart), int64(exponent)}, int64(result))
 	return result
 }
 
@@ -1042,6 +1235,7 @@ func (w *WrapperVMHooks) BigFloatNewFromFrac(numerator int64, denominator int64)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigFloatNewFromFrac(numerator, denominator)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("bigFloatNewFromFrac", []int64{int64(numerator), int64(denominator)}, int64(result))
 	return result
 }
 
@@ -1051,6 +1245,7 @@ func (w *WrapperVMHooks) BigFloatNewFromSci(significand int64, exponent int64) i
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigFloatNewFromSci(significand, exponent)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("bigFloatNewFromSci", []int64{int64(significand), int64(exponent)}, int64(result))
 	return result
 }
 
@@ -1060,6 +1255,7 @@ func (w *WrapperVMHooks) BigFloatAdd(destinationHandle int32, op1Handle int32, o
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatAdd(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigFloatAdd", []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)})
 }
 
 // BigFloatSub VM hook wrapper
@@ -1068,6 +1264,7 @@ func (w *WrapperVMHooks) BigFloatSub(destinationHandle int32, op1Handle int32, o
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatSub(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigFloatSub", []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)})
 }
 
 // BigFloatMul VM hook wrapper
@@ -1076,6 +1273,7 @@ func (w *WrapperVMHooks) BigFloatMul(destinationHandle int32, op1Handle int32, o
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatMul(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigFloatMul", []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)})
 }
 
 // BigFloatDiv VM hook wrapper
@@ -1084,6 +1282,7 @@ func (w *WrapperVMHooks) BigFloatDiv(destinationHandle int32, op1Handle int32, o
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatDiv(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigFloatDiv", []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)})
 }
 
 // BigFloatNeg VM hook wrapper
@@ -1092,6 +1291,7 @@ func (w *WrapperVMHooks) BigFloatNeg(destinationHandle int32, opHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatNeg(destinationHandle, opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigFloatNeg", []int64{int64(destinationHandle), int64(opHandle)})
 }
 
 // BigFloatClone VM hook wrapper
@@ -1100,6 +1300,7 @@ func (w *WrapperVMHooks) BigFloatClone(destinationHandle int32, opHandle int32)
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatClone(destinationHandle, opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigFloatClone", []int64{int64(destinationHandle), int64(opHandle)})
 }
 
 // BigFloatCmp VM hook wrapper
@@ -1108,6 +1309,7 @@ func (w *WrapperVMHooks) BigFloatCmp(op1Handle int32, op2Handle int32) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigFloatCmp(op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("bigFloatCmp", []int64{int64(op1Handle), int64(op2Handle)}, int64(result))
 	return result
 }
 
@@ -1117,6 +1319,7 @@ func (w *WrapperVMHooks) BigFloatAbs(destinationHandle int32, opHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatAbs(destinationHandle, opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigFloatAbs", []int64{int64(destinationHandle), int64(opHandle)})
 }
 
 // BigFloatSign VM hook wrapper
@@ -1125,6 +1328,7 @@ func (w *WrapperVMHooks) BigFloatSign(opHandle int32) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigFloatSign(opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("bigFloatSign", []int64{int64(opHandle)}, int64(result))
 	return result
 }
 
@@ -1134,6 +1338,7 @@ func (w *WrapperVMHooks) BigFloatSqrt(destinationHandle int32, opHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatSqrt(destinationHandle, opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigFloatSqrt", []int64{int64(destinationHandle), int64(opHandle)})
 }
 
 // BigFloatPow VM hook wrapper
@@ -1142,6 +1347,7 @@ func (w *WrapperVMHooks) BigFloatPow(destinationHandle int32, opHandle int32, ex
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatPow(destinationHandle, opHandle, exponent)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigFloatPow", []int64{int64(destinationHandle), int64(opHandle), int64(exponent)})
 }
 
 // BigFloatFloor VM hook wrapper
@@ -1150,6 +1356,7 @@ func (w *WrapperVMHooks) BigFloatFloor(destBigIntHandle int32, opHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatFloor(destBigIntHandle, opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigFloatFloor", []int64{int64(destBigIntHandle), int64(opHandle)})
 }
 
 // BigFloatCeil VM hook wrapper
@@ -1158,6 +1365,7 @@ func (w *WrapperVMHooks) BigFloatCeil(destBigIntHandle int32, opHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatCeil(destBigIntHandle, opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigFloatCeil", []int64{int64(destBigIntHandle), int64(opHandle)})
 }
 
 // BigFloatTruncate VM hook wrapper
@@ -1166,6 +1374,7 @@ func (w *WrapperVMHooks) BigFloatTruncate(destBigIntHandle int32, opHandle int32
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatTruncate(destBigIntHandle, opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigFloatTruncate", []int64{int64(destBigIntHandle), int64(opHandle)})
 }
 
 // BigFloatSetInt64 VM hook wrapper
@@ -1174,6 +1383,7 @@ func (w *WrapperVMHooks) BigFloatSetInt64(destinationHandle int32, value int64)
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatSetInt64(destinationHandle, value)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigFloatSetInt64", []int64{int64(destinationHandle), int64(value)})
 }
 
 // BigFloatIsInt VM hook wrapper
@@ -1182,6 +1392,7 @@ func (w *WrapperVMHooks) BigFloatIsInt(opHandle int32) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigFloatIsInt(opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("bigFloatIsInt", []int64{int64(opHandle)}, int64(result))
 	return result
 }
 
@@ -1191,6 +1402,7 @@ func (w *WrapperVMHooks) BigFloatSetBigInt(destinationHandle int32, bigIntHandle
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatSetBigInt(destinationHandle, bigIntHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigFloatSetBigInt", []int64{int64(destinationHandle), int64(bigIntHandle)})
 }
 
 // BigFloatGetConstPi VM hook wrapper
@@ -1199,6 +1411,7 @@ func (w *WrapperVMHooks) BigFloatGetConstPi(destinationHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatGetConstPi(destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigFloatGetConstPi", []int64{int64(destinationHandle)})
 }
 
 // BigFloatGetConstE VM hook wrapper
@@ -1207,6 +1420,7 @@ func (w *WrapperVMHooks) BigFloatGetConstE(destinationHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatGetConstE(destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigFloatGetConstE", []int64{int64(destinationHandle)})
 }
 
 // BigIntGetUnsignedArgument VM hook wrapper
@@ -1215,6 +1429,7 @@ func (w *WrapperVMHooks) BigIntGetUnsignedArgument(id int32, destinationHandle i
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntGetUnsignedArgument(id, destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntGetUnsignedArgument", []int64{int64(id), int64(destinationHandle)})
 }
 
 // BigIntGetSignedArgument VM hook wrapper
@@ -1223,6 +1438,7 @@ func (w *WrapperVMHooks) BigIntGetSignedArgument(id int32, destinationHandle int
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntGetSignedArgument(id, destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntGetSignedArgument", []int64{int64(id), int64(destinationHandle)})
 }
 
 // BigIntStorageStoreUnsigned VM hook wrapper
@@ -1231,6 +1447,7 @@ func (w *WrapperVMHooks) BigIntStorageStoreUnsigned(keyOffset executor.MemPtr, k
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntStorageStoreUnsigned(keyOffset, keyLength, sourceHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("bigIntStorageStoreUnsigned", []int64{int64(keyOffset), int64(keyLength), int64(sourceHandle)}, int64(result))
 	return result
 }
 
@@ -1240,6 +1457,7 @@ func (w *WrapperVMHooks) BigIntStorageLoadUnsigned(keyOffset executor.MemPtr, ke
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntStorageLoadUnsigned(keyOffset, keyLength, destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("bigIntStorageLoadUnsigned", []int64{int64(keyOffset), int64(keyLength), int64(destinationHandle)}, int64(result))
 	return result
 }
 
@@ -1249,6 +1467,7 @@ func (w *WrapperVMHooks) BigIntGetCallValue(destinationHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntGetCallValue(destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntGetCallValue", []int64{int64(destinationHandle)})
 }
 
 // BigIntGetESDTCallValue VM hook wrapper
@@ -1257,6 +1476,7 @@ func (w *WrapperVMHooks) BigIntGetESDTCallValue(destination int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntGetESDTCallValue(destination)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntGetESDTCallValue", []int64{int64(destination)})
 }
 
 // BigIntGetESDTCallValueByIndex VM hook wrapper
@@ -1265,6 +1485,7 @@ func (w *WrapperVMHooks) BigIntGetESDTCallValueByIndex(destinationHandle int32,
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntGetESDTCallValueByIndex(destinationHandle, index)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntGetESDTCallValueByIndex", []int64{int64(destinationHandle), int64(index)})
 }
 
 // BigIntGetExternalBalance VM hook wrapper
@@ -1273,6 +1494,7 @@ func (w *WrapperVMHooks) BigIntGetExternalBalance(addressOffset executor.MemPtr,
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntGetExternalBalance(addressOffset, result)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntGetExternalBalance", []int64{int64(addressOffset), int64(result)})
 }
 
 // BigIntGetESDTExternalBalance VM hook wrapper
@@ -1281,6 +1503,7 @@ func (w *WrapperVMHooks) BigIntGetESDTExternalBalance(addressOffset executor.Mem
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntGetESDTExternalBalance(addressOffset, tokenIDOffset, tokenIDLen, nonce, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntGetESDTExternalBalance", []int64{int64(addressOffset), int64(tokenIDOffset), int64(tokenIDLen), int64(nonce), int64(resultHandle)})
 }
 
 // BigIntNew VM hook wrapper
@@ -1289,6 +1512,7 @@ func (w *WrapperVMHooks) BigIntNew(smallValue int64) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntNew(smallValue)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("bigIntNew", []int64{int64(smallValue)}, int64(result))
 	return result
 }
 
@@ -1298,6 +1522,7 @@ func (w *WrapperVMHooks) BigIntUnsignedByteLength(referenceHandle int32) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntUnsignedByteLength(referenceHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("bigIntUnsignedByteLength", []int64{int64(referenceHandle)}, int64(result))
 	return result
 }
 
@@ -1307,6 +1532,7 @@ func (w *WrapperVMHooks) BigIntSignedByteLength(referenceHandle int32) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntSignedByteLength(referenceHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("bigIntSignedByteLength", []int64{int64(referenceHandle)}, int64(result))
 	return result
 }
 
@@ -1316,6 +1542,7 @@ func (w *WrapperVMHooks) BigIntGetUnsignedBytes(referenceHandle int32, byteOffse
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntGetUnsignedBytes(referenceHandle, byteOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("bigIntGetUnsignedBytes", []int64{int64(referenceHandle), int64(byteOffset)}, int64(result))
 	return result
 }
 
@@ -1325,6 +1552,7 @@ func (w *WrapperVMHooks) BigIntGetSignedBytes(referenceHandle int32, byteOffset
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntGetSignedBytes(referenceHandle, byteOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("bigIntGetSignedBytes", []int64{int64(referenceHandle), int64(byteOffset)}, int64(result))
 	return result
 }
 
@@ -1334,6 +1562,7 @@ func (w *WrapperVMHooks) BigIntSetUnsignedBytes(destinationHandle int32, byteOff
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntSetUnsignedBytes(destinationHandle, byteOffset, byteLength)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntSetUnsignedBytes", []int64{int64(destinationHandle), int64(byteOffset), int64(byteLength)})
 }
 
 // BigIntSetSignedBytes VM hook wrapper
@@ -1342,6 +1571,7 @@ func (w *WrapperVMHooks) BigIntSetSignedBytes(destinationHandle int32, byteOffse
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntSetSignedBytes(destinationHandle, byteOffset, byteLength)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntSetSignedBytes", []int64{int64(destinationHandle), int64(byteOffset), int64(byteLength)})
 }
 
 // BigIntIsInt64 VM hook wrapper
@@ -1350,6 +1580,7 @@ func (w *WrapperVMHooks) BigIntIsInt64(destinationHandle int32) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntIsInt64(destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("bigIntIsInt64", []int64{int64(destinationHandle)}, int64(result))
 	return result
 }
 
@@ -1359,6 +1590,7 @@ func (w *WrapperVMHooks) BigIntGetInt64(destinationHandle int32) int64 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntGetInt64(destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("bigIntGetInt64", []int64{int64(destinationHandle)}, int64(result))
 	return result
 }
 
@@ -1368,6 +1600,7 @@ func (w *WrapperVMHooks) BigIntSetInt64(destinationHandle int32, value int64) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntSetInt64(destinationHandle, value)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntSetInt64", []int64{int64(destinationHandle), int64(value)})
 }
 
 // BigIntAdd VM hook wrapper
@@ -1376,6 +1609,7 @@ func (w *WrapperVMHooks) BigIntAdd(destinationHandle int32, op1Handle int32, op2
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntAdd(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntAdd", []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)})
 }
 
 // BigIntSub VM hook wrapper
@@ -1384,6 +1618,7 @@ func (w *WrapperVMHooks) BigIntSub(destinationHandle int32, op1Handle int32, op2
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntSub(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntSub", []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)})
 }
 
 // BigIntMul VM hook wrapper
@@ -1392,6 +1627,7 @@ func (w *WrapperVMHooks) BigIntMul(destinationHandle int32, op1Handle int32, op2
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntMul(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntMul", []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)})
 }
 
 // BigIntTDiv VM hook wrapper
@@ -1400,6 +1636,7 @@ func (w *WrapperVMHooks) BigIntTDiv(destinationHandle int32, op1Handle int32, op
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntTDiv(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntTDiv", []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)})
 }
 
 // BigIntTMod VM hook wrapper
@@ -1408,6 +1645,7 @@ func (w *WrapperVMHooks) BigIntTMod(destinationHandle int32, op1Handle int32, op
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntTMod(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntTMod", []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)})
 }
 
 // BigIntEDiv VM hook wrapper
@@ -1416,6 +1654,7 @@ func (w *WrapperVMHooks) BigIntEDiv(destinationHandle int32, op1Handle int32, op
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntEDiv(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntEDiv", []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)})
 }
 
 // BigIntEMod VM hook wrapper
@@ -1424,6 +1663,7 @@ func (w *WrapperVMHooks) BigIntEMod(destinationHandle int32, op1Handle int32, op
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntEMod(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntEMod", []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)})
 }
 
 // BigIntSqrt VM hook wrapper
@@ -1432,6 +1672,7 @@ func (w *WrapperVMHooks) BigIntSqrt(destinationHandle int32, opHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntSqrt(destinationHandle, opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntSqrt", []int64{int64(destinationHandle), int64(opHandle)})
 }
 
 // BigIntPow VM hook wrapper
@@ -1440,6 +1681,7 @@ func (w *WrapperVMHooks) BigIntPow(destinationHandle int32, op1Handle int32, op2
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntPow(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntPow", []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)})
 }
 
 // BigIntLog2 VM hook wrapper
@@ -1448,6 +1690,7 @@ func (w *WrapperVMHooks) BigIntLog2(op1Handle int32) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntLog2(op1Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("bigIntLog2", []int64{int64(op1Handle)}, int64(result))
 	return result
 }
 
@@ -1457,6 +1700,7 @@ func (w *WrapperVMHooks) BigIntAbs(destinationHandle int32, opHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntAbs(destinationHandle, opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntAbs", []int64{int64(destinationHandle), int64(opHandle)})
 }
 
 // BigIntNeg VM hook wrapper
@@ -1465,6 +1709,7 @@ func (w *WrapperVMHooks) BigIntNeg(destinationHandle int32, opHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntNeg(destinationHandle, opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntNeg", []int64{int64(destinationHandle), int64(opHandle)})
 }
 
 // BigIntSign VM hook wrapper
@@ -1473,6 +1718,7 @@ func (w *WrapperVMHooks) BigIntSign(opHandle int32) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntSign(opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("bigIntSign", []int64{int64(opHandle)}, int64(result))
 	return result
 }
 
@@ -1482,6 +1728,7 @@ func (w *WrapperVMHooks) BigIntCmp(op1Handle int32, op2Handle int32) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntCmp(op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("bigIntCmp", []int64{int64(op1Handle), int64(op2Handle)}, int64(result))
 	return result
 }
 
@@ -1491,6 +1738,7 @@ func (w *WrapperVMHooks) BigIntNot(destinationHandle int32, opHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntNot(destinationHandle, opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntNot", []int64{int64(destinationHandle), int64(opHandle)})
 }
 
 // BigIntAnd VM hook wrapper
@@ -1499,6 +1747,7 @@ func (w *WrapperVMHooks) BigIntAnd(destinationHandle int32, op1Handle int32, op2
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntAnd(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntAnd", []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)})
 }
 
 // BigIntOr VM hook wrapper
@@ -1507,6 +1756,7 @@ func (w *WrapperVMHooks) BigIntOr(destinationHandle int32, op1Handle int32, op2H
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntOr(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntOr", []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)})
 }
 
 // BigIntXor VM hook wrapper
@@ -1515,6 +1765,7 @@ func (w *WrapperVMHooks) BigIntXor(destinationHandle int32, op1Handle int32, op2
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntXor(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntXor", []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)})
 }
 
 // BigIntShr VM hook wrapper
@@ -1523,6 +1774,7 @@ func (w *WrapperVMHooks) BigIntShr(destinationHandle int32, opHandle int32, bits
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntShr(destinationHandle, opHandle, bits)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntShr", []int64{int64(destinationHandle), int64(opHandle), int64(bits)})
 }
 
 // BigIntShl VM hook wrapper
@@ -1531,6 +1783,7 @@ func (w *WrapperVMHooks) BigIntShl(destinationHandle int32, opHandle int32, bits
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntShl(destinationHandle, opHandle, bits)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntShl", []int64{int64(destinationHandle), int64(opHandle), int64(bits)})
 }
 
 // BigIntFinishUnsigned VM hook wrapper
@@ -1539,6 +1792,7 @@ func (w *WrapperVMHooks) BigIntFinishUnsigned(referenceHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntFinishUnsigned(referenceHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntFinishUnsigned", []int64{int64(referenceHandle)})
 }
 
 // BigIntFinishSigned VM hook wrapper
@@ -1547,6 +1801,7 @@ func (w *WrapperVMHooks) BigIntFinishSigned(referenceHandle int32) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntFinishSigned(referenceHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntFinishSigned", []int64{int64(referenceHandle)})
 }
 
 // BigIntToString VM hook wrapper
@@ -1555,6 +1810,7 @@ func (w *WrapperVMHooks) BigIntToString(bigIntHandle int32, destinationHandle in
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntToString(bigIntHandle, destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("bigIntToString", []int64{int64(bigIntHandle), int64(destinationHandle)})
 }
 
 // MBufferNew VM hook wrapper
@@ -1563,6 +1819,7 @@ func (w *WrapperVMHooks) MBufferNew() int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferNew()
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferNew", []int64{}, int64(result))
 	return result
 }
 
@@ -1572,6 +1829,7 @@ func (w *WrapperVMHooks) MBufferNewFromBytes(dataOffset executor.MemPtr, dataLen
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferNewFromBytes(dataOffset, dataLength)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferNewFromBytes", []int64{int64(dataOffset), int64(dataLength)}, int64(result))
 	return result
 }
 
@@ -1581,6 +1839,7 @@ func (w *WrapperVMHooks) MBufferGetLength(mBufferHandle int32) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferGetLength(mBufferHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferGetLength", []int64{int64(mBufferHandle)}, int64(result))
 	return result
 }
 
@@ -1590,6 +1849,7 @@ func (w *WrapperVMHooks) MBufferGetBytes(mBufferHandle int32, resultOffset execu
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferGetBytes(mBufferHandle, resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferGetBytes", []int64{int64(mBufferHandle), int64(resultOffset)}, int64(result))
 	return result
 }
 
@@ -1599,6 +1859,7 @@ func (w *WrapperVMHooks) MBufferGetByteSlice(sourceHandle int32, startingPositio
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferGetByteSlice(sourceHandle, startingPosition, sliceLength, resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferGetByteSlice", []int64{int64(sourceHandle), int64(startingPosition), int64(sliceLength), int64(resultOffset)}, int64(result))
 	return result
 }
 
@@ -1608,6 +1869,7 @@ func (w *WrapperVMHooks) MBufferCopyByteSlice(sourceHandle int32, startingPositi
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferCopyByteSlice(sourceHandle, startingPosition, sliceLength, destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferCopyByteSlice", []int64{int64(sourceHandle), int64(startingPosition), int64(sliceLength), int64(destinationHandle)}, int64(result))
 	return result
 }
 
@@ -1617,6 +1879,7 @@ func (w *WrapperVMHooks) MBufferEq(mBufferHandle1 int32, mBufferHandle2 int32) i
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferEq(mBufferHandle1, mBufferHandle2)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferEq", []int64{int64(mBufferHandle1), int64(mBufferHandle2)}, int64(result))
 	return result
 }
 
@@ -1626,6 +1889,7 @@ func (w *WrapperVMHooks) MBufferSetBytes(mBufferHandle int32, dataOffset executo
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferSetBytes(mBufferHandle, dataOffset, dataLength)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferSetBytes", []int64{int64(mBufferHandle), int64(dataOffset), int64(dataLength)}, int64(result))
 	return result
 }
 
@@ -1635,6 +1899,7 @@ func (w *WrapperVMHooks) MBufferSetByteSlice(mBufferHandle int32, startingPositi
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferSetByteSlice(mBufferHandle, startingPosition, dataLength, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferSetByteSlice", []int64{int64(mBufferHandle), int64(startingPosition), int64(dataLength), int64(dataOffset)}, int64(result))
 	return result
 }
 
@@ -1644,6 +1909,7 @@ func (w *WrapperVMHooks) MBufferAppend(accumulatorHandle int32, dataHandle int32
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferAppend(accumulatorHandle, dataHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferAppend", []int64{int64(accumulatorHandle), int64(dataHandle)}, int64(result))
 	return result
 }
 
@@ -1653,6 +1919,7 @@ func (w *WrapperVMHooks) MBufferAppendBytes(accumulatorHandle int32, dataOffset
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferAppendBytes(accumulatorHandle, dataOffset, dataLength)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferAppendBytes", []int64{int64(accumulatorHandle), int64(dataOffset), int64(dataLength)}, int64(result))
 	return result
 }
 
@@ -1662,6 +1929,7 @@ func (w *WrapperVMHooks) MBufferToBigIntUnsigned(mBufferHandle int32, bigIntHand
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferToBigIntUnsigned(mBufferHandle, bigIntHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferToBigIntUnsigned", []int64{int64(mBufferHandle), int64(bigIntHandle)}, int64(result))
 	return result
 }
 
@@ -1671,6 +1939,7 @@ func (w *WrapperVMHooks) MBufferToBigIntSigned(mBufferHandle int32, bigIntHandle
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferToBigIntSigned(mBufferHandle, bigIntHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferToBigIntSigned", []int64{int64(mBufferHandle), int64(bigIntHandle)}, int64(result))
 	return result
 }
 
@@ -1680,6 +1949,7 @@ func (w *WrapperVMHooks) MBufferFromBigIntUnsigned(mBufferHandle int32, bigIntHa
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferFromBigIntUnsigned(mBufferHandle, bigIntHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferFromBigIntUnsigned", []int64{int64(mBufferHandle), int64(bigIntHandle)}, int64(result))
 	return result
 }
 
@@ -1689,6 +1959,7 @@ func (w *WrapperVMHooks) MBufferFromBigIntSigned(mBufferHandle int32, bigIntHand
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferFromBigIntSigned(mBufferHandle, bigIntHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferFromBigIntSigned", []int64{int64(mBufferHandle), int64(bigIntHandle)}, int64(result))
 	return result
 }
 
@@ -1698,6 +1969,7 @@ func (w *WrapperVMHooks) MBufferToBigFloat(mBufferHandle int32, bigFloatHandle i
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferToBigFloat(mBufferHandle, bigFloatHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferToBigFloat", []int64{int64(mBufferHandle), int64(bigFloatHandle)}, int64(result))
 	return result
 }
 
@@ -1707,6 +1979,7 @@ func (w *WrapperVMHooks) MBufferFromBigFloat(mBufferHandle int32, bigFloatHandle
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferFromBigFloat(mBufferHandle, bigFloatHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferFromBigFloat", []int64{int64(mBufferHandle), int64(bigFloatHandle)}, int64(result))
 	return result
 }
 
@@ -1716,6 +1989,7 @@ func (w *WrapperVMHooks) MBufferStorageStore(keyHandle int32, sourceHandle int32
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferStorageStore(keyHandle, sourceHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferStorageStore", []int64{int64(keyHandle), int64(sourceHandle)}, int64(result))
 	return result
 }
 
@@ -1725,6 +1999,37 @@ func (w *WrapperVMHooks) MBufferStorageLoad(keyHandle int32, destinationHandle i
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferStorageLoad(keyHandle, destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferStorageLoad", []int64{int64(keyHandle), int64(destinationHandle)}, int64(result))
+	return result
+}
+
+// MBufferStorageIterateKeys VM hook wrapper
+func (w *WrapperVMHooks) MBufferStorageIterateKeys(prefixHandle int32, cursorHandle int32, maxKeys int32, keysHandle int32) int32 {
+	callInfo := fmt.Sprintf("MBufferStorageIterateKeys(%d, %d, %d, %d)", prefixHandle, cursorHandle, maxKeys, keysHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.MBufferStorageIterateKeys(prefixHandle, cursorHandle, maxKeys, keysHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferStorageIterateKeys", []int64{int64(prefixHandle), int64(cursorHandle), int64(maxKeys), int64(keysHandle)}, int64(result))
+	return result
+}
+
+// MBufferTransientStore VM hook wrapper
+func (w *WrapperVMHooks) MBufferTransientStore(keyHandle int32, sourceHandle int32) int32 {
+	callInfo := fmt.Sprintf("MBufferTransientStore(%d, %d)", keyHandle, sourceHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.MBufferTransientStore(keyHandle, sourceHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferTransientStore", []int64{int64(keyHandle), int64(sourceHandle)}, int64(result))
+	return result
+}
+
+// MBufferTransientLoad VM hook wrapper
+func (w *WrapperVMHooks) MBufferTransientLoad(keyHandle int32, destinationHandle int32) int32 {
+	callInfo := fmt.Sprintf("MBufferTransientLoad(%d, %d)", keyHandle, destinationHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.MBufferTransientLoad(keyHandle, destinationHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferTransientLoad", []int64{int64(keyHandle), int64(destinationHandle)}, int64(result))
 	return result
 }
 
@@ -1734,6 +2039,7 @@ func (w *WrapperVMHooks) MBufferStorageLoadFromAddress(addressHandle int32, keyH
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.MBufferStorageLoadFromAddress(addressHandle, keyHandle, destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("mBufferStorageLoadFromAddress", []int64{int64(addressHandle), int64(keyHandle), int64(destinationHandle)})
 }
 
 // MBufferGetArgument VM hook wrapper
@@ -1742,6 +2048,7 @@ func (w *WrapperVMHooks) MBufferGetArgument(id int32, destinationHandle int32) i
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferGetArgument(id, destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferGetArgument", []int64{int64(id), int64(destinationHandle)}, int64(result))
 	return result
 }
 
@@ -1751,6 +2058,7 @@ func (w *WrapperVMHooks) MBufferFinish(sourceHandle int32) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferFinish(sourceHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferFinish", []int64{int64(sourceHandle)}, int64(result))
 	return result
 }
 
@@ -1760,6 +2068,7 @@ func (w *WrapperVMHooks) MBufferSetRandom(destinationHandle int32, length int32)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferSetRandom(destinationHandle, length)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("mBufferSetRandom", []int64{int64(destinationHandle), int64(length)}, int64(result))
 	return result
 }
 
@@ -1769,6 +2078,7 @@ func (w *WrapperVMHooks) ManagedMapNew() int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedMapNew()
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedMapNew", []int64{}, int64(result))
 	return result
 }
 
@@ -1778,6 +2088,7 @@ func (w *WrapperVMHooks) ManagedMapPut(mMapHandle int32, keyHandle int32, valueH
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedMapPut(mMapHandle, keyHandle, valueHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedMapPut", []int64{int64(mMapHandle), int64(keyHandle), int64(valueHandle)}, int64(result))
 	return result
 }
 
@@ -1787,6 +2098,7 @@ func (w *WrapperVMHooks) ManagedMapGet(mMapHandle int32, keyHandle int32, outVal
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedMapGet(mMapHandle, keyHandle, outValueHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedMapGet", []int64{int64(mMapHandle), int64(keyHandle), int64(outValueHandle)}, int64(result))
 	return result
 }
 
@@ -1796,6 +2108,7 @@ func (w *WrapperVMHooks) ManagedMapRemove(mMapHandle int32, keyHandle int32, out
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedMapRemove(mMapHandle, keyHandle, outValueHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedMapRemove", []int64{int64(mMapHandle), int64(keyHandle), int64(outValueHandle)}, int64(result))
 	return result
 }
 
@@ -1805,6 +2118,7 @@ func (w *WrapperVMHooks) ManagedMapContains(mMapHandle int32, keyHandle int32) i
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedMapContains(mMapHandle, keyHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedMapContains", []int64{int64(mMapHandle), int64(keyHandle)}, int64(result))
 	return result
 }
 
@@ -1814,6 +2128,7 @@ func (w *WrapperVMHooks) SmallIntGetUnsignedArgument(id int32) int64 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.SmallIntGetUnsignedArgument(id)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("smallIntGetUnsignedArgument", []int64{int64(id)}, int64(result))
 	return result
 }
 
@@ -1823,6 +2138,7 @@ func (w *WrapperVMHooks) SmallIntGetSignedArgument(id int32) int64 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.SmallIntGetSignedArgument(id)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("smallIntGetSignedArgument", []int64{int64(id)}, int64(result))
 	return result
 }
 
@@ -1832,6 +2148,7 @@ func (w *WrapperVMHooks) SmallIntFinishUnsigned(value int64) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.SmallIntFinishUnsigned(value)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("smallIntFinishUnsigned", []int64{int64(value)})
 }
 
 // SmallIntFinishSigned VM hook wrapper
@@ -1840,6 +2157,7 @@ func (w *WrapperVMHooks) SmallIntFinishSigned(value int64) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.SmallIntFinishSigned(value)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("smallIntFinishSigned", []int64{int64(value)})
 }
 
 // SmallIntStorageStoreUnsigned VM hook wrapper
@@ -1848,6 +2166,7 @@ func (w *WrapperVMHooks) SmallIntStorageStoreUnsigned(keyOffset executor.MemPtr,
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.SmallIntStorageStoreUnsigned(keyOffset, keyLength, value)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("smallIntStorageStoreUnsigned", []int64{int64(keyOffset), int64(keyLength), int64(value)}, int64(result))
 	return result
 }
 
@@ -1857,6 +2176,7 @@ func (w *WrapperVMHooks) SmallIntStorageStoreSigned(keyOffset executor.MemPtr, k
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.SmallIntStorageStoreSigned(keyOffset, keyLength, value)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("smallIntStorageStoreSigned", []int64{int64(keyOffset), int64(keyLength), int64(value)}, int64(result))
 	return result
 }
 
@@ -1866,6 +2186,7 @@ func (w *WrapperVMHooks) SmallIntStorageLoadUnsigned(keyOffset executor.MemPtr,
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.SmallIntStorageLoadUnsigned(keyOffset, keyLength)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("smallIntStorageLoadUnsigned", []int64{int64(keyOffset), int64(keyLength)}, int64(result))
 	return result
 }
 
@@ -1875,6 +2196,7 @@ func (w *WrapperVMHooks) SmallIntStorageLoadSigned(keyOffset executor.MemPtr, ke
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.SmallIntStorageLoadSigned(keyOffset, keyLength)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("smallIntStorageLoadSigned", []int64{int64(keyOffset), int64(keyLength)}, int64(result))
 	return result
 }
 
@@ -1884,6 +2206,7 @@ func (w *WrapperVMHooks) Int64getArgument(id int32) int64 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.Int64getArgument(id)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("int64getArgument", []int64{int64(id)}, int64(result))
 	return result
 }
 
@@ -1893,6 +2216,7 @@ func (w *WrapperVMHooks) Int64finish(value int64) {
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.Int64finish(value)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("int64finish", []int64{int64(value)})
 }
 
 // Int64storageStore VM hook wrapper
@@ -1901,6 +2225,7 @@ func (w *WrapperVMHooks) Int64storageStore(keyOffset executor.MemPtr, keyLength
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.Int64storageStore(keyOffset, keyLength, value)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("int64storageStore", []int64{int64(keyOffset), int64(keyLength), int64(value)}, int64(result))
 	return result
 }
 
@@ -1910,6 +2235,7 @@ func (w *WrapperVMHooks) Int64storageLoad(keyOffset executor.MemPtr, keyLength e
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.Int64storageLoad(keyOffset, keyLength)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("int64storageLoad", []int64{int64(keyOffset), int64(keyLength)}, int64(result))
 	return result
 }
 
@@ -1919,6 +2245,7 @@ func (w *WrapperVMHooks) Sha256(dataOffset executor.MemPtr, length executor.MemL
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.Sha256(dataOffset, length, resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("sha256", []int64{int64(dataOffset), int64(length), int64(resultOffset)}, int64(result))
 	return result
 }
 
@@ -1928,6 +2255,7 @@ func (w *WrapperVMHooks) ManagedSha256(inputHandle int32, outputHandle int32) in
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedSha256(inputHandle, outputHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedSha256", []int64{int64(inputHandle), int64(outputHandle)}, int64(result))
 	return result
 }
 
@@ -1937,6 +2265,7 @@ func (w *WrapperVMHooks) Keccak256(dataOffset executor.MemPtr, length executor.M
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.Keccak256(dataOffset, length, resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("keccak256", []int64{int64(dataOffset), int64(length), int64(resultOffset)}, int64(result))
 	return result
 }
 
@@ -1946,6 +2275,7 @@ func (w *WrapperVMHooks) ManagedKeccak256(inputHandle int32, outputHandle int32)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedKeccak256(inputHandle, outputHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedKeccak256", []int64{int64(inputHandle), int64(outputHandle)}, int64(result))
 	return result
 }
 
@@ -1955,6 +2285,7 @@ func (w *WrapperVMHooks) Ripemd160(dataOffset executor.MemPtr, length executor.M
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.Ripemd160(dataOffset, length, resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("ripemd160", []int64{int64(dataOffset), int64(length), int64(resultOffset)}, int64(result))
 	return result
 }
 
@@ -1964,6 +2295,7 @@ func (w *WrapperVMHooks) ManagedRipemd160(inputHandle int32, outputHandle int32)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedRipemd160(inputHandle, outputHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedRipemd160", []int64{int64(inputHandle), int64(outputHandle)}, int64(result))
 	return result
 }
 
@@ -1973,6 +2305,7 @@ func (w *WrapperVMHooks) VerifyBLS(keyOffset executor.MemPtr, messageOffset exec
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.VerifyBLS(keyOffset, messageOffset, messageLength, sigOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("verifyBLS", []int64{int64(keyOffset), int64(messageOffset), int64(messageLength), int64(sigOffset)}, int64(result))
 	return result
 }
 
@@ -1982,6 +2315,7 @@ func (w *WrapperVMHooks) ManagedVerifyBLS(keyHandle int32, messageHandle int32,
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedVerifyBLS(keyHandle, messageHandle, sigHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedVerifyBLS", []int64{int64(keyHandle), int64(messageHandle), int64(sigHandle)}, int64(result))
 	return result
 }
 
@@ -1991,6 +2325,7 @@ func (w *WrapperVMHooks) VerifyEd25519(keyOffset executor.MemPtr, messageOffset
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.VerifyEd25519(keyOffset, messageOffset, messageLength, sigOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("verifyEd25519", []int64{int64(keyOffset), int64(messageOffset), int64(messageLength), int64(sigOffset)}, int64(result))
 	return result
 }
 
@@ -2000,6 +2335,7 @@ func (w *WrapperVMHooks) ManagedVerifyEd25519(keyHandle int32, messageHandle int
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedVerifyEd25519(keyHandle, messageHandle, sigHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedVerifyEd25519", []int64{int64(keyHandle), int64(messageHandle), int64(sigHandle)}, int64(result))
 	return result
 }
 
@@ -2009,6 +2345,7 @@ func (w *WrapperVMHooks) VerifyCustomSecp256k1(keyOffset executor.MemPtr, keyLen
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.VerifyCustomSecp256k1(keyOffset, keyLength, messageOffset, messageLength, sigOffset, hashType)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("verifyCustomSecp256k1", []int64{int64(keyOffset), int64(keyLength), int64(messageOffset), int64(messageLength), int64(sigOffset), int64(hashType)}, int64(result))
 	return result
 }
 
@@ -2018,6 +2355,7 @@ func (w *WrapperVMHooks) ManagedVerifyCustomSecp256k1(keyHandle int32, messageHa
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedVerifyCustomSecp256k1(keyHandle, messageHandle, sigHandle, hashType)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedVerifyCustomSecp256k1", []int64{int64(keyHandle), int64(messageHandle), int64(sigHandle), int64(hashType)}, int64(result))
 	return result
 }
 
@@ -2027,6 +2365,7 @@ func (w *WrapperVMHooks) VerifySecp256k1(keyOffset executor.MemPtr, keyLength ex
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.VerifySecp256k1(keyOffset, keyLength, messageOffset, messageLength, sigOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("verifySecp256k1", []int64{int64(keyOffset), int64(keyLength), int64(messageOffset), int64(messageLength), int64(sigOffset)}, int64(result))
 	return result
 }
 
@@ -2036,6 +2375,7 @@ func (w *WrapperVMHooks) ManagedVerifySecp256k1(keyHandle int32, messageHandle i
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedVerifySecp256k1(keyHandle, messageHandle, sigHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedVerifySecp256k1", []int64{int64(keyHandle), int64(messageHandle), int64(sigHandle)}, int64(result))
 	return result
 }
 
@@ -2045,6 +2385,7 @@ func (w *WrapperVMHooks) EncodeSecp256k1DerSignature(rOffset executor.MemPtr, rL
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.EncodeSecp256k1DerSignature(rOffset, rLength, sOffset, sLength, sigOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("encodeSecp256k1DerSignature", []int64{int64(rOffset), int64(rLength), int64(sOffset), int64(sLength), int64(sigOffset)}, int64(result))
 	return result
 }
 
@@ -2054,6 +2395,7 @@ func (w *WrapperVMHooks) ManagedEncodeSecp256k1DerSignature(rHandle int32, sHand
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedEncodeSecp256k1DerSignature(rHandle, sHandle, sigHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedEncodeSecp256k1DerSignature", []int64{int64(rHandle), int64(sHandle), int64(sigHandle)}, int64(result))
 	return result
 }
 
@@ -2063,6 +2405,7 @@ func (w *WrapperVMHooks) AddEC(xResultHandle int32, yResultHandle int32, ecHandl
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.AddEC(xResultHandle, yResultHandle, ecHandle, fstPointXHandle, fstPointYHandle, sndPointXHandle, sndPointYHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("addEC", []int64{int64(xResultHandle), int64(yResultHandle), int64(ecHandle), int64(fstPointXHandle), int64(fstPointYHandle), int64(sndPointXHandle), int64(sndPointYHandle)})
 }
 
 // DoubleEC VM hook wrapper
@@ -2071,6 +2414,7 @@ func (w *WrapperVMHooks) DoubleEC(xResultHandle int32, yResultHandle int32, ecHa
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.DoubleEC(xResultHandle, yResultHandle, ecHandle, pointXHandle, pointYHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCall("doubleEC", []int64{int64(xResultHandle), int64(yResultHandle), int64(ecHandle), int64(pointXHandle), int64(pointYHandle)})
 }
 
 // IsOnCurveEC VM hook wrapper
@@ -2079,6 +2423,7 @@ func (w *WrapperVMHooks) IsOnCurveEC(ecHandle int32, pointXHandle int32, pointYH
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.IsOnCurveEC(ecHandle, pointXHandle, pointYHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("isOnCurveEC", []int64{int64(ecHandle), int64(pointXHandle), int64(pointYHandle)}, int64(result))
 	return result
 }
 
@@ -2088,6 +2433,7 @@ func (w *WrapperVMHooks) ScalarBaseMultEC(xResultHandle int32, yResultHandle int
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ScalarBaseMultEC(xResultHandle, yResultHandle, ecHandle, dataOffset, length)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("scalarBaseMultEC", []int64{int64(xResultHandle), int64(yResultHandle), int64(ecHandle), int64(dataOffset), int64(length)}, int64(result))
 	return result
 }
 
@@ -2097,6 +2443,7 @@ func (w *WrapperVMHooks) ManagedScalarBaseMultEC(xResultHandle int32, yResultHan
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedScalarBaseMultEC(xResultHandle, yResultHandle, ecHandle, dataHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedScalarBaseMultEC", []int64{int64(xResultHandle), int64(yResultHandle), int64(ecHandle), int64(dataHandle)}, int64(result))
 	return result
 }
 
@@ -2106,6 +2453,7 @@ func (w *WrapperVMHooks) ScalarMultEC(xResultHandle int32, yResultHandle int32,
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ScalarMultEC(xResultHandle, yResultHandle, ecHandle, pointXHandle, pointYHandle, dataOffset, length)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("scalarMultEC", []int64{int64(xResultHandle), int64(yResultHandle), int64(ecHandle), int64(pointXHandle), int64(pointYHandle), int64(dataOffset), int64(length)}, int64(result))
 	return result
 }
 
@@ -2115,6 +2463,7 @@ func (w *WrapperVMHooks) ManagedScalarMultEC(xResultHandle int32, yResultHandle
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedScalarMultEC(xResultHandle, yResultHandle, ecHandle, pointXHandle, pointYHandle, dataHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedScalarMultEC", []int64{int64(xResultHandle), int64(yResultHandle), int64(ecHandle), int64(pointXHandle), int64(pointYHandle), int64(dataHandle)}, int64(result))
 	return result
 }
 
@@ -2124,6 +2473,7 @@ func (w *WrapperVMHooks) MarshalEC(xPairHandle int32, yPairHandle int32, ecHandl
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MarshalEC(xPairHandle, yPairHandle, ecHandle, resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("marshalEC", []int64{int64(xPairHandle), int64(yPairHandle), int64(ecHandle), int64(resultOffset)}, int64(result))
 	return result
 }
 
@@ -2133,6 +2483,7 @@ func (w *WrapperVMHooks) ManagedMarshalEC(xPairHandle int32, yPairHandle int32,
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedMarshalEC(xPairHandle, yPairHandle, ecHandle, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedMarshalEC", []int64{int64(xPairHandle), int64(yPairHandle), int64(ecHandle), int64(resultHandle)}, int64(result))
 	return result
 }
 
@@ -2142,6 +2493,7 @@ func (w *WrapperVMHooks) MarshalCompressedEC(xPairHandle int32, yPairHandle int3
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MarshalCompressedEC(xPairHandle, yPairHandle, ecHandle, resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("marshalCompressedEC", []int64{int64(xPairHandle), int64(yPairHandle), int64(ecHandle), int64(resultOffset)}, int64(result))
 	return result
 }
 
@@ -2151,6 +2503,7 @@ func (w *WrapperVMHooks) ManagedMarshalCompressedEC(xPairHandle int32, yPairHand
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedMarshalCompressedEC(xPairHandle, yPairHandle, ecHandle, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedMarshalCompressedEC", []int64{int64(xPairHandle), int64(yPairHandle), int64(ecHandle), int64(resultHandle)}, int64(result))
 	return result
 }
 
@@ -2160,6 +2513,7 @@ func (w *WrapperVMHooks) UnmarshalEC(xResultHandle int32, yResultHandle int32, e
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.UnmarshalEC(xResultHandle, yResultHandle, ecHandle, dataOffset, length)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("unmarshalEC", []int64{int64(xResultHandle), int64(yResultHandle), int64(ecHandle), int64(dataOffset), int64(length)}, int64(result))
 	return result
 }
 
@@ -2169,6 +2523,7 @@ func (w *WrapperVMHooks) ManagedUnmarshalEC(xResultHandle int32, yResultHandle i
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedUnmarshalEC(xResultHandle, yResultHandle, ecHandle, dataHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedUnmarshalEC", []int64{int64(xResultHandle), int64(yResultHandle), int64(ecHandle), int64(dataHandle)}, int64(result))
 	return result
 }
 
@@ -2178,6 +2533,7 @@ func (w *WrapperVMHooks) UnmarshalCompressedEC(xResultHandle int32, yResultHandl
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.UnmarshalCompressedEC(xResultHandle, yResultHandle, ecHandle, dataOffset, length)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("unmarshalCompressedEC", []int64{int64(xResultHandle), int64(yResultHandle), int64(ecHandle), int64(dataOffset), int64(length)}, int64(result))
 	return result
 }
 
@@ -2187,6 +2543,7 @@ func (w *WrapperVMHooks) ManagedUnmarshalCompressedEC(xResultHandle int32, yResu
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedUnmarshalCompressedEC(xResultHandle, yResultHandle, ecHandle, dataHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedUnmarshalCompressedEC", []int64{int64(xResultHandle), int64(yResultHandle), int64(ecHandle), int64(dataHandle)}, int64(result))
 	return result
 }
 
@@ -2196,6 +2553,7 @@ func (w *WrapperVMHooks) GenerateKeyEC(xPubKeyHandle int32, yPubKeyHandle int32,
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GenerateKeyEC(xPubKeyHandle, yPubKeyHandle, ecHandle, resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("generateKeyEC", []int64{int64(xPubKeyHandle), int64(yPubKeyHandle), int64(ecHandle), int64(resultOffset)}, int64(result))
 	return result
 }
 
@@ -2205,6 +2563,7 @@ func (w *WrapperVMHooks) ManagedGenerateKeyEC(xPubKeyHandle int32, yPubKeyHandle
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedGenerateKeyEC(xPubKeyHandle, yPubKeyHandle, ecHandle, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedGenerateKeyEC", []int64{int64(xPubKeyHandle), int64(yPubKeyHandle), int64(ecHandle), int64(resultHandle)}, int64(result))
 	return result
 }
 
@@ -2214,6 +2573,7 @@ func (w *WrapperVMHooks) CreateEC(dataOffset executor.MemPtr, dataLength executo
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.CreateEC(dataOffset, dataLength)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("createEC", []int64{int64(dataOffset), int64(dataLength)}, int64(result))
 	return result
 }
 
@@ -2223,6 +2583,7 @@ func (w *WrapperVMHooks) ManagedCreateEC(dataHandle int32) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedCreateEC(dataHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedCreateEC", []int64{int64(dataHandle)}, int64(result))
 	return result
 }
 
@@ -2232,6 +2593,7 @@ func (w *WrapperVMHooks) GetCurveLengthEC(ecHandle int32) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetCurveLengthEC(ecHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getCurveLengthEC", []int64{int64(ecHandle)}, int64(result))
 	return result
 }
 
@@ -2241,6 +2603,7 @@ func (w *WrapperVMHooks) GetPrivKeyByteLengthEC(ecHandle int32) int32 {
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetPrivKeyByteLengthEC(ecHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("getPrivKeyByteLengthEC", []int64{int64(ecHandle)}, int64(result))
 	return result
 }
 
@@ -2250,6 +2613,7 @@ func (w *WrapperVMHooks) EllipticCurveGetValues(ecHandle int32, fieldOrderHandle
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.EllipticCurveGetValues(ecHandle, fieldOrderHandle, basePointOrderHandle, eqConstantHandle, xBasePointHandle, yBasePointHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("ellipticCurveGetValues", []int64{int64(ecHandle), int64(fieldOrderHandle), int64(basePointOrderHandle), int64(eqConstantHandle), int64(xBasePointHandle), int64(yBasePointHandle)}, int64(result))
 	return result
 }
 
@@ -2259,6 +2623,7 @@ func (w *WrapperVMHooks) ManagedVerifySecp256r1(keyHandle int32, messageHandle i
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedVerifySecp256r1(keyHandle, messageHandle, sigHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedVerifySecp256r1", []int64{int64(keyHandle), int64(messageHandle), int64(sigHandle)}, int64(result))
 	return result
 }
 
@@ -2268,6 +2633,7 @@ func (w *WrapperVMHooks) ManagedVerifyBLSSignatureShare(keyHandle int32, message
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedVerifyBLSSignatureShare(keyHandle, messageHandle, sigHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedVerifyBLSSignatureShare", []int64{int64(keyHandle), int64(messageHandle), int64(sigHandle)}, int64(result))
 	return result
 }
 
@@ -2277,5 +2643,6 @@ func (w *WrapperVMHooks) ManagedVerifyBLSAggregatedSignature(keyHandle int32, me
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedVerifyBLSAggregatedSignature(keyHandle, messageHandle, sigHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
+	w.recordCallWithResult("managedVerifyBLSAggregatedSignature", []int64{int64(keyHandle), int64(messageHandle), int64(sigHandle)}, int64(result))
 	return result
 }
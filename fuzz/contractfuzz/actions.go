@@ -0,0 +1,88 @@
+package contractfuzz
+
+import (
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	fuzzutil "github.com/multiversx/mx-chain-vm-go/fuzz/util"
+)
+
+const defaultEndpointGasLimit = uint64(100_000_000)
+
+// Endpoint declares a contract endpoint which the fuzzer calls with generated arguments
+type Endpoint struct {
+	Name      string
+	Weight    float32
+	Contract  string
+	Caller    Generator
+	Value     Generator
+	Arguments []Generator
+	GasLimit  uint64
+
+	// Check is optional and validates the output of each call
+	Check func(ctx *Context, vmOutput *vmcommon.VMOutput) error
+}
+
+// Action is any weighted event of a fuzzing run, e.g. moving to another block
+type Action struct {
+	Name   string
+	Weight float32
+	Run    func(ctx *Context) error
+}
+
+// Invariant is a property checked periodically during the run and at its end
+type Invariant struct {
+	Name  string
+	Check func(ctx *Context) error
+}
+
+func (endpoint *Endpoint) toAction() *Action {
+	return &Action{
+		Name:   endpoint.Name,
+		Weight: endpoint.Weight,
+		Run:    endpoint.call,
+	}
+}
+
+func (endpoint *Endpoint) call(ctx *Context) error {
+	arguments := make([]string, len(endpoint.Arguments))
+	for i, generator := range endpoint.Arguments {
+		arguments[i] = generator(ctx)
+	}
+
+	value := "0"
+	if endpoint.Value != nil {
+		value = endpoint.Value(ctx)
+	}
+
+	gasLimit := endpoint.GasLimit
+	if gasLimit == 0 {
+		gasLimit = defaultEndpointGasLimit
+	}
+
+	caller := endpoint.Caller(ctx)
+	vmOutput, err := ctx.Call(caller, endpoint.Contract, value, endpoint.Name, arguments, gasLimit)
+	if err != nil {
+		return err
+	}
+
+	ctx.Log("%s, caller: %s, value: %s, arguments: %v -> %s %s",
+		endpoint.Name, caller, value, arguments, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+
+	if endpoint.Check == nil {
+		return nil
+	}
+	return endpoint.Check(ctx, vmOutput)
+}
+
+// chooseAction selects one of the actions, with a probability proportional to its weight
+func chooseAction(randomEvents *fuzzutil.RandomEventProvider, actions []*Action, totalWeight float32) *Action {
+	randomEvents.Reset()
+	lastIndex := len(actions) - 1
+	for _, action := range actions[:lastIndex] {
+		if randomEvents.WithProbability(action.Weight / totalWeight) {
+			return action
+		}
+	}
+
+	// the last action takes whatever probability is left, to avoid rounding errors
+	return actions[lastIndex]
+}
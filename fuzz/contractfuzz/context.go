@@ -0,0 +1,183 @@
+package contractfuzz
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	scenexec "github.com/multiversx/mx-chain-scenario-go/scenario/executor"
+	scenjsonparse "github.com/multiversx/mx-chain-scenario-go/scenario/json/parse"
+	scenmodel "github.com/multiversx/mx-chain-scenario-go/scenario/model"
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// Context is passed to the setup, the actions, the generators and the
+// invariants of a fuzzer. Every step executed through it is recorded in the
+// generated scenario.
+type Context struct {
+	Rand  *rand.Rand
+	World *worldmock.MockWorld
+
+	vmTestExecutor *scenexec.ScenarioExecutor
+	parser         scenjsonparse.Parser
+	scenario       *scenmodel.Scenario
+	txIndex        int
+	verbose        bool
+}
+
+// ExecuteStep parses and executes a scenario step given as JSON
+func (ctx *Context) ExecuteStep(stepSnippet string) error {
+	step, err := ctx.parser.ParseScenarioStep(stepSnippet)
+	if err != nil {
+		return err
+	}
+
+	ctx.addStep(step)
+	return ctx.vmTestExecutor.ExecuteStep(step)
+}
+
+// ExecuteTxStep parses and executes a scenario transaction given as JSON, returning its output
+func (ctx *Context) ExecuteTxStep(stepSnippet string) (*vmcommon.VMOutput, error) {
+	step, err := ctx.parser.ParseScenarioStep(stepSnippet)
+	if err != nil {
+		return nil, err
+	}
+
+	txStep, isTx := step.(*scenmodel.TxStep)
+	if !isTx {
+		return nil, ErrTxStepExpected
+	}
+
+	ctx.addStep(step)
+	return ctx.vmTestExecutor.ExecuteTxStep(txStep)
+}
+
+// Deploy deploys a contract; the new address has to be declared beforehand in a setState step
+func (ctx *Context) Deploy(from string, contractCode string, arguments []string, gasLimit uint64) (*vmcommon.VMOutput, error) {
+	return ctx.ExecuteTxStep(fmt.Sprintf(`
+	{
+		"step": "scDeploy",
+		"txId": "%d",
+		"tx": {
+			"from": %s,
+			"contractCode": %s,
+			"value": "0",
+			"arguments": %s,
+			"gasLimit": "%d",
+			"gasPrice": "0"
+		}
+	}`,
+		ctx.nextTxIndex(),
+		jsonString(from),
+		jsonString(contractCode),
+		jsonStrings(arguments),
+		gasLimit,
+	))
+}
+
+// Call calls a contract endpoint; the output is returned without being checked
+func (ctx *Context) Call(from string, to string, value string, function string, arguments []string, gasLimit uint64) (*vmcommon.VMOutput, error) {
+	return ctx.ExecuteTxStep(fmt.Sprintf(`
+	{
+		"step": "scCall",
+		"txId": "%d",
+		"tx": {
+			"from": %s,
+			"to": %s,
+			"value": %s,
+			"function": %s,
+			"arguments": %s,
+			"gasLimit": "%d",
+			"gasPrice": "0"
+		}
+	}`,
+		ctx.nextTxIndex(),
+		jsonString(from),
+		jsonString(to),
+		jsonString(value),
+		jsonString(function),
+		jsonStrings(arguments),
+		gasLimit,
+	))
+}
+
+// Query runs a contract view function
+func (ctx *Context) Query(to string, function string, arguments []string) (*vmcommon.VMOutput, error) {
+	return ctx.ExecuteTxStep(fmt.Sprintf(`
+	{
+		"step": "scQuery",
+		"txId": "%d",
+		"tx": {
+			"to": %s,
+			"function": %s,
+			"arguments": %s
+		}
+	}`,
+		ctx.nextTxIndex(),
+		jsonString(to),
+		jsonString(function),
+		jsonStrings(arguments),
+	))
+}
+
+// CurrentBlockNonce returns the nonce of the current block of the mock world
+func (ctx *Context) CurrentBlockNonce() uint64 {
+	if ctx.World.CurrentBlockInfo == nil {
+		return 0
+	}
+	return ctx.World.CurrentBlockInfo.BlockNonce
+}
+
+// IncreaseBlockNonce moves the mock world forward by the given number of blocks
+func (ctx *Context) IncreaseBlockNonce(nonceDelta uint64) error {
+	return ctx.ExecuteStep(fmt.Sprintf(`
+	{
+		"step": "setState",
+		"comment": "%d - increase block nonce",
+		"currentBlockInfo": {
+			"blockNonce": "%d"
+		}
+	}`,
+		ctx.nextTxIndex(),
+		ctx.CurrentBlockNonce()+nonceDelta,
+	))
+}
+
+// InterpretExpr evaluates a scenario value expression, e.g. "address:owner"
+func (ctx *Context) InterpretExpr(expression string) []byte {
+	bytes, err := ctx.parser.ExprInterpreter.InterpretString(expression)
+	if err != nil {
+		panic(err)
+	}
+	return bytes
+}
+
+// Log prints a message when the fuzzer runs in verbose mode
+func (ctx *Context) Log(info string, args ...interface{}) {
+	if ctx.verbose {
+		fmt.Printf(info+"\n", args...)
+	}
+}
+
+func (ctx *Context) addStep(step scenmodel.Step) {
+	ctx.scenario.Steps = append(ctx.scenario.Steps, step)
+}
+
+func (ctx *Context) nextTxIndex() int {
+	ctx.txIndex++
+	return ctx.txIndex
+}
+
+func jsonString(value string) string {
+	serialized, _ := json.Marshal(value)
+	return string(serialized)
+}
+
+func jsonStrings(values []string) string {
+	if values == nil {
+		values = make([]string, 0)
+	}
+	serialized, _ := json.Marshal(values)
+	return string(serialized)
+}
@@ -0,0 +1,45 @@
+package contractfuzz
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNoActions signals that the fuzzer was created without any endpoint or action
+var ErrNoActions = errors.New("no endpoints or actions to fuzz")
+
+// ErrInvalidWeight signals that an endpoint or action has a weight which is not strictly positive
+var ErrInvalidWeight = errors.New("invalid weight")
+
+// ErrNilRunFunction signals that an action has no function to run
+var ErrNilRunFunction = errors.New("nil run function")
+
+// ErrNilFileResolver signals that a nil file resolver has been provided
+var ErrNilFileResolver = errors.New("nil file resolver")
+
+// ErrTxStepExpected signals that a step other than a transaction was provided where a transaction was expected
+var ErrTxStepExpected = errors.New("tx step expected")
+
+// ErrEmptyChoice signals that a generator was asked to choose from an empty list of values
+var ErrEmptyChoice = errors.New("empty list of values to choose from")
+
+// FailureError is returned by a fuzzing run which broke an invariant or failed
+// to execute a step; it holds everything needed to replay the run
+type FailureError struct {
+	Seed         int64
+	ScenarioPath string
+	Err          error
+
+	// MinimizedScenarioPath is empty if the failure could not be reproduced by replaying the scenario
+	MinimizedScenarioPath string
+}
+
+// Error returns the error message, together with the seed and the saved scenario
+func (fe *FailureError) Error() string {
+	return fmt.Sprintf("fuzzing failed with seed %d, scenario saved to %s: %v", fe.Seed, fe.ScenarioPath, fe.Err)
+}
+
+// Unwrap returns the error which caused the failure
+func (fe *FailureError) Unwrap() error {
+	return fe.Err
+}
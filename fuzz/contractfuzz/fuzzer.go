@@ -0,0 +1,202 @@
+// Package contractfuzz provides a property-based fuzzer for arbitrary contracts,
+// declared through their endpoints, weighted actions and invariants.
+package contractfuzz
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+
+	fr "github.com/multiversx/mx-chain-scenario-go/scenario/expression/fileresolver"
+	scenjsonparse "github.com/multiversx/mx-chain-scenario-go/scenario/json/parse"
+	scenjsonwrite "github.com/multiversx/mx-chain-scenario-go/scenario/json/write"
+	scenmodel "github.com/multiversx/mx-chain-scenario-go/scenario/model"
+	"github.com/multiversx/mx-chain-vm-go/fuzz/shrink"
+	fuzzutil "github.com/multiversx/mx-chain-vm-go/fuzz/util"
+	vmscenario "github.com/multiversx/mx-chain-vm-go/scenario"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+const defaultInvariantCheckFrequency = 10
+
+// ArgsFuzzer holds the declaration of a fuzzing run
+type ArgsFuzzer struct {
+	Name         string
+	FileResolver fr.FileResolver
+	GasSchedule  scenmodel.GasSchedule
+	Seed         int64
+	NumSteps     int
+
+	// Setup prepares the state before the run, e.g. accounts and deployments
+	Setup      func(ctx *Context) error
+	Endpoints  []*Endpoint
+	Actions    []*Action
+	Invariants []*Invariant
+
+	// InvariantCheckFrequency is the number of steps between invariant checks, 10 by default
+	InvariantCheckFrequency int
+
+	// OutputDir is where the scenario of a failed run is saved, the working directory by default
+	OutputDir string
+	Verbose   bool
+}
+
+// Fuzzer runs random sequences of actions against a contract and checks its invariants
+type Fuzzer struct {
+	args        ArgsFuzzer
+	actions     []*Action
+	totalWeight float32
+}
+
+// NewFuzzer creates a new fuzzer from its declaration
+func NewFuzzer(args ArgsFuzzer) (*Fuzzer, error) {
+	if args.FileResolver == nil {
+		return nil, ErrNilFileResolver
+	}
+
+	actions := make([]*Action, 0, len(args.Endpoints)+len(args.Actions))
+	for _, endpoint := range args.Endpoints {
+		actions = append(actions, endpoint.toAction())
+	}
+	actions = append(actions, args.Actions...)
+	if len(actions) == 0 {
+		return nil, ErrNoActions
+	}
+
+	totalWeight := float32(0)
+	for _, action := range actions {
+		if action.Weight <= 0 {
+			return nil, fmt.Errorf("%w for %s", ErrInvalidWeight, action.Name)
+		}
+		if action.Run == nil {
+			return nil, fmt.Errorf("%w for %s", ErrNilRunFunction, action.Name)
+		}
+		totalWeight += action.Weight
+	}
+
+	if args.InvariantCheckFrequency <= 0 {
+		args.InvariantCheckFrequency = defaultInvariantCheckFrequency
+	}
+
+	return &Fuzzer{
+		args:        args,
+		actions:     actions,
+		totalWeight: totalWeight,
+	}, nil
+}
+
+// Run executes the setup and then NumSteps random actions. On failure, the
+// generated scenario is saved and a *FailureError holding the seed is returned.
+// If the failing step can be replayed, a minimized scenario is saved as well.
+func (fuzzer *Fuzzer) Run() error {
+	ctx, err := fuzzer.newContext()
+	if err != nil {
+		return err
+	}
+	defer ctx.vmTestExecutor.Close()
+
+	err = fuzzer.run(ctx)
+	if err == nil {
+		return nil
+	}
+
+	failure := &FailureError{
+		Seed: fuzzer.args.Seed,
+		Err:  err,
+	}
+
+	var saveErr error
+	failure.ScenarioPath, saveErr = fuzzer.saveScenario(ctx)
+	if saveErr != nil {
+		ctx.Log("could not save the generated scenario: %v", saveErr)
+		return failure
+	}
+
+	minimizedPath := fuzzer.scenarioPath(".min.scen.json")
+	saveErr = shrink.SaveMinimizedScenario(ctx.vmTestExecutor, ctx.scenario, minimizedPath)
+	if saveErr != nil {
+		ctx.Log("could not minimize the generated scenario: %v", saveErr)
+		return failure
+	}
+
+	failure.MinimizedScenarioPath = minimizedPath
+	return failure
+}
+
+func (fuzzer *Fuzzer) newContext() (*Context, error) {
+	vmTestExecutor := vmscenario.DefaultScenarioExecutor()
+	err := vmTestExecutor.InitVM(fuzzer.args.GasSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Context{
+		Rand:           rand.New(rand.NewSource(fuzzer.args.Seed)),
+		World:          vmTestExecutor.World,
+		vmTestExecutor: vmTestExecutor,
+		parser:         scenjsonparse.NewParser(fuzzer.args.FileResolver, vmTestExecutor.GetVMType()),
+		scenario: &scenmodel.Scenario{
+			Name:        fuzzer.args.Name,
+			Comment:     fmt.Sprintf("generated by fuzzing with seed %d", fuzzer.args.Seed),
+			GasSchedule: fuzzer.args.GasSchedule,
+		},
+		verbose: fuzzer.args.Verbose,
+	}, nil
+}
+
+func (fuzzer *Fuzzer) run(ctx *Context) error {
+	ctx.Log("%s: random seed %d", fuzzer.args.Name, fuzzer.args.Seed)
+
+	if fuzzer.args.Setup != nil {
+		err := fuzzer.args.Setup(ctx)
+		if err != nil {
+			return fmt.Errorf("setup: %w", err)
+		}
+	}
+
+	randomEvents := fuzzutil.NewRandomEventProvider(ctx.Rand)
+	for stepIndex := 0; stepIndex < fuzzer.args.NumSteps; stepIndex++ {
+		action := chooseAction(randomEvents, fuzzer.actions, fuzzer.totalWeight)
+		err := action.Run(ctx)
+		if err != nil {
+			return fmt.Errorf("step %d, %s: %w", stepIndex, action.Name, err)
+		}
+
+		if (stepIndex+1)%fuzzer.args.InvariantCheckFrequency == 0 {
+			err = fuzzer.checkInvariants(ctx)
+			if err != nil {
+				return fmt.Errorf("after step %d: %w", stepIndex, err)
+			}
+		}
+	}
+
+	return fuzzer.checkInvariants(ctx)
+}
+
+func (fuzzer *Fuzzer) checkInvariants(ctx *Context) error {
+	for _, invariant := range fuzzer.args.Invariants {
+		err := invariant.Check(ctx)
+		if err != nil {
+			return fmt.Errorf("invariant %s: %w", invariant.Name, err)
+		}
+	}
+	return nil
+}
+
+func (fuzzer *Fuzzer) saveScenario(ctx *Context) (string, error) {
+	vmHost, ok := ctx.vmTestExecutor.GetVM().(vmhost.VMHost)
+	if ok {
+		vmHost.Reset()
+	}
+
+	scenarioPath := fuzzer.scenarioPath(".scen.json")
+	serialized := scenjsonwrite.ScenarioToJSONString(ctx.scenario)
+
+	return scenarioPath, os.WriteFile(scenarioPath, []byte(serialized), 0644)
+}
+
+func (fuzzer *Fuzzer) scenarioPath(extension string) string {
+	fileName := fmt.Sprintf("%s_%d%s", fuzzer.args.Name, fuzzer.args.Seed, extension)
+	return filepath.Join(fuzzer.args.OutputDir, fileName)
+}
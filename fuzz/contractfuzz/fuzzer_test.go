@@ -0,0 +1,232 @@
+package contractfuzz
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	scenio "github.com/multiversx/mx-chain-scenario-go/scenario/io"
+	scenmodel "github.com/multiversx/mx-chain-scenario-go/scenario/model"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	fuzzutil "github.com/multiversx/mx-chain-vm-go/fuzz/util"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	counterOwner    = "address:owner"
+	counterContract = "sc:counter"
+)
+
+var errCounterMismatch = errors.New("counter mismatch")
+
+func noopAction(name string, weight float32) *Action {
+	return &Action{
+		Name:   name,
+		Weight: weight,
+		Run:    func(_ *Context) error { return nil },
+	}
+}
+
+func TestNewFuzzer_InvalidArgs(t *testing.T) {
+	t.Parallel()
+
+	fileResolver := scenio.NewDefaultFileResolver()
+
+	fuzzer, err := NewFuzzer(ArgsFuzzer{})
+	require.Nil(t, fuzzer)
+	require.Equal(t, ErrNilFileResolver, err)
+
+	fuzzer, err = NewFuzzer(ArgsFuzzer{FileResolver: fileResolver})
+	require.Nil(t, fuzzer)
+	require.Equal(t, ErrNoActions, err)
+
+	fuzzer, err = NewFuzzer(ArgsFuzzer{
+		FileResolver: fileResolver,
+		Actions:      []*Action{noopAction("a", 0)},
+	})
+	require.Nil(t, fuzzer)
+	require.ErrorIs(t, err, ErrInvalidWeight)
+
+	fuzzer, err = NewFuzzer(ArgsFuzzer{
+		FileResolver: fileResolver,
+		Actions:      []*Action{{Name: "a", Weight: 1}},
+	})
+	require.Nil(t, fuzzer)
+	require.ErrorIs(t, err, ErrNilRunFunction)
+
+	fuzzer, err = NewFuzzer(ArgsFuzzer{
+		FileResolver: fileResolver,
+		Endpoints:    []*Endpoint{{Name: "increment", Weight: 1}},
+		Actions:      []*Action{noopAction("a", 2)},
+	})
+	require.Nil(t, err)
+	require.Len(t, fuzzer.actions, 2)
+	require.Equal(t, float32(3), fuzzer.totalWeight)
+	require.Equal(t, defaultInvariantCheckFrequency, fuzzer.args.InvariantCheckFrequency)
+}
+
+func TestChooseAction_FollowsWeights(t *testing.T) {
+	t.Parallel()
+
+	actions := []*Action{noopAction("rare", 1), noopAction("frequent", 3)}
+	randomEvents := fuzzutil.NewRandomEventProvider(rand.New(rand.NewSource(1)))
+
+	counts := make(map[string]int)
+	numDraws := 10000
+	for i := 0; i < numDraws; i++ {
+		counts[chooseAction(randomEvents, actions, 4).Name]++
+	}
+
+	require.Equal(t, numDraws, counts["rare"]+counts["frequent"])
+	require.InDelta(t, 0.25, float64(counts["rare"])/float64(numDraws), 0.02)
+}
+
+func TestGenerators(t *testing.T) {
+	t.Parallel()
+
+	ctx := &Context{Rand: rand.New(rand.NewSource(1))}
+
+	require.Equal(t, "str:abc", Constant("str:abc")(ctx))
+	require.Contains(t, []string{"1", "2"}, OneOf("1", "2")(ctx))
+	require.Panics(t, func() { OneOf() })
+
+	for i := 0; i < 100; i++ {
+		value, ok := big.NewInt(0).SetString(RandomUint(5)(ctx), 10)
+		require.True(t, ok)
+		require.True(t, value.Cmp(big.NewInt(5)) <= 0)
+
+		bytesExpression := RandomBytes(4)(ctx)
+		require.LessOrEqual(t, len(bytesExpression), len("0x")+8)
+
+		require.Contains(t, []string{"address:user0", "address:user1"}, IndexedAddress("user", 2)(ctx))
+	}
+}
+
+func newCounterFuzzerArgs(t *testing.T, seed int64) (ArgsFuzzer, *int64) {
+	fileResolver := scenio.NewDefaultFileResolver().
+		ReplacePath("counter.wasm", "../../test/contracts/counter/output/counter.wasm")
+
+	expectedCounter := int64(0)
+	checkReturnedCounter := func(ctx *Context, vmOutput *vmcommon.VMOutput) error {
+		if vmOutput.ReturnCode != vmcommon.Ok {
+			return fmt.Errorf("unexpected return code %s", vmOutput.ReturnCode)
+		}
+		return nil
+	}
+
+	args := ArgsFuzzer{
+		Name:         "counter",
+		FileResolver: fileResolver,
+		GasSchedule:  scenmodel.GasScheduleDummy,
+		Seed:         seed,
+		NumSteps:     50,
+		OutputDir:    t.TempDir(),
+		Setup: func(ctx *Context) error {
+			err := ctx.ExecuteStep(fmt.Sprintf(`
+			{
+				"step": "setState",
+				"accounts": {
+					%s: { "nonce": "0", "balance": "0" }
+				},
+				"newAddresses": [
+					{ "creatorAddress": %s, "creatorNonce": "0", "newAddress": %s }
+				]
+			}`, jsonString(counterOwner), jsonString(counterOwner), jsonString(counterContract)))
+			if err != nil {
+				return err
+			}
+
+			_, err = ctx.Deploy(counterOwner, "file:counter.wasm", nil, 1_000_000)
+			expectedCounter = 1
+			return err
+		},
+		Endpoints: []*Endpoint{
+			{
+				Name:     "increment",
+				Weight:   2,
+				Contract: counterContract,
+				Caller:   Constant(counterOwner),
+				Check: func(ctx *Context, vmOutput *vmcommon.VMOutput) error {
+					expectedCounter++
+					return checkReturnedCounter(ctx, vmOutput)
+				},
+			},
+			{
+				Name:     "decrement",
+				Weight:   1,
+				Contract: counterContract,
+				Caller:   Constant(counterOwner),
+				Check: func(ctx *Context, vmOutput *vmcommon.VMOutput) error {
+					expectedCounter--
+					return checkReturnedCounter(ctx, vmOutput)
+				},
+			},
+		},
+		Actions: []*Action{
+			{
+				Name:   "increaseBlockNonce",
+				Weight: 1,
+				Run: func(ctx *Context) error {
+					return ctx.IncreaseBlockNonce(uint64(ctx.Rand.Intn(10)))
+				},
+			},
+		},
+		Invariants: []*Invariant{
+			{
+				Name: "counter matches model",
+				Check: func(ctx *Context) error {
+					vmOutput, err := ctx.Query(counterContract, "get", nil)
+					if err != nil {
+						return err
+					}
+					actual := big.NewInt(0).SetBytes(vmOutput.ReturnData[0]).Int64()
+					if actual != expectedCounter {
+						return fmt.Errorf("%w: expected %d, got %d", errCounterMismatch, expectedCounter, actual)
+					}
+					return nil
+				},
+			},
+		},
+	}
+
+	return args, &expectedCounter
+}
+
+func TestFuzzer_Counter(t *testing.T) {
+	args, _ := newCounterFuzzerArgs(t, 7)
+	fuzzer, err := NewFuzzer(args)
+	require.Nil(t, err)
+
+	require.Nil(t, fuzzer.Run())
+}
+
+func TestFuzzer_FailureSavesScenario(t *testing.T) {
+	args, expectedCounter := newCounterFuzzerArgs(t, 42)
+	args.Actions = append(args.Actions, &Action{
+		Name:   "corruptModel",
+		Weight: 1,
+		Run: func(_ *Context) error {
+			*expectedCounter += 100
+			return nil
+		},
+	})
+	fuzzer, err := NewFuzzer(args)
+	require.Nil(t, err)
+
+	err = fuzzer.Run()
+	require.ErrorIs(t, err, errCounterMismatch)
+
+	var failure *FailureError
+	require.True(t, errors.As(err, &failure))
+	require.Equal(t, int64(42), failure.Seed)
+	require.Equal(t, filepath.Join(args.OutputDir, "counter_42.scen.json"), failure.ScenarioPath)
+
+	serialized, err := os.ReadFile(failure.ScenarioPath)
+	require.Nil(t, err)
+	require.Contains(t, string(serialized), "generated by fuzzing with seed 42")
+	require.Contains(t, string(serialized), "increment")
+}
@@ -0,0 +1,57 @@
+package contractfuzz
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+)
+
+// Generator produces a scenario value expression, used for callers, values and arguments
+type Generator func(ctx *Context) string
+
+// Constant always generates the same expression
+func Constant(expression string) Generator {
+	return func(_ *Context) string {
+		return expression
+	}
+}
+
+// OneOf generates one of the given expressions, chosen uniformly
+func OneOf(expressions ...string) Generator {
+	if len(expressions) == 0 {
+		panic(ErrEmptyChoice)
+	}
+
+	return func(ctx *Context) string {
+		return expressions[ctx.Rand.Intn(len(expressions))]
+	}
+}
+
+// RandomUint generates a number between 0 and max, inclusive
+func RandomUint(max uint64) Generator {
+	return RandomBigUint(big.NewInt(0).SetUint64(max))
+}
+
+// RandomBigUint generates a number between 0 and max, inclusive
+func RandomBigUint(max *big.Int) Generator {
+	limit := big.NewInt(0).Add(max, big.NewInt(1))
+	return func(ctx *Context) string {
+		return big.NewInt(0).Rand(ctx.Rand, limit).String()
+	}
+}
+
+// RandomBytes generates a hex expression of at most maxLength random bytes
+func RandomBytes(maxLength int) Generator {
+	return func(ctx *Context) string {
+		data := make([]byte, ctx.Rand.Intn(maxLength+1))
+		_, _ = ctx.Rand.Read(data)
+		return "0x" + hex.EncodeToString(data)
+	}
+}
+
+// IndexedAddress generates one of the addresses "address:<prefix><index>", with index lower than count
+func IndexedAddress(prefix string, count int) Generator {
+	return func(ctx *Context) string {
+		return fmt.Sprintf("address:%s%d", prefix, ctx.Rand.Intn(count))
+	}
+}
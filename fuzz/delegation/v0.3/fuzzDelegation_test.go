@@ -50,7 +50,12 @@ func TestFuzzDelegation(t *testing.T) {
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 
 	pfe := newExecutorWithPaths()
-	defer pfe.saveGeneratedScenario()
+	defer func() {
+		pfe.saveGeneratedScenario()
+		if t.Failed() {
+			pfe.saveMinimizedScenario()
+		}
+	}()
 
 	err := pfe.init(&fuzzDelegationExecutorInitArgs{
 		serviceFee:                  r.Intn(10000),
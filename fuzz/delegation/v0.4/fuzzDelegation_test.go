@@ -49,7 +49,12 @@ func TestFuzzDelegation_v0_4_0_genesis(t *testing.T) {
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 
 	pfe := newExecutorWithPaths()
-	defer pfe.saveGeneratedScenario()
+	defer func() {
+		pfe.saveGeneratedScenario()
+		if t.Failed() {
+			pfe.saveMinimizedScenario()
+		}
+	}()
 
 	err := pfe.init(&fuzzDelegationExecutorInitArgs{
 		serviceFee:                  r.Intn(10000),
@@ -18,6 +18,7 @@ import (
 	scenmodel "github.com/multiversx/mx-chain-scenario-go/scenario/model"
 	"github.com/multiversx/mx-chain-scenario-go/worldmock"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/fuzz/shrink"
 	vmscenario "github.com/multiversx/mx-chain-vm-go/scenario"
 	"github.com/multiversx/mx-chain-vm-go/vmhost"
 	"github.com/stretchr/testify/require"
@@ -121,6 +122,14 @@ func (pfe *fuzzDelegationExecutor) saveGeneratedScenario() {
 	}
 }
 
+// saveMinimizedScenario saves the generated scenario, reduced to the steps needed to reproduce its failure
+func (pfe *fuzzDelegationExecutor) saveMinimizedScenario() {
+	err := shrink.SaveMinimizedScenario(pfe.vmTestExecutor, pfe.generatedScenario, "fuzz_gen.min.scen.json")
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
 func (pfe *fuzzDelegationExecutor) executeTxStep(stepSnippet string) (*vmcommon.VMOutput, error) {
 	step, err := pfe.parser.ParseScenarioStep(stepSnippet)
 	if err != nil {
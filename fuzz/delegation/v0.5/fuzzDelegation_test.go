@@ -52,7 +52,12 @@ func TestFuzzDelegation_v0_5(t *testing.T) {
 	}
 
 	pfe := newExecutorWithPaths()
-	defer pfe.saveGeneratedScenario()
+	defer func() {
+		pfe.saveGeneratedScenario()
+		if t.Failed() {
+			pfe.saveMinimizedScenario()
+		}
+	}()
 
 	var seed int64
 	if *seedFlag == 0 {
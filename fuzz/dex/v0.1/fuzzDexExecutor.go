@@ -13,6 +13,7 @@ import (
 	scenmodel "github.com/multiversx/mx-chain-scenario-go/scenario/model"
 	"github.com/multiversx/mx-chain-scenario-go/worldmock"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/fuzz/shrink"
 	vmscenario "github.com/multiversx/mx-chain-vm-go/scenario"
 	"github.com/multiversx/mx-chain-vm-go/vmhost"
 )
@@ -190,6 +191,14 @@ func (pfe *fuzzDexExecutor) saveGeneratedScenario() {
 	}
 }
 
+// saveMinimizedScenario saves the generated scenario, reduced to the steps needed to reproduce its failure
+func (pfe *fuzzDexExecutor) saveMinimizedScenario() {
+	err := shrink.SaveMinimizedScenario(pfe.vmTestExecutor, pfe.generatedScenario, "fuzz_gen.min.scen.json")
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
 func (pfe *fuzzDexExecutor) executeStep(stepSnippet string) error {
 	step, err := pfe.parser.ParseScenarioStep(stepSnippet)
 	if err != nil {
@@ -49,7 +49,12 @@ func TestFuzzDex_v0_1(t *testing.T) {
 	}
 
 	pfe := newExecutorWithPaths()
-	defer pfe.saveGeneratedScenario()
+	defer func() {
+		pfe.saveGeneratedScenario()
+		if t.Failed() {
+			pfe.saveMinimizedScenario()
+		}
+	}()
 
 	var seed int64
 	if *seedFlag == 0 {
@@ -0,0 +1,12 @@
+package shrink
+
+import "errors"
+
+// ErrNilScenarioExecutor signals that a nil scenario executor has been provided
+var ErrNilScenarioExecutor = errors.New("nil scenario executor")
+
+// ErrEmptyScenario signals that the scenario to minimize has no steps
+var ErrEmptyScenario = errors.New("empty scenario")
+
+// ErrFailureNotReproduced signals that replaying the scenario does not fail at its last step
+var ErrFailureNotReproduced = errors.New("failure not reproduced by replaying the scenario")
@@ -0,0 +1,152 @@
+// Package shrink minimizes failing fuzz-generated scenarios through delta debugging.
+package shrink
+
+import (
+	"os"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	scenexec "github.com/multiversx/mx-chain-scenario-go/scenario/executor"
+	scenjsonwrite "github.com/multiversx/mx-chain-scenario-go/scenario/json/write"
+	scenmodel "github.com/multiversx/mx-chain-scenario-go/scenario/model"
+)
+
+var log = logger.GetOrCreate("vm/shrink")
+
+// StepsPredicate returns true if the given steps still reproduce the failure
+type StepsPredicate func(steps []scenmodel.Step) bool
+
+// MinimizeSteps removes steps for as long as the predicate still holds, by
+// testing the complements of increasingly smaller chunks (ddmin). The result
+// is 1-minimal: removing any single step makes the predicate fail.
+func MinimizeSteps(steps []scenmodel.Step, isFailing StepsPredicate) []scenmodel.Step {
+	current := steps
+	numChunks := 2
+
+	for len(current) > 0 {
+		if numChunks > len(current) {
+			numChunks = len(current)
+		}
+
+		reduced := false
+		for _, chunk := range splitIntoChunks(len(current), numChunks) {
+			complement := removeChunk(current, chunk)
+			if isFailing(complement) {
+				current = complement
+				if numChunks > 2 {
+					numChunks--
+				}
+				reduced = true
+				break
+			}
+		}
+
+		if reduced {
+			continue
+		}
+		if numChunks == len(current) {
+			break
+		}
+		numChunks = 2 * numChunks
+	}
+
+	return current
+}
+
+// MinimizeScenario returns a copy of the scenario keeping only the steps needed
+// for its last step to fail with the same error, e.g. a broken invariant or a
+// checkState mismatch. The steps are replayed on the given executor, whose world
+// is cleared before each replay.
+func MinimizeScenario(vmTestExecutor *scenexec.ScenarioExecutor, scenario *scenmodel.Scenario) (*scenmodel.Scenario, error) {
+	if vmTestExecutor == nil {
+		return nil, ErrNilScenarioExecutor
+	}
+	if len(scenario.Steps) == 0 {
+		return nil, ErrEmptyScenario
+	}
+
+	lastIndex := len(scenario.Steps) - 1
+	failingStep := scenario.Steps[lastIndex]
+	expectedErr := replayUntilLastStep(vmTestExecutor, scenario.Steps)
+	if expectedErr == nil {
+		return nil, ErrFailureNotReproduced
+	}
+
+	numReplays := 1
+	isFailing := func(steps []scenmodel.Step) bool {
+		numReplays++
+		candidate := make([]scenmodel.Step, 0, len(steps)+1)
+		candidate = append(candidate, steps...)
+		candidate = append(candidate, failingStep)
+		err := replayUntilLastStep(vmTestExecutor, candidate)
+		return err != nil && err.Error() == expectedErr.Error()
+	}
+
+	minimizedSteps := MinimizeSteps(scenario.Steps[:lastIndex], isFailing)
+	minimizedSteps = append(minimizedSteps, failingStep)
+	log.Debug("scenario minimized",
+		"initial steps", len(scenario.Steps),
+		"minimized steps", len(minimizedSteps),
+		"replays", numReplays)
+
+	minimized := *scenario
+	minimized.Steps = minimizedSteps
+	return &minimized, nil
+}
+
+// SaveMinimizedScenario minimizes the scenario and writes it as JSON to the given path
+func SaveMinimizedScenario(vmTestExecutor *scenexec.ScenarioExecutor, scenario *scenmodel.Scenario, path string) error {
+	minimized, err := MinimizeScenario(vmTestExecutor, scenario)
+	if err != nil {
+		return err
+	}
+
+	serialized := scenjsonwrite.ScenarioToJSONString(minimized)
+	return os.WriteFile(path, []byte(serialized), 0644)
+}
+
+// replayUntilLastStep returns the error of the last step, or nil if the replay
+// panics, succeeds or fails at an earlier step
+func replayUntilLastStep(vmTestExecutor *scenexec.ScenarioExecutor, steps []scenmodel.Step) (lastStepErr error) {
+	defer func() {
+		r := recover()
+		if r != nil {
+			log.Trace("replay panicked", "error", r)
+			lastStepErr = nil
+		}
+	}()
+
+	vmTestExecutor.Reset()
+	for index, step := range steps {
+		err := vmTestExecutor.ExecuteStep(step)
+		if err != nil {
+			if index == len(steps)-1 {
+				return err
+			}
+			return nil
+		}
+	}
+
+	return nil
+}
+
+type chunk struct {
+	start int
+	end   int
+}
+
+func splitIntoChunks(length int, numChunks int) []chunk {
+	chunks := make([]chunk, 0, numChunks)
+	start := 0
+	for i := 0; i < numChunks; i++ {
+		end := start + (length-start)/(numChunks-i)
+		chunks = append(chunks, chunk{start: start, end: end})
+		start = end
+	}
+	return chunks
+}
+
+func removeChunk(steps []scenmodel.Step, toRemove chunk) []scenmodel.Step {
+	complement := make([]scenmodel.Step, 0, len(steps)-(toRemove.end-toRemove.start))
+	complement = append(complement, steps[:toRemove.start]...)
+	return append(complement, steps[toRemove.end:]...)
+}
@@ -0,0 +1,168 @@
+package shrink
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	scenio "github.com/multiversx/mx-chain-scenario-go/scenario/io"
+	scenjsonparse "github.com/multiversx/mx-chain-scenario-go/scenario/json/parse"
+	scenmodel "github.com/multiversx/mx-chain-scenario-go/scenario/model"
+	vmscenario "github.com/multiversx/mx-chain-vm-go/scenario"
+	"github.com/stretchr/testify/require"
+)
+
+type testStep struct {
+	id int
+}
+
+func (step *testStep) StepTypeName() string {
+	return "test"
+}
+
+func makeTestSteps(count int) []scenmodel.Step {
+	steps := make([]scenmodel.Step, count)
+	for i := range steps {
+		steps[i] = &testStep{id: i}
+	}
+	return steps
+}
+
+func containsAllIDs(steps []scenmodel.Step, ids ...int) bool {
+	present := make(map[int]bool)
+	for _, step := range steps {
+		present[step.(*testStep).id] = true
+	}
+	for _, id := range ids {
+		if !present[id] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestMinimizeSteps_KeepsOnlyRelevantSteps(t *testing.T) {
+	t.Parallel()
+
+	steps := makeTestSteps(100)
+	minimized := MinimizeSteps(steps, func(candidate []scenmodel.Step) bool {
+		return containsAllIDs(candidate, 3, 42, 97)
+	})
+
+	require.Len(t, minimized, 3)
+	require.True(t, containsAllIDs(minimized, 3, 42, 97))
+	require.Len(t, steps, 100)
+}
+
+func TestMinimizeSteps_PreservesOrder(t *testing.T) {
+	t.Parallel()
+
+	steps := makeTestSteps(10)
+	minimized := MinimizeSteps(steps, func(candidate []scenmodel.Step) bool {
+		return containsAllIDs(candidate, 8, 1)
+	})
+
+	require.Equal(t, []scenmodel.Step{steps[1], steps[8]}, minimized)
+}
+
+func TestMinimizeSteps_NoStepNeeded(t *testing.T) {
+	t.Parallel()
+
+	minimized := MinimizeSteps(makeTestSteps(7), func(_ []scenmodel.Step) bool {
+		return true
+	})
+	require.Empty(t, minimized)
+}
+
+func parseTestScenario(t *testing.T, stepSnippets ...string) *scenmodel.Scenario {
+	parser := scenjsonparse.NewParser(scenio.NewDefaultFileResolver(), []byte{5, 0})
+	scenario := &scenmodel.Scenario{Name: "shrink test"}
+	for _, snippet := range stepSnippets {
+		step, err := parser.ParseScenarioStep(snippet)
+		require.Nil(t, err)
+		scenario.Steps = append(scenario.Steps, step)
+	}
+	return scenario
+}
+
+func setBalanceStep(address string, balance string) string {
+	return `{
+		"step": "setState",
+		"accounts": {
+			"` + address + `": { "nonce": "0", "balance": "` + balance + `" }
+		}
+	}`
+}
+
+func checkBalancesStep(balanceA string, balanceB string) string {
+	return `{
+		"step": "checkState",
+		"accounts": {
+			"address:a": { "nonce": "*", "balance": "` + balanceA + `", "storage": "*", "code": "*" },
+			"address:b": { "nonce": "*", "balance": "` + balanceB + `", "storage": "*", "code": "*" },
+			"+": ""
+		}
+	}`
+}
+
+func TestMinimizeScenario_CheckStateMismatch(t *testing.T) {
+	t.Parallel()
+
+	scenario := parseTestScenario(t,
+		setBalanceStep("address:a", "1"),
+		setBalanceStep("address:b", "1"),
+		setBalanceStep("address:c", "1"),
+		setBalanceStep("address:a", "2"),
+		setBalanceStep("address:c", "2"),
+		checkBalancesStep("2", "2"),
+	)
+
+	minimized, err := MinimizeScenario(vmscenario.DefaultScenarioExecutor(), scenario)
+	require.Nil(t, err)
+	require.Equal(t, []scenmodel.Step{scenario.Steps[1], scenario.Steps[3], scenario.Steps[5]}, minimized.Steps)
+	require.Equal(t, scenario.Name, minimized.Name)
+	require.Len(t, scenario.Steps, 6)
+}
+
+func TestMinimizeScenario_FailureNotReproduced(t *testing.T) {
+	t.Parallel()
+
+	scenario := parseTestScenario(t,
+		setBalanceStep("address:a", "2"),
+		setBalanceStep("address:b", "2"),
+		checkBalancesStep("2", "2"),
+	)
+
+	minimized, err := MinimizeScenario(vmscenario.DefaultScenarioExecutor(), scenario)
+	require.Nil(t, minimized)
+	require.Equal(t, ErrFailureNotReproduced, err)
+
+	minimized, err = MinimizeScenario(nil, scenario)
+	require.Nil(t, minimized)
+	require.Equal(t, ErrNilScenarioExecutor, err)
+
+	minimized, err = MinimizeScenario(vmscenario.DefaultScenarioExecutor(), &scenmodel.Scenario{})
+	require.Nil(t, minimized)
+	require.Equal(t, ErrEmptyScenario, err)
+}
+
+func TestSaveMinimizedScenario(t *testing.T) {
+	t.Parallel()
+
+	scenario := parseTestScenario(t,
+		setBalanceStep("address:a", "1"),
+		setBalanceStep("address:c", "3"),
+		setBalanceStep("address:b", "7"),
+		checkBalancesStep("1", "2"),
+	)
+
+	path := filepath.Join(t.TempDir(), "min.scen.json")
+	err := SaveMinimizedScenario(vmscenario.DefaultScenarioExecutor(), scenario, path)
+	require.Nil(t, err)
+
+	serialized, err := os.ReadFile(path)
+	require.Nil(t, err)
+	require.Contains(t, string(serialized), "checkState")
+	require.Contains(t, string(serialized), "address:b")
+	require.NotContains(t, string(serialized), "address:c")
+}
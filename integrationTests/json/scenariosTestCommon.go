@@ -12,10 +12,10 @@ import (
 	logger "github.com/multiversx/mx-chain-logger-go"
 	scenexec "github.com/multiversx/mx-chain-scenario-go/scenario/executor"
 	scenio "github.com/multiversx/mx-chain-scenario-go/scenario/io"
-	"github.com/multiversx/mx-chain-scenario-go/worldmock"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/multiversx/mx-chain-vm-go/executor"
 	executorwrapper "github.com/multiversx/mx-chain-vm-go/executor/wrapper"
+	mockworld "github.com/multiversx/mx-chain-vm-go/mock/world"
 	vmscenario "github.com/multiversx/mx-chain-vm-go/scenario"
 	"github.com/multiversx/mx-chain-vm-go/testcommon/testexecutor"
 	"github.com/stretchr/testify/require"
@@ -55,7 +55,7 @@ func ScenariosTest(t *testing.T) *ScenariosTestBuilder {
 		singleFile:          "",
 		executorLogger:      nil,
 		executorFactory:     nil,
-		enableEpochsHandler: worldmock.EnableEpochsHandlerStubAllFlags(),
+		enableEpochsHandler: mockworld.EnableEpochsHandlerStubDefaultFlags(),
 	}
 }
 
@@ -0,0 +1,54 @@
+package mock
+
+import (
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+var _ vmhost.AsyncCallGraphTracer = (*AsyncCallGraphTracerMock)(nil)
+
+// AsyncCallGraphTracerMock is a tracer which records nothing, used by the host mocks
+type AsyncCallGraphTracerMock struct {
+}
+
+// BeginTransaction mocked method
+func (tracer *AsyncCallGraphTracerMock) BeginTransaction(_ *vmcommon.ContractCallInput) {
+}
+
+// EndTransaction mocked method
+func (tracer *AsyncCallGraphTracerMock) EndTransaction(_ *vmcommon.VMOutput) {
+}
+
+// BeginCall mocked method
+func (tracer *AsyncCallGraphTracerMock) BeginCall(_ *vmcommon.ContractCallInput) {
+}
+
+// EndCall mocked method
+func (tracer *AsyncCallGraphTracerMock) EndCall(_ *vmcommon.VMOutput, _ error) {
+}
+
+// TraceRegisterAsyncCall mocked method
+func (tracer *AsyncCallGraphTracerMock) TraceRegisterAsyncCall(_ string, _ *vmhost.AsyncCall) {
+}
+
+// TraceAsyncCallExecution mocked method
+func (tracer *AsyncCallGraphTracerMock) TraceAsyncCallExecution(_ *vmhost.AsyncCall) {
+}
+
+// TraceCallbackExecution mocked method
+func (tracer *AsyncCallGraphTracerMock) TraceCallbackExecution(_ *vmhost.AsyncCall) {
+}
+
+// TraceChildComplete mocked method
+func (tracer *AsyncCallGraphTracerMock) TraceChildComplete(_ []byte, _ uint64) {
+}
+
+// GetExecutionGraph mocked method
+func (tracer *AsyncCallGraphTracerMock) GetExecutionGraph() *vmhost.AsyncExecutionGraph {
+	return nil
+}
+
+// IsInterfaceNil mocked method
+func (tracer *AsyncCallGraphTracerMock) IsInterfaceNil() bool {
+	return tracer == nil
+}
@@ -76,6 +76,10 @@ func (executorMock *ExecutorMock) CreateAndStoreInstanceMock(t testing.TB, host
 		account.ShardID = shardID
 		account.CodeMetadata = codeMetadata
 		account.OwnerAddress = ownerAddress
+		// the world mock recomputes the code hash when setting the code, so the requested one is restored
+		if codeHash != nil {
+			account.CodeHash = codeHash
+		}
 	}
 
 	return instance
@@ -84,6 +84,10 @@ func (m *MeteringContextMock) UpdateGasStateOnSuccess(_ *vmcommon.VMOutput) erro
 	return nil
 }
 
+// ApplyGasRefund mocked method
+func (m *MeteringContextMock) ApplyGasRefund(_ *vmcommon.VMOutput) {
+}
+
 // UpdateGasStateOnFailure mocked method
 func (m *MeteringContextMock) UpdateGasStateOnFailure(_ *vmcommon.VMOutput) {}
 
@@ -198,6 +198,24 @@ func (o *OutputContextMock) GetVMOutput() *vmcommon.VMOutput {
 func (o *OutputContextMock) RemoveNonUpdatedStorage() {
 }
 
+// GetTransientStorage mocked method
+func (o *OutputContextMock) GetTransientStorage(_ []byte, _ []byte) []byte {
+	return nil
+}
+
+// SetTransientStorage mocked method
+func (o *OutputContextMock) SetTransientStorage(_ []byte, _ []byte, _ []byte) {
+}
+
+// SetLogLimits mocked method
+func (o *OutputContextMock) SetLogLimits(_ vmhost.LogLimits) {
+}
+
+// CheckLogLimits mocked method
+func (o *OutputContextMock) CheckLogLimits(_ [][]byte, _ [][]byte) error {
+	return nil
+}
+
 // DeployCode mocked method
 func (o *OutputContextMock) DeployCode(_ vmhost.CodeDeployInput) {
 }
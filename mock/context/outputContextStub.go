@@ -46,6 +46,10 @@ type OutputContextStub struct {
 	AddToActiveStateCalled            func(vmOutput *vmcommon.VMOutput)
 	TransferValueOnlyCalled           func(destination []byte, sender []byte, value *big.Int, checkPayable bool) error
 	RemoveNonUpdatedStorageCalled     func()
+	GetTransientStorageCalled         func(address []byte, key []byte) []byte
+	SetTransientStorageCalled         func(address []byte, key []byte, value []byte)
+	SetLogLimitsCalled                func(limits vmhost.LogLimits)
+	CheckLogLimitsCalled              func(topics [][]byte, data [][]byte) error
 	NextOutputTransferIndexCalled     func() uint32
 	GetCrtTransferIndexCalled         func() uint32
 	SetCrtTransferIndexCalled         func(index uint32)
@@ -281,6 +285,36 @@ func (o *OutputContextStub) RemoveNonUpdatedStorage() {
 	}
 }
 
+// GetTransientStorage mocked method
+func (o *OutputContextStub) GetTransientStorage(address []byte, key []byte) []byte {
+	if o.GetTransientStorageCalled != nil {
+		return o.GetTransientStorageCalled(address, key)
+	}
+	return nil
+}
+
+// SetTransientStorage mocked method
+func (o *OutputContextStub) SetTransientStorage(address []byte, key []byte, value []byte) {
+	if o.SetTransientStorageCalled != nil {
+		o.SetTransientStorageCalled(address, key, value)
+	}
+}
+
+// SetLogLimits mocked method
+func (o *OutputContextStub) SetLogLimits(limits vmhost.LogLimits) {
+	if o.SetLogLimitsCalled != nil {
+		o.SetLogLimitsCalled(limits)
+	}
+}
+
+// CheckLogLimits mocked method
+func (o *OutputContextStub) CheckLogLimits(topics [][]byte, data [][]byte) error {
+	if o.CheckLogLimitsCalled != nil {
+		return o.CheckLogLimitsCalled(topics, data)
+	}
+	return nil
+}
+
 // AddTxValueToAccount mocked method
 func (o *OutputContextStub) AddTxValueToAccount(address []byte, value *big.Int) {
 	if o.AddTxValueToAccountCalled != nil {
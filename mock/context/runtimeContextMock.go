@@ -122,6 +122,10 @@ func (r *RuntimeContextMock) GetInstanceStackSize() uint64 {
 func (r *RuntimeContextMock) SetMaxInstanceStackSize(uint64) {
 }
 
+// SetCodeValidationPolicy mocked method
+func (r *RuntimeContextMock) SetCodeValidationPolicy(_ *vmhost.CodeValidationPolicy) {
+}
+
 // ClearInstanceStack mocked method
 func (r *RuntimeContextMock) ClearInstanceStack() {
 }
@@ -229,7 +233,7 @@ func (r *RuntimeContextMock) PrepareLegacyAsyncCall(_ []byte, _ []byte, _ []byte
 }
 
 // VerifyContractCode mocked method
-func (r *RuntimeContextMock) VerifyContractCode() error {
+func (r *RuntimeContextMock) VerifyContractCode(_ []byte) error {
 	return r.Err
 }
 
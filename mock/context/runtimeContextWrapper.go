@@ -78,7 +78,9 @@ type RuntimeContextWrapper struct {
 	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
 	SetMaxInstanceStackSizeFunc func(maxInstanceStackSize uint64)
 	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
-	VerifyContractCodeFunc func() error
+	SetCodeValidationPolicyFunc func(policy *vmhost.CodeValidationPolicy)
+	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
+	VerifyContractCodeFunc func(code []byte) error
 	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
 	GetInstanceFunc func() executor.Instance
 	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
@@ -236,8 +238,12 @@ func NewRuntimeContextWrapper(inputRuntimeContext *vmhost.RuntimeContext) *Runti
 		runtimeWrapper.runtimeContext.SetMaxInstanceStackSize(maxInstanceStackSize)
 	}
 
-	runtimeWrapper.VerifyContractCodeFunc = func() error {
-		return runtimeWrapper.runtimeContext.VerifyContractCode()
+	runtimeWrapper.SetCodeValidationPolicyFunc = func(policy *vmhost.CodeValidationPolicy) {
+		runtimeWrapper.runtimeContext.SetCodeValidationPolicy(policy)
+	}
+
+	runtimeWrapper.VerifyContractCodeFunc = func(code []byte) error {
+		return runtimeWrapper.runtimeContext.VerifyContractCode(code)
 	}
 
 	runtimeWrapper.GetInstanceFunc = func() executor.Instance {
@@ -468,9 +474,14 @@ func (contextWrapper *RuntimeContextWrapper) SetMaxInstanceStackSize(maxInstance
 	contextWrapper.SetMaxInstanceStackSizeFunc(maxInstanceStackSize)
 }
 
+// SetCodeValidationPolicy calls corresponding xxxFunc function, that by default in turn calls the original method of the wrapped RuntimeContext
+func (contextWrapper *RuntimeContextWrapper) SetCodeValidationPolicy(policy *vmhost.CodeValidationPolicy) {
+	contextWrapper.SetCodeValidationPolicyFunc(policy)
+}
+
 // VerifyContractCode calls corresponding xxxFunc function, that by default in turn calls the original method of the wrapped RuntimeContext
-func (contextWrapper *RuntimeContextWrapper) VerifyContractCode() error {
-	return contextWrapper.VerifyContractCodeFunc()
+func (contextWrapper *RuntimeContextWrapper) VerifyContractCode(code []byte) error {
+	return contextWrapper.VerifyContractCodeFunc(code)
 }
 
 // GetInstance calls corresponding xxxFunc function, that by default in turn calls the original method of the wrapped RuntimeContext
@@ -17,14 +17,15 @@ type VMHostMock struct {
 
 	EthInput []byte
 
-	BlockchainContext        vmhost.BlockchainContext
-	RuntimeContext           vmhost.RuntimeContext
-	AsyncContext             vmhost.AsyncContext
-	OutputContext            vmhost.OutputContext
-	MeteringContext          vmhost.MeteringContext
-	StorageContext           vmhost.StorageContext
-	EnableEpochsHandlerField vmhost.EnableEpochsHandler
-	ManagedTypesContext      vmhost.ManagedTypesContext
+	BlockchainContext         vmhost.BlockchainContext
+	RuntimeContext            vmhost.RuntimeContext
+	AsyncContext              vmhost.AsyncContext
+	OutputContext             vmhost.OutputContext
+	MeteringContext           vmhost.MeteringContext
+	StorageContext            vmhost.StorageContext
+	EnableEpochsHandlerField  vmhost.EnableEpochsHandler
+	ManagedTypesContext       vmhost.ManagedTypesContext
+	AsyncCallGraphTracerField vmhost.AsyncCallGraphTracer
 
 	IsBuiltinFunc bool
 
@@ -265,3 +266,26 @@ func (host *VMHostMock) SetGasTracing(enableGasTracing bool) {
 func (host *VMHostMock) GetGasTrace() map[string]map[string][]uint64 {
 	return make(map[string]map[string][]uint64)
 }
+
+// SetAsyncCallGraphTracer -
+func (host *VMHostMock) SetAsyncCallGraphTracer(tracer vmhost.AsyncCallGraphTracer) {
+	host.AsyncCallGraphTracerField = tracer
+}
+
+// AsyncCallGraphTracer -
+func (host *VMHostMock) AsyncCallGraphTracer() vmhost.AsyncCallGraphTracer {
+	if host.AsyncCallGraphTracerField == nil {
+		return &AsyncCallGraphTracerMock{}
+	}
+	return host.AsyncCallGraphTracerField
+}
+
+// ExecuteQuery mocked method
+func (host *VMHostMock) ExecuteQuery(_ *vmhost.QueryInput) (*vmhost.QueryOutput, error) {
+	return nil, nil
+}
+
+// ExecuteQueries mocked method
+func (host *VMHostMock) ExecuteQueries(_ []*vmhost.QueryInput) []*vmhost.QueryResult {
+	return nil
+}
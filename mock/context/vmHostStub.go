@@ -44,6 +44,9 @@ type VMHostStub struct {
 	GasScheduleChangeCalled              func(newGasSchedule config.GasScheduleMap)
 	IsInterfaceNilCalled                 func() bool
 	CompleteLogEntriesWithCallTypeCalled func(vmOutput *vmcommon.VMOutput, callType string)
+	ExecuteQueryCalled                   func(input *vmhost.QueryInput) (*vmhost.QueryOutput, error)
+	ExecuteQueriesCalled                 func(inputs []*vmhost.QueryInput) []*vmhost.QueryResult
+	AsyncCallGraphTracerCalled           func() vmhost.AsyncCallGraphTracer
 
 	SetRuntimeContextCalled func(runtime vmhost.RuntimeContext)
 
@@ -333,3 +336,31 @@ func (vhs *VMHostStub) SetGasTracing(enableGasTracing bool) {
 func (vhs *VMHostStub) GetGasTrace() map[string]map[string][]uint64 {
 	return make(map[string]map[string][]uint64)
 }
+
+// SetAsyncCallGraphTracer -
+func (vhs *VMHostStub) SetAsyncCallGraphTracer(tracer vmhost.AsyncCallGraphTracer) {
+}
+
+// AsyncCallGraphTracer -
+func (vhs *VMHostStub) AsyncCallGraphTracer() vmhost.AsyncCallGraphTracer {
+	if vhs.AsyncCallGraphTracerCalled != nil {
+		return vhs.AsyncCallGraphTracerCalled()
+	}
+	return &AsyncCallGraphTracerMock{}
+}
+
+// ExecuteQuery mocked method
+func (vhs *VMHostStub) ExecuteQuery(input *vmhost.QueryInput) (*vmhost.QueryOutput, error) {
+	if vhs.ExecuteQueryCalled != nil {
+		return vhs.ExecuteQueryCalled(input)
+	}
+	return nil, nil
+}
+
+// ExecuteQueries mocked method
+func (vhs *VMHostStub) ExecuteQueries(inputs []*vmhost.QueryInput) []*vmhost.QueryResult {
+	if vhs.ExecuteQueriesCalled != nil {
+		return vhs.ExecuteQueriesCalled(inputs)
+	}
+	return nil
+}
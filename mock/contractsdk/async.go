@@ -0,0 +1,124 @@
+package contractsdk
+
+import (
+	"math/big"
+
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-common-go/txDataBuilder"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/vmhooks"
+)
+
+// AsyncCallBuilder prepares an async call, registered like createAsyncCall
+type AsyncCallBuilder struct {
+	ctx               *Context
+	destination       []byte
+	function          string
+	arguments         [][]byte
+	value             *big.Int
+	gasLimit          uint64
+	gasForCallback    uint64
+	successCallback   string
+	errorCallback     string
+	callbackClosure   []byte
+	deadlineRound     uint64
+	deadlineTimestamp uint64
+}
+
+// AsyncCall starts building an async call of the function on the destination
+func (ctx *Context) AsyncCall(destination []byte, function string) *AsyncCallBuilder {
+	return &AsyncCallBuilder{
+		ctx:         ctx,
+		destination: destination,
+		function:    function,
+		value:       big.NewInt(0),
+	}
+}
+
+// WithArguments appends arguments to the call
+func (builder *AsyncCallBuilder) WithArguments(arguments ...[]byte) *AsyncCallBuilder {
+	builder.arguments = append(builder.arguments, arguments...)
+	return builder
+}
+
+// WithValue sets the EGLD value sent with the call
+func (builder *AsyncCallBuilder) WithValue(value *big.Int) *AsyncCallBuilder {
+	builder.value = value
+	return builder
+}
+
+// WithGasLimit sets the gas given to the called function
+func (builder *AsyncCallBuilder) WithGasLimit(gasLimit uint64) *AsyncCallBuilder {
+	builder.gasLimit = gasLimit
+	return builder
+}
+
+// WithCallback sets the endpoint called back on both success and error
+func (builder *AsyncCallBuilder) WithCallback(callback string) *AsyncCallBuilder {
+	return builder.WithCallbacks(callback, callback)
+}
+
+// WithCallbacks sets distinct endpoints called back on success and on error
+func (builder *AsyncCallBuilder) WithCallbacks(successCallback string, errorCallback string) *AsyncCallBuilder {
+	builder.successCallback = successCallback
+	builder.errorCallback = errorCallback
+	return builder
+}
+
+// WithGasForCallback sets the gas locked for the callback
+func (builder *AsyncCallBuilder) WithGasForCallback(gasForCallback uint64) *AsyncCallBuilder {
+	builder.gasForCallback = gasForCallback
+	return builder
+}
+
+// WithCallbackClosure sets the data given back to the callback
+func (builder *AsyncCallBuilder) WithCallbackClosure(callbackClosure []byte) *AsyncCallBuilder {
+	builder.callbackClosure = callbackClosure
+	return builder
+}
+
+// WithDeadline sets the round and timestamp after which the call times out, zero meaning none
+func (builder *AsyncCallBuilder) WithDeadline(round uint64, timestamp uint64) *AsyncCallBuilder {
+	builder.deadlineRound = round
+	builder.deadlineTimestamp = timestamp
+	return builder
+}
+
+// Register registers the async call, failing the execution if it is rejected
+func (builder *AsyncCallBuilder) Register() {
+	callData := txDataBuilder.NewBuilder()
+	callData.Func(builder.function)
+	for _, argument := range builder.arguments {
+		callData.Bytes(argument)
+	}
+
+	result := vmhooks.CreateAsyncCallWithDeadline(builder.ctx.host,
+		builder.destination,
+		builder.value.Bytes(),
+		callData.ToBytes(),
+		[]byte(builder.successCallback),
+		[]byte(builder.errorCallback),
+		int64(builder.gasLimit),
+		int64(builder.gasForCallback),
+		builder.callbackClosure,
+		builder.deadlineRound,
+		builder.deadlineTimestamp)
+	builder.ctx.abortOnBreakpoint()
+	if result != 0 {
+		builder.ctx.Fail(ErrAsyncCallRegistration)
+	}
+}
+
+// CallbackResult decodes the arguments of a callback into the return code of
+// the async call and the data it returned
+func (ctx *Context) CallbackResult() (vmcommon.ReturnCode, [][]byte) {
+	returnCode := vmcommon.ReturnCode(ctx.ArgUint64(0))
+	arguments := ctx.host.Runtime().Arguments()
+	return returnCode, arguments[1:]
+}
+
+// CallbackClosure returns the closure given when the async call was registered
+func (ctx *Context) CallbackClosure() []byte {
+	closure, err := ctx.host.Async().GetCallbackClosure()
+	ctx.failOnError(err)
+	return closure
+}
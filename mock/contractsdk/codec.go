@@ -0,0 +1,42 @@
+package contractsdk
+
+import (
+	"encoding/binary"
+	"math/big"
+)
+
+// encodeUint64 returns the minimal big endian encoding, empty for zero
+func encodeUint64(value uint64) []byte {
+	return big.NewInt(0).SetUint64(value).Bytes()
+}
+
+func decodeUint64(data []byte) (uint64, error) {
+	if len(data) > 8 {
+		return 0, ErrValueTooLarge
+	}
+
+	padded := make([]byte, 8)
+	copy(padded[8-len(data):], data)
+	return binary.BigEndian.Uint64(padded), nil
+}
+
+// encodeBool returns 1 for true and empty for false
+func encodeBool(value bool) []byte {
+	if value {
+		return []byte{1}
+	}
+	return []byte{}
+}
+
+func decodeBool(data []byte) (bool, error) {
+	switch {
+	case len(data) == 0:
+		return false, nil
+	case len(data) == 1 && data[0] == 1:
+		return true, nil
+	case len(data) == 1 && data[0] == 0:
+		return false, nil
+	default:
+		return false, ErrInvalidBoolEncoding
+	}
+}
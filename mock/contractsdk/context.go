@@ -0,0 +1,257 @@
+package contractsdk
+
+import (
+	"fmt"
+	"math/big"
+
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/math"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/vmhooks"
+)
+
+// abortSignal stops the endpoint once the execution has been failed
+type abortSignal struct{}
+
+// Context is the view of an endpoint over the VM host during a single call
+type Context struct {
+	host   vmhost.VMHost
+	config interface{}
+}
+
+func newContext(host vmhost.VMHost, config interface{}) *Context {
+	return &Context{
+		host:   host,
+		config: config,
+	}
+}
+
+func (ctx *Context) run(endpoint EndpointFunc) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		_, isAbort := r.(abortSignal)
+		if !isAbort {
+			panic(r)
+		}
+	}()
+
+	endpoint(ctx)
+}
+
+// Host returns the VM host, for anything not covered by the context
+func (ctx *Context) Host() vmhost.VMHost {
+	return ctx.host
+}
+
+// Config returns the test configuration given to MockTestSmartContract.WithConfig
+func (ctx *Context) Config() interface{} {
+	return ctx.config
+}
+
+// SignalError fails the call with a user error and stops the endpoint
+func (ctx *Context) SignalError(message string) {
+	ctx.host.Runtime().SignalUserError(message)
+	panic(abortSignal{})
+}
+
+// Require signals a user error with the given message if the condition does not hold
+func (ctx *Context) Require(condition bool, message string) {
+	if !condition {
+		ctx.SignalError(message)
+	}
+}
+
+// Fail fails the execution with the given error and stops the endpoint
+func (ctx *Context) Fail(err error) {
+	ctx.host.Runtime().FailExecution(err)
+	panic(abortSignal{})
+}
+
+func (ctx *Context) failOnError(err error) {
+	if err != nil {
+		ctx.Fail(err)
+	}
+}
+
+// abortOnBreakpoint stops the endpoint if a hook has already failed the execution
+func (ctx *Context) abortOnBreakpoint() {
+	if ctx.host.Runtime().GetRuntimeBreakpointValue() != vmhost.BreakpointNone {
+		panic(abortSignal{})
+	}
+}
+
+// UseGas consumes gas as if it were spent by the contract code
+func (ctx *Context) UseGas(gas uint64) {
+	err := ctx.host.Metering().UseGasBounded(gas)
+	if err != nil {
+		ctx.host.Runtime().SetRuntimeBreakpointValue(vmhost.BreakpointOutOfGas)
+		panic(abortSignal{})
+	}
+}
+
+// SelfAddress returns the address of the executing contract
+func (ctx *Context) SelfAddress() []byte {
+	return ctx.host.Runtime().GetContextAddress()
+}
+
+// Caller returns the address of the caller
+func (ctx *Context) Caller() []byte {
+	return ctx.host.Runtime().GetVMInput().CallerAddr
+}
+
+// CallValue returns the EGLD value of the call
+func (ctx *Context) CallValue() *big.Int {
+	callValue := ctx.host.Runtime().GetVMInput().CallValue
+	if callValue == nil {
+		return big.NewInt(0)
+	}
+	return big.NewInt(0).Set(callValue)
+}
+
+// ESDTTransfers returns the ESDT tokens received with the call
+func (ctx *Context) ESDTTransfers() []*vmcommon.ESDTTransfer {
+	return ctx.host.Runtime().GetVMInput().ESDTTransfers
+}
+
+// Balance returns the EGLD balance of the given account
+func (ctx *Context) Balance(address []byte) *big.Int {
+	return ctx.host.Blockchain().GetBalanceBigInt(address)
+}
+
+// BlockNonce returns the nonce of the current block
+func (ctx *Context) BlockNonce() uint64 {
+	return ctx.host.Blockchain().CurrentNonce()
+}
+
+// BlockRound returns the round of the current block
+func (ctx *Context) BlockRound() uint64 {
+	return ctx.host.Blockchain().CurrentRound()
+}
+
+// BlockTimestamp returns the timestamp of the current block
+func (ctx *Context) BlockTimestamp() uint64 {
+	return ctx.host.Blockchain().CurrentTimeStamp()
+}
+
+// NumArguments returns the number of call arguments
+func (ctx *Context) NumArguments() int {
+	return len(ctx.host.Runtime().Arguments())
+}
+
+// RequireNumArguments signals a user error unless exactly the given number of arguments was provided
+func (ctx *Context) RequireNumArguments(expected int) {
+	actual := ctx.NumArguments()
+	if actual != expected {
+		ctx.SignalError(fmt.Sprintf("%s: expected %d, got %d", ErrWrongNumberOfArguments, expected, actual))
+	}
+}
+
+// ArgBytes returns the raw argument at the given index
+func (ctx *Context) ArgBytes(index int) []byte {
+	arguments := ctx.host.Runtime().Arguments()
+	if index < 0 || index >= len(arguments) {
+		ctx.SignalError(fmt.Sprintf("%s: %d", ErrArgumentIndexOutOfRange, index))
+	}
+	return arguments[index]
+}
+
+// ArgString decodes the argument at the given index as a string
+func (ctx *Context) ArgString(index int) string {
+	return string(ctx.ArgBytes(index))
+}
+
+// ArgBigUint decodes the argument at the given index as an unsigned big integer
+func (ctx *Context) ArgBigUint(index int) *big.Int {
+	return big.NewInt(0).SetBytes(ctx.ArgBytes(index))
+}
+
+// ArgUint64 decodes the argument at the given index as an uint64
+func (ctx *Context) ArgUint64(index int) uint64 {
+	value, err := decodeUint64(ctx.ArgBytes(index))
+	if err != nil {
+		ctx.argumentDecodeError(index, err)
+	}
+	return value
+}
+
+// ArgBool decodes the argument at the given index as a bool, encoded as 1 or empty
+func (ctx *Context) ArgBool(index int) bool {
+	value, err := decodeBool(ctx.ArgBytes(index))
+	if err != nil {
+		ctx.argumentDecodeError(index, err)
+	}
+	return value
+}
+
+// ArgAddress decodes the argument at the given index as an address
+func (ctx *Context) ArgAddress(index int) []byte {
+	address := ctx.ArgBytes(index)
+	if len(address) != vmhost.AddressLen {
+		ctx.argumentDecodeError(index, fmt.Errorf("address of %d bytes", len(address)))
+	}
+	return address
+}
+
+func (ctx *Context) argumentDecodeError(index int, err error) {
+	ctx.SignalError(fmt.Sprintf("%s (argument %d): %v", ErrArgumentDecode, index, err))
+}
+
+// Finish appends raw data to the return data of the call
+func (ctx *Context) Finish(data []byte) {
+	ctx.host.Output().Finish(data)
+}
+
+// FinishString appends a string to the return data of the call
+func (ctx *Context) FinishString(value string) {
+	ctx.Finish([]byte(value))
+}
+
+// FinishBigUint appends an unsigned big integer to the return data of the call
+func (ctx *Context) FinishBigUint(value *big.Int) {
+	ctx.Finish(value.Bytes())
+}
+
+// FinishUint64 appends an uint64 to the return data of the call
+func (ctx *Context) FinishUint64(value uint64) {
+	ctx.Finish(encodeUint64(value))
+}
+
+// FinishBool appends a bool to the return data of the call
+func (ctx *Context) FinishBool(value bool) {
+	ctx.Finish(encodeBool(value))
+}
+
+// EmitEvent writes a log entry with the identifier as its first topic, charged like managedWriteLog
+func (ctx *Context) EmitEvent(identifier string, topics [][]byte, data []byte) {
+	allTopics := make([][]byte, 0, len(topics)+1)
+	allTopics = append(allTopics, []byte(identifier))
+	allTopics = append(allTopics, topics...)
+
+	numBytes := uint64(len(data))
+	for _, topic := range allTopics {
+		numBytes += uint64(len(topic))
+	}
+
+	gasSchedule := ctx.host.Metering().GasSchedule()
+	gasForData := math.MulUint64(gasSchedule.BaseOperationCost.DataCopyPerByte, numBytes)
+	ctx.UseGas(math.AddUint64(gasSchedule.BaseOpsAPICost.Log, gasForData))
+
+	ctx.host.Output().WriteLog(ctx.SelfAddress(), allTopics, [][]byte{data})
+}
+
+// TransferValue sends EGLD from the contract to the destination
+func (ctx *Context) TransferValue(destination []byte, value *big.Int) {
+	ctx.TransferValueExecute(destination, value, 0, "")
+}
+
+// TransferValueExecute sends EGLD from the contract and executes the function on the destination
+func (ctx *Context) TransferValueExecute(destination []byte, value *big.Int, gasLimit uint64, function string, arguments ...[]byte) {
+	result := vmhooks.TransferValueExecuteWithTypedArgs(ctx.host, destination, value, int64(gasLimit), []byte(function), arguments)
+	ctx.abortOnBreakpoint()
+	if result != 0 {
+		ctx.Fail(ErrTransferFailed)
+	}
+}
@@ -0,0 +1,84 @@
+// Package contractsdk allows writing mock contracts as plain Go functions, with
+// typed arguments, storage mappers, events and async calls, executed by the
+// mock executor inside a real VM host.
+package contractsdk
+
+import (
+	"fmt"
+	"sort"
+
+	mock "github.com/multiversx/mx-chain-vm-go/mock/context"
+)
+
+// EndpointFunc is the Go implementation of a contract endpoint
+type EndpointFunc func(ctx *Context)
+
+// Contract is a set of endpoints which can be installed on a mock contract
+type Contract struct {
+	endpoints map[string]EndpointFunc
+}
+
+// NewContract creates a contract without endpoints
+func NewContract() *Contract {
+	return &Contract{
+		endpoints: make(map[string]EndpointFunc),
+	}
+}
+
+// AddEndpoint declares an endpoint, returning an error for an invalid or duplicate declaration
+func (contract *Contract) AddEndpoint(name string, endpoint EndpointFunc) error {
+	if len(name) == 0 {
+		return ErrEmptyEndpointName
+	}
+	if endpoint == nil {
+		return fmt.Errorf("%w for %s", ErrNilEndpoint, name)
+	}
+	_, exists := contract.endpoints[name]
+	if exists {
+		return fmt.Errorf("%w: %s", ErrDuplicateEndpoint, name)
+	}
+
+	contract.endpoints[name] = endpoint
+	return nil
+}
+
+// Endpoint declares an endpoint and panics on an invalid declaration, to be chained in tests
+func (contract *Contract) Endpoint(name string, endpoint EndpointFunc) *Contract {
+	err := contract.AddEndpoint(name, endpoint)
+	if err != nil {
+		panic(err)
+	}
+	return contract
+}
+
+// EndpointNames returns the sorted names of the declared endpoints
+func (contract *Contract) EndpointNames() []string {
+	names := make([]string, 0, len(contract.endpoints))
+	for name := range contract.endpoints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Methods returns the initializer which installs the endpoints on a mock
+// instance, to be passed to MockTestSmartContract.WithMethods
+func (contract *Contract) Methods() func(*mock.InstanceMock, interface{}) {
+	return func(instanceMock *mock.InstanceMock, config interface{}) {
+		for name, endpoint := range contract.endpoints {
+			instanceMock.AddMockMethod(name, wrapEndpoint(instanceMock, config, endpoint))
+		}
+	}
+}
+
+func wrapEndpoint(instanceMock *mock.InstanceMock, config interface{}, endpoint EndpointFunc) func() *mock.InstanceMock {
+	return func() *mock.InstanceMock {
+		host := instanceMock.Host
+		instance := mock.GetMockInstance(host)
+
+		ctx := newContext(host, config)
+		ctx.run(endpoint)
+
+		return instance
+	}
+}
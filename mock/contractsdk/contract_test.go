@@ -0,0 +1,171 @@
+package contractsdk
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	test "github.com/multiversx/mx-chain-vm-go/testcommon"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	sumKey         = []byte("sum")
+	childArgKey    = []byte("childArg")
+	callbackKey    = []byte("callbackResult")
+	callbackRCKey  = []byte("callbackReturnCode")
+	historyLenKey  = []byte("history.len")
+	historyItemKey = []byte("history.item\x00\x00\x00\x01")
+)
+
+func newAdderContract() *Contract {
+	return NewContract().
+		Endpoint("add", func(ctx *Context) {
+			ctx.RequireNumArguments(1)
+			value := ctx.ArgBigUint(0)
+			ctx.Require(value.Sign() > 0, "zero value")
+
+			sum := ctx.SingleValue("sum")
+			newSum := big.NewInt(0).Add(sum.BigUint(), value)
+			sum.SetBigUint(newSum)
+
+			ctx.EmitEvent("added", [][]byte{ctx.Caller()}, value.Bytes())
+			ctx.FinishBigUint(newSum)
+		}).
+		Endpoint("record", func(ctx *Context) {
+			history := ctx.Vec("history")
+			index := history.Push(ctx.ArgBytes(0))
+			ctx.FinishUint64(uint64(index))
+			ctx.Finish(history.Get(index))
+		})
+}
+
+func callAdder(t *testing.T, function string, arguments ...[]byte) *test.MockInstancesTestTemplate {
+	return test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(newAdderContract().Methods()),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithCallerAddr(test.UserAddress).
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(1_000_000).
+			WithFunction(function).
+			WithArguments(arguments...).
+			Build())
+}
+
+func TestContract_Declaration(t *testing.T) {
+	t.Parallel()
+
+	noop := func(_ *Context) {}
+	contract := NewContract()
+
+	require.Equal(t, ErrEmptyEndpointName, contract.AddEndpoint("", noop))
+	require.ErrorIs(t, contract.AddEndpoint("a", nil), ErrNilEndpoint)
+	require.Nil(t, contract.AddEndpoint("b", noop))
+	require.Nil(t, contract.AddEndpoint("a", noop))
+	require.ErrorIs(t, contract.AddEndpoint("a", noop), ErrDuplicateEndpoint)
+	require.Panics(t, func() { contract.Endpoint("b", noop) })
+
+	require.Equal(t, []string{"a", "b"}, contract.EndpointNames())
+}
+
+func TestContract_StorageEventsAndResults(t *testing.T) {
+	_, err := callAdder(t, "add", big.NewInt(7).Bytes()).
+		WithSetup(func(_ vmhost.VMHost, world *worldmock.MockWorld) {
+			world.AcctMap.GetAccount(test.ParentAddress).Storage[string(sumKey)] = big.NewInt(3).Bytes()
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData(big.NewInt(10).Bytes()).
+				Storage(test.CreateStoreEntry(test.ParentAddress).WithKey(sumKey).WithValue(big.NewInt(10).Bytes())).
+				Logs(vmcommon.LogEntry{
+					Address:    test.ParentAddress,
+					Identifier: []byte("add"),
+					Topics:     [][]byte{[]byte("added"), test.UserAddress},
+					Data:       [][]byte{big.NewInt(7).Bytes()},
+				})
+		})
+	require.Nil(t, err)
+}
+
+func TestContract_ArgumentErrors(t *testing.T) {
+	_, err := callAdder(t, "add").
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.UserError().
+				ReturnMessage("wrong number of arguments: expected 1, got 0")
+		})
+	require.Nil(t, err)
+
+	_, err = callAdder(t, "add", []byte{}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.UserError().
+				ReturnMessage("zero value").
+				Storage()
+		})
+	require.Nil(t, err)
+}
+
+func TestContract_VecMapper(t *testing.T) {
+	_, err := callAdder(t, "record", []byte("first")).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData([]byte{1}, []byte("first")).
+				Storage(
+					test.CreateStoreEntry(test.ParentAddress).WithKey(historyItemKey).WithValue([]byte("first")),
+					test.CreateStoreEntry(test.ParentAddress).WithKey(historyLenKey).WithValue([]byte{1}),
+				)
+		})
+	require.Nil(t, err)
+}
+
+func TestContract_AsyncCall(t *testing.T) {
+	parent := NewContract().
+		Endpoint("callChild", func(ctx *Context) {
+			ctx.AsyncCall(test.ChildAddress, "childMethod").
+				WithArguments([]byte("ping")).
+				WithGasLimit(100_000).
+				WithGasForCallback(100_000).
+				WithCallback("callBack").
+				Register()
+		}).
+		Endpoint("callBack", func(ctx *Context) {
+			returnCode, results := ctx.CallbackResult()
+			ctx.SingleValue(string(callbackRCKey)).SetBytes([]byte{byte(returnCode)})
+			ctx.SingleValue(string(callbackKey)).SetBytes(results[0])
+		})
+	child := NewContract().
+		Endpoint("childMethod", func(ctx *Context) {
+			ctx.SingleValue(string(childArgKey)).SetString(ctx.ArgString(0))
+			ctx.FinishString("pong")
+		})
+
+	vmOutput, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(parent.Methods()),
+			test.CreateMockContract(test.ChildAddress).
+				WithBalance(1000).
+				WithMethods(child.Methods()),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithCallerAddr(test.UserAddress).
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(1_000_000).
+			WithFunction("callChild").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok()
+		})
+	require.Nil(t, err)
+
+	require.Equal(t, []byte("ping"), vmOutput.OutputAccounts[string(test.ChildAddress)].StorageUpdates[string(childArgKey)].Data)
+	parentStorage := vmOutput.OutputAccounts[string(test.ParentAddress)].StorageUpdates
+	require.Equal(t, []byte("pong"), parentStorage[string(callbackKey)].Data)
+	require.Equal(t, []byte{byte(vmcommon.Ok)}, parentStorage[string(callbackRCKey)].Data)
+}
@@ -0,0 +1,39 @@
+package contractsdk
+
+import "errors"
+
+// ErrEmptyEndpointName signals that an endpoint was declared without a name
+var ErrEmptyEndpointName = errors.New("empty endpoint name")
+
+// ErrNilEndpoint signals that an endpoint was declared without a function
+var ErrNilEndpoint = errors.New("nil endpoint function")
+
+// ErrDuplicateEndpoint signals that an endpoint with the same name was already declared
+var ErrDuplicateEndpoint = errors.New("duplicate endpoint")
+
+// ErrArgumentIndexOutOfRange signals that an endpoint read an argument which was not provided
+var ErrArgumentIndexOutOfRange = errors.New("argument index out of range")
+
+// ErrWrongNumberOfArguments signals that an endpoint was called with an unexpected number of arguments
+var ErrWrongNumberOfArguments = errors.New("wrong number of arguments")
+
+// ErrArgumentDecode signals that an argument could not be decoded to the requested type
+var ErrArgumentDecode = errors.New("argument decode error")
+
+// ErrStorageDecode signals that a stored value could not be decoded to the requested type
+var ErrStorageDecode = errors.New("storage decode error")
+
+// ErrAsyncCallRegistration signals that the async call could not be registered
+var ErrAsyncCallRegistration = errors.New("async call registration failed")
+
+// ErrTransferFailed signals that a transfer from the contract failed
+var ErrTransferFailed = errors.New("transfer failed")
+
+// ErrStorageStore signals that a value could not be written to storage
+var ErrStorageStore = errors.New("storage store failed")
+
+// ErrValueTooLarge signals that an encoded integer does not fit in 64 bits
+var ErrValueTooLarge = errors.New("value does not fit in 64 bits")
+
+// ErrInvalidBoolEncoding signals that a value is neither empty, 0 nor 1
+var ErrInvalidBoolEncoding = errors.New("invalid bool encoding")
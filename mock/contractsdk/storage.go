@@ -0,0 +1,196 @@
+package contractsdk
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-vm-go/vmhost/vmhooks"
+)
+
+const (
+	vecLenSuffix  = ".len"
+	vecItemSuffix = ".item"
+)
+
+// StorageKey concatenates a base key and its parts, e.g. a mapping key
+func StorageKey(baseKey string, keyParts ...[]byte) []byte {
+	key := []byte(baseKey)
+	for _, part := range keyParts {
+		key = append(key, part...)
+	}
+	return key
+}
+
+func (ctx *Context) storageLoad(key []byte) []byte {
+	data, err := vmhooks.StorageLoadWithWithTypedArgs(ctx.host, key)
+	ctx.failOnError(err)
+	return data
+}
+
+func (ctx *Context) storageStore(key []byte, data []byte) {
+	result := vmhooks.StorageStoreWithTypedArgs(ctx.host, key, data)
+	ctx.abortOnBreakpoint()
+	if result < 0 {
+		ctx.Fail(fmt.Errorf("%w for key %x", ErrStorageStore, key))
+	}
+}
+
+// SingleValueMapper is a typed view over a single storage key, metered as storageLoad and storageStore
+type SingleValueMapper struct {
+	ctx *Context
+	key []byte
+}
+
+// SingleValue returns the mapper of the key built from the base key and its parts
+func (ctx *Context) SingleValue(baseKey string, keyParts ...[]byte) *SingleValueMapper {
+	return &SingleValueMapper{
+		ctx: ctx,
+		key: StorageKey(baseKey, keyParts...),
+	}
+}
+
+// Key returns the storage key of the mapper
+func (mapper *SingleValueMapper) Key() []byte {
+	return mapper.key
+}
+
+// IsEmpty returns true if nothing is stored under the key
+func (mapper *SingleValueMapper) IsEmpty() bool {
+	return len(mapper.Bytes()) == 0
+}
+
+// Clear deletes the stored value
+func (mapper *SingleValueMapper) Clear() {
+	mapper.SetBytes(nil)
+}
+
+// Bytes returns the raw stored value
+func (mapper *SingleValueMapper) Bytes() []byte {
+	return mapper.ctx.storageLoad(mapper.key)
+}
+
+// SetBytes stores a raw value
+func (mapper *SingleValueMapper) SetBytes(value []byte) {
+	mapper.ctx.storageStore(mapper.key, value)
+}
+
+// String returns the stored value as a string
+func (mapper *SingleValueMapper) String() string {
+	return string(mapper.Bytes())
+}
+
+// SetString stores a string
+func (mapper *SingleValueMapper) SetString(value string) {
+	mapper.SetBytes([]byte(value))
+}
+
+// BigUint returns the stored value as an unsigned big integer
+func (mapper *SingleValueMapper) BigUint() *big.Int {
+	return big.NewInt(0).SetBytes(mapper.Bytes())
+}
+
+// SetBigUint stores an unsigned big integer
+func (mapper *SingleValueMapper) SetBigUint(value *big.Int) {
+	mapper.SetBytes(value.Bytes())
+}
+
+// Uint64 returns the stored value as an uint64
+func (mapper *SingleValueMapper) Uint64() uint64 {
+	value, err := decodeUint64(mapper.Bytes())
+	if err != nil {
+		mapper.ctx.Fail(fmt.Errorf("%w for key %x: %v", ErrStorageDecode, mapper.key, err))
+	}
+	return value
+}
+
+// SetUint64 stores an uint64
+func (mapper *SingleValueMapper) SetUint64(value uint64) {
+	mapper.SetBytes(encodeUint64(value))
+}
+
+// Bool returns the stored value as a bool
+func (mapper *SingleValueMapper) Bool() bool {
+	value, err := decodeBool(mapper.Bytes())
+	if err != nil {
+		mapper.ctx.Fail(fmt.Errorf("%w for key %x: %v", ErrStorageDecode, mapper.key, err))
+	}
+	return value
+}
+
+// SetBool stores a bool
+func (mapper *SingleValueMapper) SetBool(value bool) {
+	mapper.SetBytes(encodeBool(value))
+}
+
+// VecMapper is a list of raw values kept in storage with the same layout as
+// the VecMapper of the Rust framework: the length under "<key>.len" and the
+// items under "<key>.item<index>", with indices starting at 1.
+type VecMapper struct {
+	ctx     *Context
+	baseKey []byte
+}
+
+// Vec returns the list mapper of the key built from the base key and its parts
+func (ctx *Context) Vec(baseKey string, keyParts ...[]byte) *VecMapper {
+	return &VecMapper{
+		ctx:     ctx,
+		baseKey: StorageKey(baseKey, keyParts...),
+	}
+}
+
+func (mapper *VecMapper) lenMapper() *SingleValueMapper {
+	return &SingleValueMapper{
+		ctx: mapper.ctx,
+		key: StorageKey(string(mapper.baseKey), []byte(vecLenSuffix)),
+	}
+}
+
+func (mapper *VecMapper) itemMapper(index uint32) *SingleValueMapper {
+	encodedIndex := make([]byte, 4)
+	binary.BigEndian.PutUint32(encodedIndex, index)
+	return &SingleValueMapper{
+		ctx: mapper.ctx,
+		key: StorageKey(string(mapper.baseKey), []byte(vecItemSuffix), encodedIndex),
+	}
+}
+
+// Len returns the number of items
+func (mapper *VecMapper) Len() uint32 {
+	return uint32(mapper.lenMapper().Uint64())
+}
+
+// Push appends an item and returns its index
+func (mapper *VecMapper) Push(value []byte) uint32 {
+	index := mapper.Len() + 1
+	mapper.itemMapper(index).SetBytes(value)
+	mapper.lenMapper().SetUint64(uint64(index))
+	return index
+}
+
+// Get returns the item at the given index, signaling a user error if it is out of range
+func (mapper *VecMapper) Get(index uint32) []byte {
+	mapper.checkIndex(index)
+	return mapper.itemMapper(index).Bytes()
+}
+
+// Set replaces the item at the given index, signaling a user error if it is out of range
+func (mapper *VecMapper) Set(index uint32, value []byte) {
+	mapper.checkIndex(index)
+	mapper.itemMapper(index).SetBytes(value)
+}
+
+// Clear deletes all the items
+func (mapper *VecMapper) Clear() {
+	length := mapper.Len()
+	for index := uint32(1); index <= length; index++ {
+		mapper.itemMapper(index).Clear()
+	}
+	mapper.lenMapper().Clear()
+}
+
+func (mapper *VecMapper) checkIndex(index uint32) {
+	if index == 0 || index > mapper.Len() {
+		mapper.ctx.SignalError(fmt.Sprintf("index %d out of range", index))
+	}
+}
@@ -0,0 +1,34 @@
+package mockworld
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// OptInFlags lists the flags which change the gas or the results of existing contracts,
+// so the default test worlds keep them disabled and only their dedicated tests enable them
+var OptInFlags = []core.EnableEpochFlag{
+	vmhost.StorageAccountingFlag,
+	vmhost.StorageGasRefundFlag,
+	vmhost.LogLimitsFlag,
+}
+
+// EnableEpochsHandlerStubDefaultFlags creates an EnableEpochsHandler enabling all the flags except OptInFlags
+func EnableEpochsHandlerStubDefaultFlags() *worldmock.EnableEpochsHandlerStub {
+	isFlagEnabled := func(flag core.EnableEpochFlag) bool {
+		for _, optInFlag := range OptInFlags {
+			if flag == optInFlag {
+				return false
+			}
+		}
+		return true
+	}
+
+	return &worldmock.EnableEpochsHandlerStub{
+		IsFlagEnabledCalled: isFlagEnabled,
+		IsFlagEnabledInEpochCalled: func(flag core.EnableEpochFlag, _ uint32) bool {
+			return isFlagEnabled(flag)
+		},
+	}
+}
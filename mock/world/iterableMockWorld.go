@@ -0,0 +1,59 @@
+// Package mockworld extends the mock world of the scenario framework with the
+// BlockchainHook features that are specific to this VM.
+package mockworld
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+var _ vmhost.StorageIteratorHook = (*IterableMockWorld)(nil)
+
+// IterableMockWorld is a MockWorld which can also iterate the storage of its accounts
+type IterableMockWorld struct {
+	*worldmock.MockWorld
+}
+
+// NewIterableMockWorld wraps the given MockWorld
+func NewIterableMockWorld(world *worldmock.MockWorld) *IterableMockWorld {
+	return &IterableMockWorld{
+		MockWorld: world,
+	}
+}
+
+// GetStorageKeysWithPrefix returns, in ascending order, at most maxKeys non-empty storage keys
+// of the account which start with the prefix and come after the cursor
+func (world *IterableMockWorld) GetStorageKeysWithPrefix(address []byte, prefix []byte, cursor []byte, maxKeys uint32) ([][]byte, error) {
+	if world.Err != nil {
+		return nil, world.Err
+	}
+
+	keys := make([][]byte, 0)
+	account := world.AcctMap.GetAccount(address)
+	if account == nil {
+		return keys, nil
+	}
+
+	for key, value := range account.Storage {
+		if len(value) == 0 || !bytes.HasPrefix([]byte(key), prefix) || bytes.Compare([]byte(key), cursor) <= 0 {
+			continue
+		}
+		keys = append(keys, []byte(key))
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+	if uint32(len(keys)) > maxKeys {
+		keys = keys[:maxKeys]
+	}
+
+	return keys, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (world *IterableMockWorld) IsInterfaceNil() bool {
+	return world == nil || world.MockWorld == nil
+}
@@ -0,0 +1,48 @@
+package mockworld
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIterableMockWorld_GetStorageKeysWithPrefix(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("account")
+	world := worldmock.NewMockWorld()
+	world.AcctMap.PutAccount(&worldmock.Account{
+		Address: address,
+		Storage: map[string][]byte{
+			"item3": []byte("c"),
+			"item1": []byte("a"),
+			"item2": []byte("b"),
+			"empty": {},
+			"other": []byte("d"),
+		},
+	})
+	iterableWorld := NewIterableMockWorld(world)
+
+	keys, err := iterableWorld.GetStorageKeysWithPrefix(address, []byte("item"), nil, 2)
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{[]byte("item1"), []byte("item2")}, keys)
+
+	keys, err = iterableWorld.GetStorageKeysWithPrefix(address, []byte("item"), []byte("item2"), 2)
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{[]byte("item3")}, keys)
+
+	keys, err = iterableWorld.GetStorageKeysWithPrefix(address, nil, nil, 10)
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{[]byte("item1"), []byte("item2"), []byte("item3"), []byte("other")}, keys)
+
+	keys, err = iterableWorld.GetStorageKeysWithPrefix([]byte("missing"), nil, nil, 10)
+	require.Nil(t, err)
+	require.Empty(t, keys)
+
+	world.Err = errors.New("world error")
+	keys, err = iterableWorld.GetStorageKeysWithPrefix(address, nil, nil, 10)
+	require.Nil(t, keys)
+	require.Equal(t, world.Err, err)
+}
@@ -0,0 +1,27 @@
+package replay
+
+import "errors"
+
+// ErrNilBlockchainHook signals that a nil blockchain hook was provided
+var ErrNilBlockchainHook = errors.New("nil blockchain hook")
+
+// ErrNilExecutionRecord signals that a nil execution record was provided
+var ErrNilExecutionRecord = errors.New("nil execution record")
+
+// ErrNilRecordedInput signals that the execution record does not contain the call input
+var ErrNilRecordedInput = errors.New("nil recorded input")
+
+// ErrNilVMFactory signals that a nil VM factory was provided
+var ErrNilVMFactory = errors.New("nil VM factory")
+
+// ErrMissingRecordedResponse signals that the replayed execution requested a value which was not recorded
+var ErrMissingRecordedResponse = errors.New("missing recorded response")
+
+// ErrInvalidResponseKey signals that a recorded response key does not match the arguments of its method
+var ErrInvalidResponseKey = errors.New("invalid recorded response key")
+
+// ErrNilVMOutput signals that a nil VMOutput was provided
+var ErrNilVMOutput = errors.New("nil VMOutput")
+
+// ErrNilPreState signals that a nil pre-state was provided
+var ErrNilPreState = errors.New("nil pre-state")
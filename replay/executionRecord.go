@@ -0,0 +1,242 @@
+// Package replay records the blockchain reads of a smart contract execution and re-executes it locally
+package replay
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// ExecutionRecord holds everything needed to re-execute a smart contract call:
+// the input, every response given by the BlockchainHook and the original result.
+// ActiveFlags is nil when the flags of the original execution are unknown.
+type ExecutionRecord struct {
+	Input       *vmcommon.ContractCallInput
+	Output      *VMOutputRecord              `json:",omitempty"`
+	Error       string                       `json:",omitempty"`
+	GasSchedule map[string]map[string]uint64 `json:",omitempty"`
+	ActiveFlags []string
+	Responses   map[string][]*HookResponse
+}
+
+// NewEnableEpochsHandler creates an EnableEpochsHandler which enables exactly
+// the flags that were active during the recorded execution
+func (record *ExecutionRecord) NewEnableEpochsHandler() vmhost.EnableEpochsHandler {
+	activeFlags := make(map[core.EnableEpochFlag]struct{}, len(record.ActiveFlags))
+	for _, flag := range record.ActiveFlags {
+		activeFlags[core.EnableEpochFlag(flag)] = struct{}{}
+	}
+
+	return &worldmock.EnableEpochsHandlerStub{
+		IsFlagEnabledCalled: func(flag core.EnableEpochFlag) bool {
+			_, isActive := activeFlags[flag]
+			return isActive
+		},
+		IsFlagEnabledInEpochCalled: func(flag core.EnableEpochFlag, _ uint32) bool {
+			_, isActive := activeFlags[flag]
+			return isActive
+		},
+	}
+}
+
+// HookResponse holds the values returned by a single BlockchainHook call.
+// Only the fields relevant to the recorded method are filled. State is keyed
+// by the hex-encoded storage key.
+type HookResponse struct {
+	Bytes     []byte               `json:",omitempty"`
+	Uint64    uint64               `json:",omitempty"`
+	Int       int                  `json:",omitempty"`
+	Bool      bool                 `json:",omitempty"`
+	Names     []string             `json:",omitempty"`
+	State     map[string][]byte    `json:",omitempty"`
+	Account   *AccountRecord       `json:",omitempty"`
+	ESDTToken *esdt.ESDigitalToken `json:",omitempty"`
+	VMOutput  *VMOutputRecord      `json:",omitempty"`
+	Error     string               `json:",omitempty"`
+}
+
+// AccountRecord is the serializable snapshot of a vmcommon.UserAccountHandler
+type AccountRecord struct {
+	Address         []byte
+	Nonce           uint64
+	Balance         *big.Int
+	CodeHash        []byte
+	CodeMetadata    []byte
+	RootHash        []byte
+	OwnerAddress    []byte
+	UserName        []byte
+	DeveloperReward *big.Int
+}
+
+// VMOutputRecord is the serializable form of a vmcommon.VMOutput. The maps
+// of the original structure are keyed by raw bytes, which do not survive a
+// JSON round-trip, so they are stored as slices instead.
+type VMOutputRecord struct {
+	ReturnData      [][]byte
+	ReturnCode      vmcommon.ReturnCode
+	ReturnMessage   string
+	GasRemaining    uint64
+	GasRefund       *big.Int
+	OutputAccounts  []*OutputAccountRecord
+	DeletedAccounts [][]byte
+	TouchedAccounts [][]byte
+	Logs            []*vmcommon.LogEntry
+}
+
+// OutputAccountRecord is the serializable form of a vmcommon.OutputAccount
+type OutputAccountRecord struct {
+	Address                       []byte
+	Nonce                         uint64
+	Balance                       *big.Int
+	BalanceDelta                  *big.Int
+	StorageUpdates                []*vmcommon.StorageUpdate
+	Code                          []byte
+	CodeMetadata                  []byte
+	CodeDeployerAddress           []byte
+	OutputTransfers               []vmcommon.OutputTransfer
+	GasUsed                       uint64
+	BytesAddedToStorage           uint64
+	BytesDeletedFromStorage       uint64
+	BytesConsumedByTxAsNetworking uint64
+}
+
+// NewVMOutputRecord converts a VMOutput into its serializable form
+func NewVMOutputRecord(vmOutput *vmcommon.VMOutput) *VMOutputRecord {
+	if vmOutput == nil {
+		return nil
+	}
+
+	record := &VMOutputRecord{
+		ReturnData:      vmOutput.ReturnData,
+		ReturnCode:      vmOutput.ReturnCode,
+		ReturnMessage:   vmOutput.ReturnMessage,
+		GasRemaining:    vmOutput.GasRemaining,
+		GasRefund:       vmOutput.GasRefund,
+		OutputAccounts:  make([]*OutputAccountRecord, 0, len(vmOutput.OutputAccounts)),
+		DeletedAccounts: vmOutput.DeletedAccounts,
+		TouchedAccounts: vmOutput.TouchedAccounts,
+		Logs:            vmOutput.Logs,
+	}
+
+	for _, key := range sortedOutputAccountKeys(vmOutput.OutputAccounts) {
+		account := vmOutput.OutputAccounts[key]
+		accountRecord := &OutputAccountRecord{
+			Address:                       account.Address,
+			Nonce:                         account.Nonce,
+			Balance:                       account.Balance,
+			BalanceDelta:                  account.BalanceDelta,
+			StorageUpdates:                make([]*vmcommon.StorageUpdate, 0, len(account.StorageUpdates)),
+			Code:                          account.Code,
+			CodeMetadata:                  account.CodeMetadata,
+			CodeDeployerAddress:           account.CodeDeployerAddress,
+			OutputTransfers:               account.OutputTransfers,
+			GasUsed:                       account.GasUsed,
+			BytesAddedToStorage:           account.BytesAddedToStorage,
+			BytesDeletedFromStorage:       account.BytesDeletedFromStorage,
+			BytesConsumedByTxAsNetworking: account.BytesConsumedByTxAsNetworking,
+		}
+		for _, storageKey := range sortedStorageUpdateKeys(account.StorageUpdates) {
+			accountRecord.StorageUpdates = append(accountRecord.StorageUpdates, account.StorageUpdates[storageKey])
+		}
+		record.OutputAccounts = append(record.OutputAccounts, accountRecord)
+	}
+
+	return record
+}
+
+// ToVMOutput rebuilds the VMOutput from its serializable form
+func (record *VMOutputRecord) ToVMOutput() *vmcommon.VMOutput {
+	if record == nil {
+		return nil
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnData:      record.ReturnData,
+		ReturnCode:      record.ReturnCode,
+		ReturnMessage:   record.ReturnMessage,
+		GasRemaining:    record.GasRemaining,
+		GasRefund:       record.GasRefund,
+		OutputAccounts:  make(map[string]*vmcommon.OutputAccount, len(record.OutputAccounts)),
+		DeletedAccounts: record.DeletedAccounts,
+		TouchedAccounts: record.TouchedAccounts,
+		Logs:            record.Logs,
+	}
+
+	for _, accountRecord := range record.OutputAccounts {
+		account := &vmcommon.OutputAccount{
+			Address:                       accountRecord.Address,
+			Nonce:                         accountRecord.Nonce,
+			Balance:                       accountRecord.Balance,
+			BalanceDelta:                  accountRecord.BalanceDelta,
+			StorageUpdates:                make(map[string]*vmcommon.StorageUpdate, len(accountRecord.StorageUpdates)),
+			Code:                          accountRecord.Code,
+			CodeMetadata:                  accountRecord.CodeMetadata,
+			CodeDeployerAddress:           accountRecord.CodeDeployerAddress,
+			OutputTransfers:               accountRecord.OutputTransfers,
+			GasUsed:                       accountRecord.GasUsed,
+			BytesAddedToStorage:           accountRecord.BytesAddedToStorage,
+			BytesDeletedFromStorage:       accountRecord.BytesDeletedFromStorage,
+			BytesConsumedByTxAsNetworking: accountRecord.BytesConsumedByTxAsNetworking,
+		}
+		for _, storageUpdate := range accountRecord.StorageUpdates {
+			account.StorageUpdates[string(storageUpdate.Offset)] = storageUpdate
+		}
+		vmOutput.OutputAccounts[string(account.Address)] = account
+	}
+
+	return vmOutput
+}
+
+// SaveExecutionRecord writes the record as JSON to the given file
+func SaveExecutionRecord(record *ExecutionRecord, path string) error {
+	serialized, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, serialized, 0644)
+}
+
+// LoadExecutionRecord reads a JSON record previously written by SaveExecutionRecord
+func LoadExecutionRecord(path string) (*ExecutionRecord, error) {
+	serialized, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &ExecutionRecord{}
+	err = json.Unmarshal(serialized, record)
+	if err != nil {
+		return nil, err
+	}
+	if record.Input == nil {
+		return nil, ErrNilRecordedInput
+	}
+
+	return record, nil
+}
+
+func sortedOutputAccountKeys(outputAccounts map[string]*vmcommon.OutputAccount) []string {
+	keys := make([]string, 0, len(outputAccounts))
+	for key := range outputAccounts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedStorageUpdateKeys(storageUpdates map[string]*vmcommon.StorageUpdate) []string {
+	keys := make([]string, 0, len(storageUpdates))
+	for key := range storageUpdates {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,249 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PreState holds the part of the blockchain state an execution depended on
+type PreState struct {
+	Accounts      []*AccountPreState
+	CurrentBlock  *BlockInfoRecord
+	PreviousBlock *BlockInfoRecord
+}
+
+// AccountPreState holds the state of an account before the execution. Only
+// the storage keys and tokens which were read by the execution are present.
+type AccountPreState struct {
+	Address         []byte
+	Nonce           uint64
+	Balance         *big.Int
+	Code            []byte
+	CodeMetadata    []byte
+	OwnerAddress    []byte
+	UserName        []byte
+	DeveloperReward *big.Int
+	Storage         []*StorageEntry
+	ESDTTokens      []*ESDTBalance
+}
+
+// StorageEntry is a single storage key-value pair
+type StorageEntry struct {
+	Key   []byte
+	Value []byte
+}
+
+// ESDTBalance holds the balance of a single ESDT token, or token instance for nonce > 0
+type ESDTBalance struct {
+	TokenIdentifier []byte
+	Nonce           uint64
+	Balance         *big.Int
+	Creator         []byte
+	Royalties       uint32
+	Attributes      []byte
+}
+
+// BlockInfoRecord holds the block information observed by the execution
+type BlockInfoRecord struct {
+	Nonce      uint64
+	Round      uint64
+	TimeStamp  uint64
+	Epoch      uint32
+	RandomSeed []byte
+}
+
+// PreStateFromRecord rebuilds the pre-state of an execution from the first
+// response recorded for each account, storage, code and token read.
+func PreStateFromRecord(record *ExecutionRecord) (*PreState, error) {
+	if record == nil {
+		return nil, ErrNilExecutionRecord
+	}
+
+	builder := &preStateBuilder{
+		accounts:      make(map[string]*AccountPreState),
+		currentBlock:  &BlockInfoRecord{},
+		previousBlock: &BlockInfoRecord{},
+	}
+
+	keys := make([]string, 0, len(record.Responses))
+	for key := range record.Responses {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		responses := record.Responses[key]
+		if len(responses) == 0 || len(responses[0].Error) > 0 {
+			continue
+		}
+
+		err := builder.addResponse(strings.Split(key, "/"), responses[0])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return builder.preState(), nil
+}
+
+type preStateBuilder struct {
+	accounts         map[string]*AccountPreState
+	currentBlock     *BlockInfoRecord
+	previousBlock    *BlockInfoRecord
+	hasCurrentBlock  bool
+	hasPreviousBlock bool
+}
+
+func (builder *preStateBuilder) addResponse(keyParts []string, response *HookResponse) error {
+	args, err := decodeHexArgs(keyParts)
+	if err != nil {
+		return err
+	}
+
+	switch keyParts[0] {
+	case getUserAccountName:
+		if response.Account == nil {
+			return nil
+		}
+		account := builder.account(args[0])
+		account.Nonce = response.Account.Nonce
+		account.Balance = bigIntOrZero(response.Account.Balance)
+		account.CodeMetadata = response.Account.CodeMetadata
+		account.OwnerAddress = response.Account.OwnerAddress
+		account.UserName = response.Account.UserName
+		account.DeveloperReward = bigIntOrZero(response.Account.DeveloperReward)
+	case getCodeName:
+		builder.account(args[0]).Code = response.Bytes
+	case getStorageDataName:
+		account := builder.account(args[0])
+		account.Storage = append(account.Storage, &StorageEntry{Key: args[1], Value: response.Bytes})
+	case getESDTTokenName:
+		if len(keyParts) < 4 {
+			return ErrInvalidResponseKey
+		}
+		builder.addESDTBalance(args[0], args[1], keyParts[3], response)
+	case currentNonceName, currentRoundName, currentTimeStampName, currentEpochName, currentRandomSeedName:
+		builder.hasCurrentBlock = true
+		setBlockInfoField(builder.currentBlock, keyParts[0], response)
+	case lastNonceName, lastRoundName, lastTimeStampName, lastEpochName, lastRandomSeedName:
+		builder.hasPreviousBlock = true
+		setBlockInfoField(builder.previousBlock, keyParts[0], response)
+	}
+
+	return nil
+}
+
+func (builder *preStateBuilder) addESDTBalance(address []byte, tokenIdentifier []byte, nonceArg string, response *HookResponse) {
+	if response.ESDTToken == nil {
+		return
+	}
+
+	nonce, err := strconv.ParseUint(nonceArg, 10, 64)
+	if err != nil {
+		return
+	}
+
+	token := &ESDTBalance{
+		TokenIdentifier: tokenIdentifier,
+		Nonce:           nonce,
+		Balance:         bigIntOrZero(response.ESDTToken.Value),
+	}
+	if response.ESDTToken.TokenMetaData != nil {
+		token.Creator = response.ESDTToken.TokenMetaData.Creator
+		token.Royalties = response.ESDTToken.TokenMetaData.Royalties
+		token.Attributes = response.ESDTToken.TokenMetaData.Attributes
+	}
+
+	account := builder.account(address)
+	account.ESDTTokens = append(account.ESDTTokens, token)
+}
+
+func (builder *preStateBuilder) account(address []byte) *AccountPreState {
+	account, found := builder.accounts[string(address)]
+	if !found {
+		account = &AccountPreState{
+			Address:         address,
+			Balance:         big.NewInt(0),
+			DeveloperReward: big.NewInt(0),
+		}
+		builder.accounts[string(address)] = account
+	}
+
+	return account
+}
+
+func (builder *preStateBuilder) preState() *PreState {
+	preState := &PreState{
+		Accounts: make([]*AccountPreState, 0, len(builder.accounts)),
+	}
+	for _, account := range builder.accounts {
+		preState.Accounts = append(preState.Accounts, account)
+	}
+	sort.Slice(preState.Accounts, func(i, j int) bool {
+		return bytes.Compare(preState.Accounts[i].Address, preState.Accounts[j].Address) < 0
+	})
+
+	if builder.hasCurrentBlock {
+		preState.CurrentBlock = builder.currentBlock
+	}
+	if builder.hasPreviousBlock {
+		preState.PreviousBlock = builder.previousBlock
+	}
+
+	return preState
+}
+
+// FindAccount returns the pre-state of the account with the given address, or nil
+func (preState *PreState) FindAccount(address []byte) *AccountPreState {
+	for _, account := range preState.Accounts {
+		if bytes.Equal(account.Address, address) {
+			return account
+		}
+	}
+	return nil
+}
+
+func setBlockInfoField(blockInfo *BlockInfoRecord, methodName string, response *HookResponse) {
+	switch methodName {
+	case currentNonceName, lastNonceName:
+		blockInfo.Nonce = response.Uint64
+	case currentRoundName, lastRoundName:
+		blockInfo.Round = response.Uint64
+	case currentTimeStampName, lastTimeStampName:
+		blockInfo.TimeStamp = response.Uint64
+	case currentEpochName, lastEpochName:
+		blockInfo.Epoch = uint32(response.Uint64)
+	case currentRandomSeedName, lastRandomSeedName:
+		blockInfo.RandomSeed = response.Bytes
+	}
+}
+
+// decodeHexArgs decodes the arguments of the address, token and storage key
+// based calls; the arguments of the other calls are not needed here
+func decodeHexArgs(keyParts []string) ([][]byte, error) {
+	numHexArgs := 0
+	switch keyParts[0] {
+	case getUserAccountName, getCodeName:
+		numHexArgs = 1
+	case getStorageDataName, getESDTTokenName:
+		numHexArgs = 2
+	}
+	if len(keyParts) <= numHexArgs {
+		return nil, ErrInvalidResponseKey
+	}
+
+	args := make([][]byte, numHexArgs)
+	for i := range args {
+		arg, err := hex.DecodeString(keyParts[i+1])
+		if err != nil {
+			return nil, err
+		}
+		args[i] = arg
+	}
+
+	return args, nil
+}
@@ -0,0 +1,436 @@
+package replay
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/config"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+const (
+	newAddressName                        = "NewAddress"
+	getStorageDataName                    = "GetStorageData"
+	getBlockhashName                      = "GetBlockhash"
+	lastNonceName                         = "LastNonce"
+	lastRoundName                         = "LastRound"
+	lastTimeStampName                     = "LastTimeStamp"
+	lastRandomSeedName                    = "LastRandomSeed"
+	lastEpochName                         = "LastEpoch"
+	getStateRootHashName                  = "GetStateRootHash"
+	currentNonceName                      = "CurrentNonce"
+	currentRoundName                      = "CurrentRound"
+	currentTimeStampName                  = "CurrentTimeStamp"
+	currentRandomSeedName                 = "CurrentRandomSeed"
+	currentEpochName                      = "CurrentEpoch"
+	processBuiltInFunctionName            = "ProcessBuiltInFunction"
+	getBuiltinFunctionNamesName           = "GetBuiltinFunctionNames"
+	getAllStateName                       = "GetAllState"
+	getUserAccountName                    = "GetUserAccount"
+	getCodeName                           = "GetCode"
+	getShardOfAddressName                 = "GetShardOfAddress"
+	isSmartContractName                   = "IsSmartContract"
+	isPayableName                         = "IsPayable"
+	getESDTTokenName                      = "GetESDTToken"
+	isPausedName                          = "IsPaused"
+	isLimitedTransferName                 = "IsLimitedTransfer"
+	getSnapshotName                       = "GetSnapshot"
+	revertToSnapshotName                  = "RevertToSnapshot"
+	executeSmartContractCallOnOtherVMName = "ExecuteSmartContractCallOnOtherVM"
+)
+
+var _ vmcommon.BlockchainHook = (*RecordingBlockchainHook)(nil)
+
+// RecordingBlockchainHook decorates a BlockchainHook and records every
+// response it gives, so that the execution can later be replayed without
+// access to the original blockchain state.
+type RecordingBlockchainHook struct {
+	wrappedHook vmcommon.BlockchainHook
+
+	mutResponses sync.Mutex
+	responses    map[string][]*HookResponse
+	gasSchedule  config.GasScheduleMap
+	activeFlags  []string
+}
+
+// NewRecordingBlockchainHook creates a new RecordingBlockchainHook around the given hook
+func NewRecordingBlockchainHook(blockchainHook vmcommon.BlockchainHook) (*RecordingBlockchainHook, error) {
+	if check.IfNil(blockchainHook) {
+		return nil, ErrNilBlockchainHook
+	}
+
+	return &RecordingBlockchainHook{
+		wrappedHook: blockchainHook,
+		responses:   make(map[string][]*HookResponse),
+	}, nil
+}
+
+// NewExecutionRecord bundles the responses recorded so far together with the
+// input and the result of the execution
+func (hook *RecordingBlockchainHook) NewExecutionRecord(
+	input *vmcommon.ContractCallInput,
+	vmOutput *vmcommon.VMOutput,
+	executionErr error,
+) *ExecutionRecord {
+	hook.mutResponses.Lock()
+	defer hook.mutResponses.Unlock()
+
+	record := &ExecutionRecord{
+		Input:       input,
+		Output:      NewVMOutputRecord(vmOutput),
+		GasSchedule: hook.gasSchedule,
+		ActiveFlags: hook.activeFlags,
+		Responses:   make(map[string][]*HookResponse, len(hook.responses)),
+	}
+	if executionErr != nil {
+		record.Error = executionErr.Error()
+	}
+	for key, responses := range hook.responses {
+		record.Responses[key] = append([]*HookResponse(nil), responses...)
+	}
+
+	return record
+}
+
+// SetExecutionConfig remembers the gas schedule and the flags active in the
+// VM, so that the records capture the configuration of the execution
+func (hook *RecordingBlockchainHook) SetExecutionConfig(
+	gasSchedule config.GasScheduleMap,
+	enableEpochsHandler vmhost.EnableEpochsHandler,
+) {
+	gasScheduleCopy := make(config.GasScheduleMap, len(gasSchedule))
+	for section, costs := range gasSchedule {
+		costsCopy := make(map[string]uint64, len(costs))
+		for name, cost := range costs {
+			costsCopy[name] = cost
+		}
+		gasScheduleCopy[section] = costsCopy
+	}
+
+	activeFlags := make([]string, 0, len(vmhost.AllFlags))
+	if !check.IfNil(enableEpochsHandler) {
+		for _, flag := range vmhost.AllFlags {
+			if enableEpochsHandler.IsFlagEnabled(flag) {
+				activeFlags = append(activeFlags, string(flag))
+			}
+		}
+	}
+
+	hook.mutResponses.Lock()
+	hook.gasSchedule = gasScheduleCopy
+	hook.activeFlags = activeFlags
+	hook.mutResponses.Unlock()
+}
+
+// Reset discards all the recorded responses
+func (hook *RecordingBlockchainHook) Reset() {
+	hook.mutResponses.Lock()
+	hook.responses = make(map[string][]*HookResponse)
+	hook.mutResponses.Unlock()
+}
+
+func (hook *RecordingBlockchainHook) record(key string, response *HookResponse) {
+	hook.mutResponses.Lock()
+	hook.responses[key] = append(hook.responses[key], response)
+	hook.mutResponses.Unlock()
+}
+
+// NewAddress records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) NewAddress(creatorAddress []byte, creatorNonce uint64, vmType []byte) ([]byte, error) {
+	address, err := hook.wrappedHook.NewAddress(creatorAddress, creatorNonce, vmType)
+	hook.record(
+		hookCallKey(newAddressName, hex.EncodeToString(creatorAddress), fmt.Sprint(creatorNonce), hex.EncodeToString(vmType)),
+		&HookResponse{Bytes: address, Error: errorMessage(err)})
+	return address, err
+}
+
+// GetStorageData records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) GetStorageData(accountAddress []byte, index []byte) ([]byte, uint32, error) {
+	value, trieDepth, err := hook.wrappedHook.GetStorageData(accountAddress, index)
+	hook.record(
+		hookCallKey(getStorageDataName, hex.EncodeToString(accountAddress), hex.EncodeToString(index)),
+		&HookResponse{Bytes: value, Uint64: uint64(trieDepth), Error: errorMessage(err)})
+	return value, trieDepth, err
+}
+
+// GetBlockhash records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) GetBlockhash(nonce uint64) ([]byte, error) {
+	blockHash, err := hook.wrappedHook.GetBlockhash(nonce)
+	hook.record(
+		hookCallKey(getBlockhashName, fmt.Sprint(nonce)),
+		&HookResponse{Bytes: blockHash, Error: errorMessage(err)})
+	return blockHash, err
+}
+
+// LastNonce records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) LastNonce() uint64 {
+	nonce := hook.wrappedHook.LastNonce()
+	hook.record(hookCallKey(lastNonceName), &HookResponse{Uint64: nonce})
+	return nonce
+}
+
+// LastRound records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) LastRound() uint64 {
+	round := hook.wrappedHook.LastRound()
+	hook.record(hookCallKey(lastRoundName), &HookResponse{Uint64: round})
+	return round
+}
+
+// LastTimeStamp records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) LastTimeStamp() uint64 {
+	timestamp := hook.wrappedHook.LastTimeStamp()
+	hook.record(hookCallKey(lastTimeStampName), &HookResponse{Uint64: timestamp})
+	return timestamp
+}
+
+// LastRandomSeed records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) LastRandomSeed() []byte {
+	seed := hook.wrappedHook.LastRandomSeed()
+	hook.record(hookCallKey(lastRandomSeedName), &HookResponse{Bytes: seed})
+	return seed
+}
+
+// LastEpoch records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) LastEpoch() uint32 {
+	epoch := hook.wrappedHook.LastEpoch()
+	hook.record(hookCallKey(lastEpochName), &HookResponse{Uint64: uint64(epoch)})
+	return epoch
+}
+
+// GetStateRootHash records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) GetStateRootHash() []byte {
+	rootHash := hook.wrappedHook.GetStateRootHash()
+	hook.record(hookCallKey(getStateRootHashName), &HookResponse{Bytes: rootHash})
+	return rootHash
+}
+
+// CurrentNonce records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) CurrentNonce() uint64 {
+	nonce := hook.wrappedHook.CurrentNonce()
+	hook.record(hookCallKey(currentNonceName), &HookResponse{Uint64: nonce})
+	return nonce
+}
+
+// CurrentRound records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) CurrentRound() uint64 {
+	round := hook.wrappedHook.CurrentRound()
+	hook.record(hookCallKey(currentRoundName), &HookResponse{Uint64: round})
+	return round
+}
+
+// CurrentTimeStamp records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) CurrentTimeStamp() uint64 {
+	timestamp := hook.wrappedHook.CurrentTimeStamp()
+	hook.record(hookCallKey(currentTimeStampName), &HookResponse{Uint64: timestamp})
+	return timestamp
+}
+
+// CurrentRandomSeed records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) CurrentRandomSeed() []byte {
+	seed := hook.wrappedHook.CurrentRandomSeed()
+	hook.record(hookCallKey(currentRandomSeedName), &HookResponse{Bytes: seed})
+	return seed
+}
+
+// CurrentEpoch records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) CurrentEpoch() uint32 {
+	epoch := hook.wrappedHook.CurrentEpoch()
+	hook.record(hookCallKey(currentEpochName), &HookResponse{Uint64: uint64(epoch)})
+	return epoch
+}
+
+// ProcessBuiltInFunction records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) ProcessBuiltInFunction(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	vmOutput, err := hook.wrappedHook.ProcessBuiltInFunction(input)
+	hook.record(
+		hookCallKey(processBuiltInFunctionName, hex.EncodeToString(input.RecipientAddr), input.Function),
+		&HookResponse{VMOutput: NewVMOutputRecord(vmOutput), Error: errorMessage(err)})
+	return vmOutput, err
+}
+
+// GetBuiltinFunctionNames records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) GetBuiltinFunctionNames() vmcommon.FunctionNames {
+	functionNames := hook.wrappedHook.GetBuiltinFunctionNames()
+	names := make([]string, 0, len(functionNames))
+	for name := range functionNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	hook.record(hookCallKey(getBuiltinFunctionNamesName), &HookResponse{Names: names})
+	return functionNames
+}
+
+// GetAllState records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) GetAllState(address []byte) (map[string][]byte, error) {
+	state, err := hook.wrappedHook.GetAllState(address)
+	hexState := make(map[string][]byte, len(state))
+	for key, value := range state {
+		hexState[hex.EncodeToString([]byte(key))] = value
+	}
+	hook.record(
+		hookCallKey(getAllStateName, hex.EncodeToString(address)),
+		&HookResponse{State: hexState, Error: errorMessage(err)})
+	return state, err
+}
+
+// GetUserAccount records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) GetUserAccount(address []byte) (vmcommon.UserAccountHandler, error) {
+	account, err := hook.wrappedHook.GetUserAccount(address)
+	hook.record(
+		hookCallKey(getUserAccountName, hex.EncodeToString(address)),
+		&HookResponse{Account: newAccountRecord(account), Error: errorMessage(err)})
+	return account, err
+}
+
+// GetCode records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) GetCode(account vmcommon.UserAccountHandler) []byte {
+	code := hook.wrappedHook.GetCode(account)
+	if !check.IfNil(account) {
+		hook.record(
+			hookCallKey(getCodeName, hex.EncodeToString(account.AddressBytes())),
+			&HookResponse{Bytes: code})
+	}
+	return code
+}
+
+// GetShardOfAddress records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) GetShardOfAddress(address []byte) uint32 {
+	shard := hook.wrappedHook.GetShardOfAddress(address)
+	hook.record(
+		hookCallKey(getShardOfAddressName, hex.EncodeToString(address)),
+		&HookResponse{Uint64: uint64(shard)})
+	return shard
+}
+
+// IsSmartContract records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) IsSmartContract(address []byte) bool {
+	isSmartContract := hook.wrappedHook.IsSmartContract(address)
+	hook.record(
+		hookCallKey(isSmartContractName, hex.EncodeToString(address)),
+		&HookResponse{Bool: isSmartContract})
+	return isSmartContract
+}
+
+// IsPayable records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) IsPayable(sndAddress []byte, recvAddress []byte) (bool, error) {
+	isPayable, err := hook.wrappedHook.IsPayable(sndAddress, recvAddress)
+	hook.record(
+		hookCallKey(isPayableName, hex.EncodeToString(sndAddress), hex.EncodeToString(recvAddress)),
+		&HookResponse{Bool: isPayable, Error: errorMessage(err)})
+	return isPayable, err
+}
+
+// SaveCompiledCode is not recorded, the replayed execution always compiles the code again
+func (hook *RecordingBlockchainHook) SaveCompiledCode(codeHash []byte, code []byte) {
+	hook.wrappedHook.SaveCompiledCode(codeHash, code)
+}
+
+// GetCompiledCode is not recorded, the replayed execution always compiles the code again
+func (hook *RecordingBlockchainHook) GetCompiledCode(codeHash []byte) (bool, []byte) {
+	return hook.wrappedHook.GetCompiledCode(codeHash)
+}
+
+// ClearCompiledCodes is not recorded, the replayed execution always compiles the code again
+func (hook *RecordingBlockchainHook) ClearCompiledCodes() {
+	hook.wrappedHook.ClearCompiledCodes()
+}
+
+// GetESDTToken records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) GetESDTToken(address []byte, tokenID []byte, nonce uint64) (*esdt.ESDigitalToken, error) {
+	token, err := hook.wrappedHook.GetESDTToken(address, tokenID, nonce)
+	hook.record(
+		hookCallKey(getESDTTokenName, hex.EncodeToString(address), hex.EncodeToString(tokenID), fmt.Sprint(nonce)),
+		&HookResponse{ESDTToken: token, Error: errorMessage(err)})
+	return token, err
+}
+
+// IsPaused records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) IsPaused(tokenID []byte) bool {
+	isPaused := hook.wrappedHook.IsPaused(tokenID)
+	hook.record(
+		hookCallKey(isPausedName, hex.EncodeToString(tokenID)),
+		&HookResponse{Bool: isPaused})
+	return isPaused
+}
+
+// IsLimitedTransfer records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) IsLimitedTransfer(tokenID []byte) bool {
+	isLimited := hook.wrappedHook.IsLimitedTransfer(tokenID)
+	hook.record(
+		hookCallKey(isLimitedTransferName, hex.EncodeToString(tokenID)),
+		&HookResponse{Bool: isLimited})
+	return isLimited
+}
+
+// GetSnapshot records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) GetSnapshot() int {
+	snapshot := hook.wrappedHook.GetSnapshot()
+	hook.record(hookCallKey(getSnapshotName), &HookResponse{Int: snapshot})
+	return snapshot
+}
+
+// RevertToSnapshot records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) RevertToSnapshot(snapshot int) error {
+	err := hook.wrappedHook.RevertToSnapshot(snapshot)
+	hook.record(
+		hookCallKey(revertToSnapshotName, fmt.Sprint(snapshot)),
+		&HookResponse{Error: errorMessage(err)})
+	return err
+}
+
+// ExecuteSmartContractCallOnOtherVM records the call on the wrapped hook
+func (hook *RecordingBlockchainHook) ExecuteSmartContractCallOnOtherVM(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	vmOutput, err := hook.wrappedHook.ExecuteSmartContractCallOnOtherVM(input)
+	hook.record(
+		hookCallKey(executeSmartContractCallOnOtherVMName, hex.EncodeToString(input.RecipientAddr), input.Function),
+		&HookResponse{VMOutput: NewVMOutputRecord(vmOutput), Error: errorMessage(err)})
+	return vmOutput, err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hook *RecordingBlockchainHook) IsInterfaceNil() bool {
+	return hook == nil
+}
+
+func hookCallKey(methodName string, args ...string) string {
+	return strings.Join(append([]string{methodName}, args...), "/")
+}
+
+func errorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+func newAccountRecord(account vmcommon.UserAccountHandler) *AccountRecord {
+	if check.IfNil(account) {
+		return nil
+	}
+
+	return &AccountRecord{
+		Address:         account.AddressBytes(),
+		Nonce:           account.GetNonce(),
+		Balance:         copyBigInt(account.GetBalance()),
+		CodeHash:        account.GetCodeHash(),
+		CodeMetadata:    account.GetCodeMetadata(),
+		RootHash:        account.GetRootHash(),
+		OwnerAddress:    account.GetOwnerAddress(),
+		UserName:        account.GetUserName(),
+		DeveloperReward: copyBigInt(account.GetDeveloperReward()),
+	}
+}
+
+func copyBigInt(value *big.Int) *big.Int {
+	if value == nil {
+		return nil
+	}
+	return big.NewInt(0).Set(value)
+}
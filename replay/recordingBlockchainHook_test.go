@@ -0,0 +1,220 @@
+package replay
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/config"
+	contextmock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+var errTestHook = errors.New("test hook error")
+
+func createRecordedHookStub() *contextmock.BlockchainHookStub {
+	storageValues := [][]byte{[]byte("first"), []byte("second")}
+	storageCalls := 0
+
+	return &contextmock.BlockchainHookStub{
+		GetStorageDataCalled: func(_ []byte, _ []byte) ([]byte, uint32, error) {
+			value := storageValues[storageCalls]
+			storageCalls++
+			return value, 3, nil
+		},
+		CurrentNonceCalled: func() uint64 {
+			return 42
+		},
+		GetUserAccountCalled: func(address []byte) (vmcommon.UserAccountHandler, error) {
+			return &contextmock.StubAccount{
+				Address:      address,
+				Nonce:        7,
+				Balance:      big.NewInt(1000),
+				CodeMetadata: []byte{1, 0},
+			}, nil
+		},
+		GetESDTTokenCalled: func(_ []byte, _ []byte, _ uint64) (*esdt.ESDigitalToken, error) {
+			return nil, errTestHook
+		},
+	}
+}
+
+func TestNewRecordingBlockchainHook_NilHook(t *testing.T) {
+	t.Parallel()
+
+	hook, err := NewRecordingBlockchainHook(nil)
+	require.Nil(t, hook)
+	require.Equal(t, ErrNilBlockchainHook, err)
+}
+
+func TestRecordingBlockchainHook_ReplayAfterSaveAndLoad(t *testing.T) {
+	t.Parallel()
+
+	recordingHook, err := NewRecordingBlockchainHook(createRecordedHookStub())
+	require.Nil(t, err)
+
+	address := []byte("contract________________________")
+	key := []byte{0, 255, 'k'}
+
+	value, trieDepth, err := recordingHook.GetStorageData(address, key)
+	require.Nil(t, err)
+	require.Equal(t, []byte("first"), value)
+	require.Equal(t, uint32(3), trieDepth)
+	value, _, _ = recordingHook.GetStorageData(address, key)
+	require.Equal(t, []byte("second"), value)
+	require.Equal(t, uint64(42), recordingHook.CurrentNonce())
+	account, err := recordingHook.GetUserAccount(address)
+	require.Nil(t, err)
+	require.Equal(t, uint64(7), account.GetNonce())
+	_, err = recordingHook.GetESDTToken(address, []byte("TOKEN-123456"), 0)
+	require.Equal(t, errTestHook, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("caller__________________________"),
+			CallValue:   big.NewInt(0),
+			GasProvided: 1000,
+		},
+		RecipientAddr: address,
+		Function:      "doSomething",
+	}
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode: vmcommon.Ok,
+		ReturnData: [][]byte{{1}},
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(address): {
+				Address: address,
+				StorageUpdates: map[string]*vmcommon.StorageUpdate{
+					string(key): {Offset: key, Data: []byte("updated"), Written: true},
+				},
+			},
+		},
+	}
+
+	recordPath := filepath.Join(t.TempDir(), "record.json")
+	err = SaveExecutionRecord(recordingHook.NewExecutionRecord(input, vmOutput, nil), recordPath)
+	require.Nil(t, err)
+
+	record, err := LoadExecutionRecord(recordPath)
+	require.Nil(t, err)
+	require.Equal(t, "doSomething", record.Input.Function)
+	require.Empty(t, CompareVMOutputs(vmOutput, record.Output.ToVMOutput()))
+
+	replayHook, err := NewReplayBlockchainHook(record)
+	require.Nil(t, err)
+
+	value, trieDepth, err = replayHook.GetStorageData(address, key)
+	require.Nil(t, err)
+	require.Equal(t, []byte("first"), value)
+	require.Equal(t, uint32(3), trieDepth)
+	value, _, _ = replayHook.GetStorageData(address, key)
+	require.Equal(t, []byte("second"), value)
+	require.Equal(t, uint64(42), replayHook.CurrentNonce())
+
+	account, err = replayHook.GetUserAccount(address)
+	require.Nil(t, err)
+	require.Equal(t, uint64(7), account.GetNonce())
+	require.Equal(t, big.NewInt(1000), account.GetBalance())
+	require.Equal(t, []byte{1, 0}, account.GetCodeMetadata())
+
+	_, err = replayHook.GetESDTToken(address, []byte("TOKEN-123456"), 0)
+	require.Equal(t, errTestHook.Error(), err.Error())
+	require.Empty(t, replayHook.MissingResponses())
+
+	_, err = replayHook.GetBlockhash(5)
+	require.True(t, errors.Is(err, ErrMissingRecordedResponse))
+	require.Equal(t, []string{"GetBlockhash/5"}, replayHook.MissingResponses())
+}
+
+func TestReplayBlockchainHook_ExhaustedResponsesAreMissing(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("contract________________________")
+	key := []byte("k")
+	storageKey := hookCallKey(getStorageDataName, hex.EncodeToString(address), hex.EncodeToString(key))
+	replayHook, err := NewReplayBlockchainHook(&ExecutionRecord{
+		Responses: map[string][]*HookResponse{
+			storageKey: {{Bytes: []byte("value")}},
+		},
+	})
+	require.Nil(t, err)
+
+	value, _, err := replayHook.GetStorageData(address, key)
+	require.Nil(t, err)
+	require.Equal(t, []byte("value"), value)
+	require.Empty(t, replayHook.MissingResponses())
+
+	value, _, err = replayHook.GetStorageData(address, key)
+	require.True(t, errors.Is(err, ErrMissingRecordedResponse))
+	require.Nil(t, value)
+	require.Equal(t, []string{storageKey}, replayHook.MissingResponses())
+}
+
+func TestReplay_ReportsDifferences(t *testing.T) {
+	t.Parallel()
+
+	record := &ExecutionRecord{
+		Input:     &vmcommon.ContractCallInput{Function: "doSomething"},
+		Output:    NewVMOutputRecord(&vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: 100}),
+		Responses: map[string][]*HookResponse{},
+	}
+
+	vmFactory := func(blockchainHook vmcommon.BlockchainHook) (vmcommon.VMExecutionHandler, error) {
+		return &contextmock.VMHostStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				blockchainHook.CurrentRound()
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: 90}, nil
+			},
+		}, nil
+	}
+
+	result, err := Replay(record, vmFactory)
+	require.Nil(t, err)
+	require.False(t, result.IsIdentical())
+	require.Equal(t, []string{"GasRemaining: expected 100, got 90"}, result.Differences)
+	require.Equal(t, []string{"CurrentRound"}, result.MissingResponses)
+}
+
+func TestRecordingBlockchainHook_RecordsExecutionConfig(t *testing.T) {
+	t.Parallel()
+
+	recordingHook, err := NewRecordingBlockchainHook(createRecordedHookStub())
+	require.Nil(t, err)
+
+	gasSchedule := config.MakeGasMapForTests()
+	recordingHook.SetExecutionConfig(gasSchedule, &worldmock.EnableEpochsHandlerStub{
+		IsFlagEnabledCalled: func(flag core.EnableEpochFlag) bool {
+			return flag == vmhost.StorageIterationFlag
+		},
+	})
+	gasSchedule["BaseOperationCost"]["StorePerByte"] = 12345
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("caller__________________________"),
+			CallValue:   big.NewInt(0),
+			GasProvided: 1000,
+		},
+		RecipientAddr: []byte("contract________________________"),
+		Function:      "doSomething",
+	}
+	recordPath := filepath.Join(t.TempDir(), "record.json")
+	err = SaveExecutionRecord(recordingHook.NewExecutionRecord(input, nil, nil), recordPath)
+	require.Nil(t, err)
+
+	record, err := LoadExecutionRecord(recordPath)
+	require.Nil(t, err)
+	require.Equal(t, config.MakeGasMapForTests(), record.GasSchedule)
+	require.Equal(t, []string{string(vmhost.StorageIterationFlag)}, record.ActiveFlags)
+
+	enableEpochsHandler := record.NewEnableEpochsHandler()
+	require.True(t, enableEpochsHandler.IsFlagEnabled(vmhost.StorageIterationFlag))
+	require.False(t, enableEpochsHandler.IsFlagEnabled(vmhost.CryptoOpcodesV2Flag))
+}
@@ -0,0 +1,318 @@
+package replay
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+var _ vmcommon.BlockchainHook = (*ReplayBlockchainHook)(nil)
+
+// ReplayBlockchainHook answers BlockchainHook calls exclusively from the
+// responses of an ExecutionRecord. Repeated calls with the same arguments
+// receive the recorded responses in their original order; a call made after
+// these are exhausted was not made by the recorded execution, so it is
+// reported as missing.
+type ReplayBlockchainHook struct {
+	responses map[string][]*HookResponse
+
+	mutCursors       sync.Mutex
+	cursors          map[string]int
+	missingResponses []string
+}
+
+// NewReplayBlockchainHook creates a new ReplayBlockchainHook from the given record
+func NewReplayBlockchainHook(record *ExecutionRecord) (*ReplayBlockchainHook, error) {
+	if record == nil {
+		return nil, ErrNilExecutionRecord
+	}
+
+	return &ReplayBlockchainHook{
+		responses: record.Responses,
+		cursors:   make(map[string]int),
+	}, nil
+}
+
+// MissingResponses returns the keys of the calls requested during replay for
+// which the record held no response, sorted alphabetically
+func (hook *ReplayBlockchainHook) MissingResponses() []string {
+	hook.mutCursors.Lock()
+	defer hook.mutCursors.Unlock()
+
+	missing := append([]string(nil), hook.missingResponses...)
+	sort.Strings(missing)
+	return missing
+}
+
+func (hook *ReplayBlockchainHook) nextResponse(key string) (*HookResponse, error) {
+	hook.mutCursors.Lock()
+	defer hook.mutCursors.Unlock()
+
+	responses := hook.responses[key]
+	cursor := hook.cursors[key]
+	if cursor >= len(responses) {
+		hook.missingResponses = append(hook.missingResponses, key)
+		return &HookResponse{}, fmt.Errorf("%w: %s", ErrMissingRecordedResponse, key)
+	}
+	hook.cursors[key] = cursor + 1
+
+	response := responses[cursor]
+	if len(response.Error) > 0 {
+		return response, errors.New(response.Error)
+	}
+
+	return response, nil
+}
+
+// NewAddress returns the recorded response
+func (hook *ReplayBlockchainHook) NewAddress(creatorAddress []byte, creatorNonce uint64, vmType []byte) ([]byte, error) {
+	response, err := hook.nextResponse(
+		hookCallKey(newAddressName, hex.EncodeToString(creatorAddress), fmt.Sprint(creatorNonce), hex.EncodeToString(vmType)))
+	return response.Bytes, err
+}
+
+// GetStorageData returns the recorded response
+func (hook *ReplayBlockchainHook) GetStorageData(accountAddress []byte, index []byte) ([]byte, uint32, error) {
+	response, err := hook.nextResponse(
+		hookCallKey(getStorageDataName, hex.EncodeToString(accountAddress), hex.EncodeToString(index)))
+	return response.Bytes, uint32(response.Uint64), err
+}
+
+// GetBlockhash returns the recorded response
+func (hook *ReplayBlockchainHook) GetBlockhash(nonce uint64) ([]byte, error) {
+	response, err := hook.nextResponse(hookCallKey(getBlockhashName, fmt.Sprint(nonce)))
+	return response.Bytes, err
+}
+
+// LastNonce returns the recorded response
+func (hook *ReplayBlockchainHook) LastNonce() uint64 {
+	response, _ := hook.nextResponse(hookCallKey(lastNonceName))
+	return response.Uint64
+}
+
+// LastRound returns the recorded response
+func (hook *ReplayBlockchainHook) LastRound() uint64 {
+	response, _ := hook.nextResponse(hookCallKey(lastRoundName))
+	return response.Uint64
+}
+
+// LastTimeStamp returns the recorded response
+func (hook *ReplayBlockchainHook) LastTimeStamp() uint64 {
+	response, _ := hook.nextResponse(hookCallKey(lastTimeStampName))
+	return response.Uint64
+}
+
+// LastRandomSeed returns the recorded response
+func (hook *ReplayBlockchainHook) LastRandomSeed() []byte {
+	response, _ := hook.nextResponse(hookCallKey(lastRandomSeedName))
+	return response.Bytes
+}
+
+// LastEpoch returns the recorded response
+func (hook *ReplayBlockchainHook) LastEpoch() uint32 {
+	response, _ := hook.nextResponse(hookCallKey(lastEpochName))
+	return uint32(response.Uint64)
+}
+
+// GetStateRootHash returns the recorded response
+func (hook *ReplayBlockchainHook) GetStateRootHash() []byte {
+	response, _ := hook.nextResponse(hookCallKey(getStateRootHashName))
+	return response.Bytes
+}
+
+// CurrentNonce returns the recorded response
+func (hook *ReplayBlockchainHook) CurrentNonce() uint64 {
+	response, _ := hook.nextResponse(hookCallKey(currentNonceName))
+	return response.Uint64
+}
+
+// CurrentRound returns the recorded response
+func (hook *ReplayBlockchainHook) CurrentRound() uint64 {
+	response, _ := hook.nextResponse(hookCallKey(currentRoundName))
+	return response.Uint64
+}
+
+// CurrentTimeStamp returns the recorded response
+func (hook *ReplayBlockchainHook) CurrentTimeStamp() uint64 {
+	response, _ := hook.nextResponse(hookCallKey(currentTimeStampName))
+	return response.Uint64
+}
+
+// CurrentRandomSeed returns the recorded response
+func (hook *ReplayBlockchainHook) CurrentRandomSeed() []byte {
+	response, _ := hook.nextResponse(hookCallKey(currentRandomSeedName))
+	return response.Bytes
+}
+
+// CurrentEpoch returns the recorded response
+func (hook *ReplayBlockchainHook) CurrentEpoch() uint32 {
+	response, _ := hook.nextResponse(hookCallKey(currentEpochName))
+	return uint32(response.Uint64)
+}
+
+// ProcessBuiltInFunction returns the recorded response
+func (hook *ReplayBlockchainHook) ProcessBuiltInFunction(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	response, err := hook.nextResponse(
+		hookCallKey(processBuiltInFunctionName, hex.EncodeToString(input.RecipientAddr), input.Function))
+	return response.VMOutput.ToVMOutput(), err
+}
+
+// GetBuiltinFunctionNames returns the recorded response
+func (hook *ReplayBlockchainHook) GetBuiltinFunctionNames() vmcommon.FunctionNames {
+	response, _ := hook.nextResponse(hookCallKey(getBuiltinFunctionNamesName))
+	functionNames := make(vmcommon.FunctionNames, len(response.Names))
+	for _, name := range response.Names {
+		functionNames[name] = struct{}{}
+	}
+	return functionNames
+}
+
+// GetAllState returns the recorded response
+func (hook *ReplayBlockchainHook) GetAllState(address []byte) (map[string][]byte, error) {
+	response, err := hook.nextResponse(hookCallKey(getAllStateName, hex.EncodeToString(address)))
+	if err != nil {
+		return nil, err
+	}
+
+	state := make(map[string][]byte, len(response.State))
+	for hexKey, value := range response.State {
+		key, errDecode := hex.DecodeString(hexKey)
+		if errDecode != nil {
+			return nil, errDecode
+		}
+		state[string(key)] = value
+	}
+
+	return state, nil
+}
+
+// GetUserAccount returns the recorded response
+func (hook *ReplayBlockchainHook) GetUserAccount(address []byte) (vmcommon.UserAccountHandler, error) {
+	response, err := hook.nextResponse(hookCallKey(getUserAccountName, hex.EncodeToString(address)))
+	if err != nil {
+		return nil, err
+	}
+	if response.Account == nil {
+		return nil, nil
+	}
+
+	return response.Account.toAccount(), nil
+}
+
+// GetCode returns the recorded response
+func (hook *ReplayBlockchainHook) GetCode(account vmcommon.UserAccountHandler) []byte {
+	if check.IfNil(account) {
+		return nil
+	}
+
+	response, _ := hook.nextResponse(hookCallKey(getCodeName, hex.EncodeToString(account.AddressBytes())))
+	return response.Bytes
+}
+
+// GetShardOfAddress returns the recorded response
+func (hook *ReplayBlockchainHook) GetShardOfAddress(address []byte) uint32 {
+	response, _ := hook.nextResponse(hookCallKey(getShardOfAddressName, hex.EncodeToString(address)))
+	return uint32(response.Uint64)
+}
+
+// IsSmartContract returns the recorded response
+func (hook *ReplayBlockchainHook) IsSmartContract(address []byte) bool {
+	response, _ := hook.nextResponse(hookCallKey(isSmartContractName, hex.EncodeToString(address)))
+	return response.Bool
+}
+
+// IsPayable returns the recorded response
+func (hook *ReplayBlockchainHook) IsPayable(sndAddress []byte, recvAddress []byte) (bool, error) {
+	response, err := hook.nextResponse(
+		hookCallKey(isPayableName, hex.EncodeToString(sndAddress), hex.EncodeToString(recvAddress)))
+	return response.Bool, err
+}
+
+// SaveCompiledCode does nothing, the replayed execution always compiles the code
+func (hook *ReplayBlockchainHook) SaveCompiledCode(_ []byte, _ []byte) {
+}
+
+// GetCompiledCode always reports a cache miss, the replayed execution always compiles the code
+func (hook *ReplayBlockchainHook) GetCompiledCode(_ []byte) (bool, []byte) {
+	return false, nil
+}
+
+// ClearCompiledCodes does nothing, the replayed execution always compiles the code
+func (hook *ReplayBlockchainHook) ClearCompiledCodes() {
+}
+
+// GetESDTToken returns the recorded response
+func (hook *ReplayBlockchainHook) GetESDTToken(address []byte, tokenID []byte, nonce uint64) (*esdt.ESDigitalToken, error) {
+	response, err := hook.nextResponse(
+		hookCallKey(getESDTTokenName, hex.EncodeToString(address), hex.EncodeToString(tokenID), fmt.Sprint(nonce)))
+	return response.ESDTToken, err
+}
+
+// IsPaused returns the recorded response
+func (hook *ReplayBlockchainHook) IsPaused(tokenID []byte) bool {
+	response, _ := hook.nextResponse(hookCallKey(isPausedName, hex.EncodeToString(tokenID)))
+	return response.Bool
+}
+
+// IsLimitedTransfer returns the recorded response
+func (hook *ReplayBlockchainHook) IsLimitedTransfer(tokenID []byte) bool {
+	response, _ := hook.nextResponse(hookCallKey(isLimitedTransferName, hex.EncodeToString(tokenID)))
+	return response.Bool
+}
+
+// GetSnapshot returns the recorded response
+func (hook *ReplayBlockchainHook) GetSnapshot() int {
+	response, _ := hook.nextResponse(hookCallKey(getSnapshotName))
+	return response.Int
+}
+
+// RevertToSnapshot returns the recorded response
+func (hook *ReplayBlockchainHook) RevertToSnapshot(snapshot int) error {
+	_, err := hook.nextResponse(hookCallKey(revertToSnapshotName, fmt.Sprint(snapshot)))
+	return err
+}
+
+// ExecuteSmartContractCallOnOtherVM returns the recorded response
+func (hook *ReplayBlockchainHook) ExecuteSmartContractCallOnOtherVM(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	response, err := hook.nextResponse(
+		hookCallKey(executeSmartContractCallOnOtherVMName, hex.EncodeToString(input.RecipientAddr), input.Function))
+	return response.VMOutput.ToVMOutput(), err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hook *ReplayBlockchainHook) IsInterfaceNil() bool {
+	return hook == nil
+}
+
+func (record *AccountRecord) toAccount() *worldmock.Account {
+	return &worldmock.Account{
+		Exists:          true,
+		Address:         record.Address,
+		Nonce:           record.Nonce,
+		Balance:         bigIntOrZero(record.Balance),
+		BalanceDelta:    big.NewInt(0),
+		Storage:         make(map[string][]byte),
+		RootHash:        record.RootHash,
+		CodeHash:        record.CodeHash,
+		CodeMetadata:    record.CodeMetadata,
+		OwnerAddress:    record.OwnerAddress,
+		Username:        record.UserName,
+		DeveloperReward: bigIntOrZero(record.DeveloperReward),
+		IsSmartContract: len(record.CodeHash) > 0,
+	}
+}
+
+func bigIntOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+	return big.NewInt(0).Set(value)
+}
@@ -0,0 +1,70 @@
+package replay
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// VMFactory creates the VM which re-executes a recorded call on top of the given blockchain hook
+type VMFactory func(blockchainHook vmcommon.BlockchainHook) (vmcommon.VMExecutionHandler, error)
+
+// Result holds the outcome of replaying a recorded execution
+type Result struct {
+	VMOutput         *vmcommon.VMOutput
+	Error            error
+	Differences      []string
+	MissingResponses []string
+}
+
+// IsIdentical returns true if the replayed execution reproduced the recorded one exactly
+func (result *Result) IsIdentical() bool {
+	return len(result.Differences) == 0 && len(result.MissingResponses) == 0
+}
+
+// Replay re-executes the recorded call on a VM created by the factory, with
+// all blockchain reads served from the record, and compares the result with
+// the recorded one.
+func Replay(record *ExecutionRecord, vmFactory VMFactory) (*Result, error) {
+	if record == nil {
+		return nil, ErrNilExecutionRecord
+	}
+	if record.Input == nil {
+		return nil, ErrNilRecordedInput
+	}
+	if vmFactory == nil {
+		return nil, ErrNilVMFactory
+	}
+
+	replayHook, err := NewReplayBlockchainHook(record)
+	if err != nil {
+		return nil, err
+	}
+
+	vm, err := vmFactory(replayHook)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(vm) {
+		return nil, ErrNilVMFactory
+	}
+	defer func() {
+		_ = vm.Close()
+	}()
+
+	vmOutput, executionErr := vm.RunSmartContractCall(record.Input)
+
+	result := &Result{
+		VMOutput:         vmOutput,
+		Error:            executionErr,
+		MissingResponses: replayHook.MissingResponses(),
+	}
+	result.Differences = CompareVMOutputs(record.Output.ToVMOutput(), vmOutput)
+	if errorMessage(executionErr) != record.Error {
+		result.Differences = append(result.Differences,
+			fmt.Sprintf("Error: expected '%s', got '%s'", record.Error, errorMessage(executionErr)))
+	}
+
+	return result, nil
+}
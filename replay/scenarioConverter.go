@@ -0,0 +1,490 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+
+	oj "github.com/multiversx/mx-chain-scenario-go/orderedjson"
+	scenjsonwrite "github.com/multiversx/mx-chain-scenario-go/scenario/json/write"
+	scenmodel "github.com/multiversx/mx-chain-scenario-go/scenario/model"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+const recordedTxIdent = "recorded-call"
+
+// ExecutionRecordToScenario converts a recorded execution into a
+// self-contained scenario, using the recorded reads as pre-state.
+func ExecutionRecordToScenario(record *ExecutionRecord, name string) (*scenmodel.Scenario, error) {
+	if record == nil {
+		return nil, ErrNilExecutionRecord
+	}
+
+	preState, err := PreStateFromRecord(record)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewScenarioFromExecution(name, record.Input, preState, record.Output.ToVMOutput())
+}
+
+// NewScenarioFromExecution creates a scenario with a setState step holding the
+// pre-state, an scCall step reproducing the input and expecting the given
+// output, and a checkState step with the accounts as modified by the output.
+//
+// The pre-state is expected as seen by the VM, i.e. with the call value and
+// the ESDT transfers already moved by the protocol. The scenario executor
+// moves them itself, so they are given back to the caller in setState.
+// Gas is only checked after enabling checkGas, since it depends on the gas
+// schedule of the original execution.
+func NewScenarioFromExecution(
+	name string,
+	input *vmcommon.ContractCallInput,
+	preState *PreState,
+	vmOutput *vmcommon.VMOutput,
+) (*scenmodel.Scenario, error) {
+	if input == nil {
+		return nil, ErrNilRecordedInput
+	}
+	if preState == nil {
+		return nil, ErrNilPreState
+	}
+	if vmOutput == nil {
+		return nil, ErrNilVMOutput
+	}
+
+	accounts := initialAccounts(input, preState)
+
+	return &scenmodel.Scenario{
+		Name:     name,
+		CheckGas: false,
+		Steps: []scenmodel.Step{
+			newSetStateStep(accounts, preState),
+			newScCallStep(input, vmOutput),
+			newCheckStateStep(input, accounts, vmOutput),
+		},
+	}, nil
+}
+
+// WriteScenarioFile writes the scenario as JSON to the given file
+func WriteScenarioFile(scenario *scenmodel.Scenario, path string) error {
+	return os.WriteFile(path, []byte(scenjsonwrite.ScenarioToJSONString(scenario)), 0644)
+}
+
+// initialAccounts copies the pre-state accounts, adding the caller and the
+// recipient if missing and handing back to the caller what the protocol had
+// already transferred before the execution
+func initialAccounts(input *vmcommon.ContractCallInput, preState *PreState) []*AccountPreState {
+	accounts := make([]*AccountPreState, 0, len(preState.Accounts)+2)
+	for _, account := range preState.Accounts {
+		accounts = append(accounts, copyAccountPreState(account))
+	}
+
+	caller := findOrAddAccount(&accounts, input.CallerAddr)
+	recipient := findOrAddAccount(&accounts, input.RecipientAddr)
+
+	caller.Balance.Add(caller.Balance, bigIntOrZero(input.CallValue))
+	for _, transfer := range input.ESDTTransfers {
+		callerToken := findOrAddESDTBalance(caller, transfer.ESDTTokenName, transfer.ESDTTokenNonce)
+		callerToken.Balance.Add(callerToken.Balance, bigIntOrZero(transfer.ESDTValue))
+
+		recipientToken := findESDTBalance(recipient, transfer.ESDTTokenName, transfer.ESDTTokenNonce)
+		if recipientToken != nil {
+			recipientToken.Balance.Sub(recipientToken.Balance, bigIntOrZero(transfer.ESDTValue))
+			if recipientToken.Balance.Sign() < 0 {
+				recipientToken.Balance.SetInt64(0)
+			}
+		}
+	}
+
+	sort.Slice(accounts, func(i, j int) bool {
+		return bytes.Compare(accounts[i].Address, accounts[j].Address) < 0
+	})
+
+	return accounts
+}
+
+func newSetStateStep(accounts []*AccountPreState, preState *PreState) *scenmodel.SetStateStep {
+	step := &scenmodel.SetStateStep{
+		Accounts: make([]*scenmodel.Account, 0, len(accounts)),
+	}
+	for _, account := range accounts {
+		step.Accounts = append(step.Accounts, toScenarioAccount(account))
+	}
+	if preState.PreviousBlock != nil {
+		step.PreviousBlockInfo = toScenarioBlockInfo(preState.PreviousBlock)
+	}
+	if preState.CurrentBlock != nil {
+		step.CurrentBlockInfo = toScenarioBlockInfo(preState.CurrentBlock)
+	}
+
+	return step
+}
+
+func toScenarioAccount(account *AccountPreState) *scenmodel.Account {
+	scenarioAccount := &scenmodel.Account{
+		Address: bytesFromString(account.Address),
+		Nonce:   jsonUint64(account.Nonce),
+		Balance: jsonBigInt(account.Balance),
+		Code:    bytesFromString(account.Code),
+		Owner:   bytesFromString(account.OwnerAddress),
+	}
+	if len(account.CodeMetadata) > 0 {
+		scenarioAccount.CodeMetadata = bytesFromString(account.CodeMetadata)
+	}
+	if len(account.UserName) > 0 {
+		scenarioAccount.Username = bytesFromString(account.UserName)
+	}
+	if bigIntOrZero(account.DeveloperReward).Sign() > 0 {
+		scenarioAccount.DeveloperReward = jsonBigInt(account.DeveloperReward)
+	}
+	for _, entry := range account.Storage {
+		if len(entry.Value) == 0 {
+			continue
+		}
+		scenarioAccount.Storage = append(scenarioAccount.Storage, &scenmodel.StorageKeyValuePair{
+			Key:   bytesFromString(entry.Key),
+			Value: bytesFromTree(entry.Value),
+		})
+	}
+	scenarioAccount.ESDTData = toScenarioESDTData(account.ESDTTokens)
+
+	return scenarioAccount
+}
+
+func toScenarioESDTData(tokens []*ESDTBalance) []*scenmodel.ESDTData {
+	var esdtData []*scenmodel.ESDTData
+	for _, token := range tokens {
+		var tokenData *scenmodel.ESDTData
+		for _, existing := range esdtData {
+			if bytes.Equal(existing.TokenIdentifier.Value, token.TokenIdentifier) {
+				tokenData = existing
+				break
+			}
+		}
+		if tokenData == nil {
+			tokenData = &scenmodel.ESDTData{
+				TokenIdentifier: scenmodel.NewJSONBytesFromString(token.TokenIdentifier, "str:"+string(token.TokenIdentifier)),
+			}
+			esdtData = append(esdtData, tokenData)
+		}
+
+		instance := &scenmodel.ESDTInstance{
+			Balance: jsonBigInt(token.Balance),
+		}
+		if token.Nonce > 0 {
+			instance.Nonce = jsonUint64(token.Nonce)
+			instance.Creator = bytesFromString(token.Creator)
+			instance.Royalties = jsonUint64(uint64(token.Royalties))
+			instance.Attributes = bytesFromTree(token.Attributes)
+		}
+		tokenData.Instances = append(tokenData.Instances, instance)
+	}
+
+	return esdtData
+}
+
+func toScenarioBlockInfo(blockInfo *BlockInfoRecord) *scenmodel.BlockInfo {
+	scenarioBlockInfo := &scenmodel.BlockInfo{
+		BlockTimestamp: jsonUint64(blockInfo.TimeStamp),
+		BlockNonce:     jsonUint64(blockInfo.Nonce),
+		BlockRound:     jsonUint64(blockInfo.Round),
+		BlockEpoch:     jsonUint64(uint64(blockInfo.Epoch)),
+	}
+	if len(blockInfo.RandomSeed) > 0 {
+		randomSeed := bytesFromTree(blockInfo.RandomSeed)
+		scenarioBlockInfo.BlockRandomSeed = &randomSeed
+	}
+
+	return scenarioBlockInfo
+}
+
+func newScCallStep(input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput) *scenmodel.TxStep {
+	tx := &scenmodel.Transaction{
+		Type:      scenmodel.ScCall,
+		From:      bytesFromString(input.CallerAddr),
+		To:        bytesFromString(input.RecipientAddr),
+		EGLDValue: jsonBigInt(input.CallValue),
+		Function:  input.Function,
+		GasLimit:  jsonUint64(input.GasProvided),
+		GasPrice:  jsonUint64(0),
+	}
+	for _, argument := range input.Arguments {
+		tx.Arguments = append(tx.Arguments, bytesFromTree(argument))
+	}
+	for _, transfer := range input.ESDTTransfers {
+		tx.ESDTValue = append(tx.ESDTValue, &scenmodel.ESDTTxData{
+			TokenIdentifier: scenmodel.NewJSONBytesFromString(transfer.ESDTTokenName, "str:"+string(transfer.ESDTTokenName)),
+			Nonce:           jsonUint64(transfer.ESDTTokenNonce),
+			Value:           jsonBigInt(transfer.ESDTValue),
+		})
+	}
+
+	expectedResult := &scenmodel.TransactionResult{
+		Out:     checkValueList(vmOutput.ReturnData),
+		Status:  checkBigInt(big.NewInt(int64(vmOutput.ReturnCode))),
+		Message: scenmodel.JSONCheckBytesReconstructed([]byte(vmOutput.ReturnMessage), "str:"+vmOutput.ReturnMessage),
+		Gas:     checkUint64(vmOutput.GasRemaining),
+		Refund:  scenmodel.JSONCheckBigIntUnspecified(),
+		Logs:    scenmodel.LogList{IsStar: true},
+	}
+	if len(vmOutput.ReturnMessage) == 0 {
+		expectedResult.Message = checkBytes(nil)
+	}
+	if vmOutput.ReturnCode == vmcommon.Ok {
+		expectedResult.Logs = toScenarioLogs(vmOutput.Logs)
+	}
+
+	return &scenmodel.TxStep{
+		TxIdent:        recordedTxIdent,
+		Tx:             tx,
+		ExpectedResult: expectedResult,
+	}
+}
+
+func toScenarioLogs(logs []*vmcommon.LogEntry) scenmodel.LogList {
+	logList := scenmodel.LogList{
+		List: make([]*scenmodel.LogEntry, 0, len(logs)),
+	}
+	for _, logEntry := range logs {
+		logList.List = append(logList.List, &scenmodel.LogEntry{
+			Address:  checkBytes(logEntry.Address),
+			Endpoint: scenmodel.JSONCheckBytesReconstructed(logEntry.Identifier, "str:"+string(logEntry.Identifier)),
+			Topics:   checkValueList(logEntry.Topics),
+			Data:     checkValueList(logEntry.Data),
+		})
+	}
+
+	return logList
+}
+
+func newCheckStateStep(
+	input *vmcommon.ContractCallInput,
+	accounts []*AccountPreState,
+	vmOutput *vmcommon.VMOutput,
+) *scenmodel.CheckStateStep {
+	finalAccounts := make([]*AccountPreState, 0, len(accounts))
+	for _, account := range accounts {
+		finalAccounts = append(finalAccounts, copyAccountPreState(account))
+	}
+
+	caller := findOrAddAccount(&finalAccounts, input.CallerAddr)
+	caller.Nonce++
+	caller.Balance.Sub(caller.Balance, bigIntOrZero(input.CallValue))
+
+	if vmOutput.ReturnCode == vmcommon.Ok {
+		applyOutputAccounts(&finalAccounts, vmOutput)
+	}
+
+	sort.Slice(finalAccounts, func(i, j int) bool {
+		return bytes.Compare(finalAccounts[i].Address, finalAccounts[j].Address) < 0
+	})
+
+	checkAccounts := &scenmodel.CheckAccounts{
+		// only the accounts touched by the execution are known
+		MoreAccountsAllowed: true,
+	}
+	for _, account := range finalAccounts {
+		if isDeletedAccount(account.Address, vmOutput) {
+			continue
+		}
+		checkAccounts.Accounts = append(checkAccounts.Accounts, toScenarioCheckAccount(account))
+	}
+
+	return &scenmodel.CheckStateStep{
+		CheckAccounts: checkAccounts,
+	}
+}
+
+func applyOutputAccounts(accounts *[]*AccountPreState, vmOutput *vmcommon.VMOutput) {
+	for _, key := range sortedOutputAccountKeys(vmOutput.OutputAccounts) {
+		outputAccount := vmOutput.OutputAccounts[key]
+		account := findOrAddAccount(accounts, outputAccount.Address)
+
+		if outputAccount.Nonce > account.Nonce {
+			account.Nonce = outputAccount.Nonce
+		}
+		account.Balance.Add(account.Balance, bigIntOrZero(outputAccount.BalanceDelta))
+		if len(outputAccount.Code) > 0 {
+			account.Code = outputAccount.Code
+			account.CodeMetadata = outputAccount.CodeMetadata
+		}
+		if len(outputAccount.CodeDeployerAddress) > 0 {
+			account.OwnerAddress = outputAccount.CodeDeployerAddress
+		}
+
+		for _, storageKey := range sortedStorageUpdateKeys(outputAccount.StorageUpdates) {
+			storageUpdate := outputAccount.StorageUpdates[storageKey]
+			if !storageUpdate.Written {
+				continue
+			}
+			setStorageEntry(account, storageUpdate.Offset, storageUpdate.Data)
+		}
+	}
+}
+
+func toScenarioCheckAccount(account *AccountPreState) *scenmodel.CheckAccount {
+	checkAccount := &scenmodel.CheckAccount{
+		Address:         bytesFromString(account.Address),
+		Nonce:           checkUint64(account.Nonce),
+		Balance:         checkBigInt(account.Balance),
+		Username:        scenmodel.JSONCheckBytesUnspecified(),
+		Code:            checkBytes(account.Code),
+		CodeMetadata:    scenmodel.JSONCheckBytesUnspecified(),
+		Owner:           scenmodel.JSONCheckBytesUnspecified(),
+		AsyncCallData:   scenmodel.JSONCheckBytesUnspecified(),
+		DeveloperReward: scenmodel.JSONCheckBigIntUnspecified(),
+		// ESDT changes are performed by built-in functions, outside the VMOutput
+		IgnoreESDT:         true,
+		ExplicitStorage:    true,
+		MoreStorageAllowed: true,
+	}
+	for _, entry := range account.Storage {
+		checkAccount.CheckStorage = append(checkAccount.CheckStorage, &scenmodel.CheckStorageKeyValuePair{
+			Key:        bytesFromString(entry.Key),
+			CheckValue: checkBytes(entry.Value),
+		})
+	}
+
+	return checkAccount
+}
+
+func isDeletedAccount(address []byte, vmOutput *vmcommon.VMOutput) bool {
+	for _, deleted := range vmOutput.DeletedAccounts {
+		if bytes.Equal(deleted, address) {
+			return true
+		}
+	}
+	return false
+}
+
+func copyAccountPreState(account *AccountPreState) *AccountPreState {
+	accountCopy := *account
+	accountCopy.Balance = bigIntOrZero(account.Balance)
+	accountCopy.DeveloperReward = bigIntOrZero(account.DeveloperReward)
+	accountCopy.Storage = make([]*StorageEntry, 0, len(account.Storage))
+	for _, entry := range account.Storage {
+		accountCopy.Storage = append(accountCopy.Storage, &StorageEntry{Key: entry.Key, Value: entry.Value})
+	}
+	accountCopy.ESDTTokens = make([]*ESDTBalance, 0, len(account.ESDTTokens))
+	for _, token := range account.ESDTTokens {
+		tokenCopy := *token
+		tokenCopy.Balance = bigIntOrZero(token.Balance)
+		accountCopy.ESDTTokens = append(accountCopy.ESDTTokens, &tokenCopy)
+	}
+
+	return &accountCopy
+}
+
+func findOrAddAccount(accounts *[]*AccountPreState, address []byte) *AccountPreState {
+	for _, account := range *accounts {
+		if bytes.Equal(account.Address, address) {
+			return account
+		}
+	}
+
+	account := &AccountPreState{
+		Address:         address,
+		Balance:         big.NewInt(0),
+		DeveloperReward: big.NewInt(0),
+	}
+	*accounts = append(*accounts, account)
+	return account
+}
+
+func findESDTBalance(account *AccountPreState, tokenIdentifier []byte, nonce uint64) *ESDTBalance {
+	for _, token := range account.ESDTTokens {
+		if bytes.Equal(token.TokenIdentifier, tokenIdentifier) && token.Nonce == nonce {
+			return token
+		}
+	}
+	return nil
+}
+
+func findOrAddESDTBalance(account *AccountPreState, tokenIdentifier []byte, nonce uint64) *ESDTBalance {
+	token := findESDTBalance(account, tokenIdentifier, nonce)
+	if token == nil {
+		token = &ESDTBalance{
+			TokenIdentifier: tokenIdentifier,
+			Nonce:           nonce,
+			Balance:         big.NewInt(0),
+		}
+		account.ESDTTokens = append(account.ESDTTokens, token)
+	}
+	return token
+}
+
+func setStorageEntry(account *AccountPreState, key []byte, value []byte) {
+	for _, entry := range account.Storage {
+		if bytes.Equal(entry.Key, key) {
+			entry.Value = value
+			return
+		}
+	}
+	account.Storage = append(account.Storage, &StorageEntry{Key: key, Value: value})
+}
+
+func hexOriginal(value []byte) string {
+	if len(value) == 0 {
+		return ""
+	}
+	return "0x" + hex.EncodeToString(value)
+}
+
+func bytesFromString(value []byte) scenmodel.JSONBytesFromString {
+	return scenmodel.NewJSONBytesFromString(value, hexOriginal(value))
+}
+
+func bytesFromTree(value []byte) scenmodel.JSONBytesFromTree {
+	return scenmodel.JSONBytesFromTree{
+		Value:    value,
+		Original: &oj.OJsonString{Value: hexOriginal(value)},
+	}
+}
+
+func jsonBigInt(value *big.Int) scenmodel.JSONBigInt {
+	value = bigIntOrZero(value)
+	return scenmodel.JSONBigInt{
+		Value:    value,
+		Original: value.String(),
+	}
+}
+
+func jsonUint64(value uint64) scenmodel.JSONUint64 {
+	return scenmodel.JSONUint64{
+		Value:    value,
+		Original: fmt.Sprint(value),
+	}
+}
+
+func checkBytes(value []byte) scenmodel.JSONCheckBytes {
+	return scenmodel.JSONCheckBytesReconstructed(value, hexOriginal(value))
+}
+
+func checkBigInt(value *big.Int) scenmodel.JSONCheckBigInt {
+	value = bigIntOrZero(value)
+	return scenmodel.JSONCheckBigInt{
+		Value:    value,
+		Original: value.String(),
+	}
+}
+
+func checkUint64(value uint64) scenmodel.JSONCheckUint64 {
+	return scenmodel.JSONCheckUint64{
+		Value:    value,
+		Original: fmt.Sprint(value),
+	}
+}
+
+func checkValueList(values [][]byte) scenmodel.JSONCheckValueList {
+	valueList := scenmodel.JSONCheckValueList{
+		Values: make([]scenmodel.JSONCheckBytes, 0, len(values)),
+	}
+	for _, value := range values {
+		valueList.Values = append(valueList.Values, checkBytes(value))
+	}
+	return valueList
+}
@@ -0,0 +1,146 @@
+package replay
+
+import (
+	"encoding/hex"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	scenio "github.com/multiversx/mx-chain-scenario-go/scenario/io"
+	scenjsonparse "github.com/multiversx/mx-chain-scenario-go/scenario/json/parse"
+	scenmodel "github.com/multiversx/mx-chain-scenario-go/scenario/model"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testCallerAddress   = []byte("caller__________________________")
+	testContractAddress = []byte("contract________________________")
+	testStorageKey      = []byte("counter")
+	testTokenIdentifier = []byte("TOKEN-123456")
+)
+
+func createTestExecutionRecord() *ExecutionRecord {
+	recordingHook, _ := NewRecordingBlockchainHook(createRecordedHookStub())
+	_, _ = recordingHook.GetUserAccount(testContractAddress)
+	_, _, _ = recordingHook.GetStorageData(testContractAddress, testStorageKey)
+	_ = recordingHook.CurrentNonce()
+
+	record := recordingHook.NewExecutionRecord(
+		&vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallerAddr:  testCallerAddress,
+				CallValue:   big.NewInt(10),
+				GasProvided: 5000,
+				Arguments:   [][]byte{{5}},
+			},
+			RecipientAddr: testContractAddress,
+			Function:      "add",
+		},
+		&vmcommon.VMOutput{
+			ReturnCode:   vmcommon.Ok,
+			ReturnData:   [][]byte{{6}},
+			GasRemaining: 1234,
+			OutputAccounts: map[string]*vmcommon.OutputAccount{
+				string(testContractAddress): {
+					Address:      testContractAddress,
+					BalanceDelta: big.NewInt(10),
+					StorageUpdates: map[string]*vmcommon.StorageUpdate{
+						string(testStorageKey): {Offset: testStorageKey, Data: []byte{6}, Written: true},
+					},
+				},
+			},
+			Logs: []*vmcommon.LogEntry{
+				{Identifier: []byte("added"), Address: testContractAddress, Topics: [][]byte{{5}}},
+			},
+		},
+		nil)
+	record.Responses[hookCallKey(getCodeName, hex.EncodeToString(testContractAddress))] =
+		[]*HookResponse{{Bytes: []byte("code")}}
+	record.Responses[hookCallKey(getESDTTokenName, hex.EncodeToString(testContractAddress), hex.EncodeToString(testTokenIdentifier), "0")] =
+		[]*HookResponse{{ESDTToken: &esdt.ESDigitalToken{Value: big.NewInt(100)}}}
+
+	return record
+}
+
+func TestPreStateFromRecord(t *testing.T) {
+	t.Parallel()
+
+	preState, err := PreStateFromRecord(createTestExecutionRecord())
+	require.Nil(t, err)
+	require.Len(t, preState.Accounts, 1)
+	require.Nil(t, preState.PreviousBlock)
+	require.Equal(t, uint64(42), preState.CurrentBlock.Nonce)
+
+	contract := preState.FindAccount(testContractAddress)
+	require.NotNil(t, contract)
+	require.Equal(t, uint64(7), contract.Nonce)
+	require.Equal(t, big.NewInt(1000), contract.Balance)
+	require.Equal(t, []byte("code"), contract.Code)
+	require.Equal(t, []*StorageEntry{{Key: testStorageKey, Value: []byte("first")}}, contract.Storage)
+	require.Len(t, contract.ESDTTokens, 1)
+	require.Equal(t, testTokenIdentifier, contract.ESDTTokens[0].TokenIdentifier)
+	require.Equal(t, big.NewInt(100), contract.ESDTTokens[0].Balance)
+}
+
+func TestNewScenarioFromExecution_NilArguments(t *testing.T) {
+	t.Parallel()
+
+	record := createTestExecutionRecord()
+	preState, _ := PreStateFromRecord(record)
+	vmOutput := record.Output.ToVMOutput()
+
+	_, err := NewScenarioFromExecution("test", nil, preState, vmOutput)
+	require.Equal(t, ErrNilRecordedInput, err)
+	_, err = NewScenarioFromExecution("test", record.Input, nil, vmOutput)
+	require.Equal(t, ErrNilPreState, err)
+	_, err = NewScenarioFromExecution("test", record.Input, preState, nil)
+	require.Equal(t, ErrNilVMOutput, err)
+}
+
+func TestExecutionRecordToScenario_WriteAndParse(t *testing.T) {
+	t.Parallel()
+
+	scenario, err := ExecutionRecordToScenario(createTestExecutionRecord(), "recorded")
+	require.Nil(t, err)
+
+	scenarioPath := filepath.Join(t.TempDir(), "recorded.scen.json")
+	err = WriteScenarioFile(scenario, scenarioPath)
+	require.Nil(t, err)
+
+	serialized, err := os.ReadFile(scenarioPath)
+	require.Nil(t, err)
+	parser := scenjsonparse.NewParser(scenio.NewDefaultFileResolver(), []byte{5, 0})
+	parsed, err := parser.ParseScenarioFile(serialized)
+	require.Nil(t, err)
+	require.Equal(t, "recorded", parsed.Name)
+	require.Len(t, parsed.Steps, 3)
+
+	setState := parsed.Steps[0].(*scenmodel.SetStateStep)
+	require.Len(t, setState.Accounts, 2)
+	caller := setState.Accounts[0]
+	require.Equal(t, testCallerAddress, caller.Address.Value)
+	require.Equal(t, big.NewInt(10), caller.Balance.Value)
+	contract := setState.Accounts[1]
+	require.Equal(t, []byte("code"), contract.Code.Value)
+	require.Equal(t, []byte("first"), contract.Storage[0].Value.Value)
+	require.Equal(t, uint64(42), setState.CurrentBlockInfo.BlockNonce.Value)
+
+	scCall := parsed.Steps[1].(*scenmodel.TxStep)
+	require.Equal(t, "add", scCall.Tx.Function)
+	require.Equal(t, big.NewInt(10), scCall.Tx.EGLDValue.Value)
+	require.True(t, scCall.ExpectedResult.Out.CheckList([][]byte{{6}}))
+	require.True(t, scCall.ExpectedResult.Gas.Check(1234))
+	require.Len(t, scCall.ExpectedResult.Logs.List, 1)
+
+	checkState := parsed.Steps[2].(*scenmodel.CheckStateStep)
+	require.True(t, checkState.CheckAccounts.MoreAccountsAllowed)
+	checkCaller := scenmodel.FindCheckAccount(checkState.CheckAccounts.Accounts, testCallerAddress)
+	require.True(t, checkCaller.Nonce.Check(1))
+	require.True(t, checkCaller.Balance.Check(big.NewInt(0)))
+	checkContract := scenmodel.FindCheckAccount(checkState.CheckAccounts.Accounts, testContractAddress)
+	require.True(t, checkContract.Balance.Check(big.NewInt(1010)))
+	require.True(t, checkContract.CheckStorage[0].CheckValue.Check([]byte{6}))
+}
@@ -0,0 +1,169 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// CompareVMOutputs lists the human-readable differences between an expected
+// and an actual VMOutput. An empty result means the outputs are equivalent.
+func CompareVMOutputs(expected *vmcommon.VMOutput, actual *vmcommon.VMOutput) []string {
+	if expected == nil || actual == nil {
+		if expected == actual {
+			return nil
+		}
+		return []string{fmt.Sprintf("VMOutput: expected %s, got %s", describeNil(expected == nil), describeNil(actual == nil))}
+	}
+
+	diff := &vmOutputDiff{}
+	diff.compareValues("ReturnCode", expected.ReturnCode, actual.ReturnCode)
+	diff.compareValues("ReturnMessage", expected.ReturnMessage, actual.ReturnMessage)
+	diff.compareValues("GasRemaining", expected.GasRemaining, actual.GasRemaining)
+	diff.compareBigInts("GasRefund", expected.GasRefund, actual.GasRefund)
+	diff.compareByteSlices("ReturnData", expected.ReturnData, actual.ReturnData)
+	diff.compareByteSlices("DeletedAccounts", expected.DeletedAccounts, actual.DeletedAccounts)
+	diff.compareByteSlices("TouchedAccounts", expected.TouchedAccounts, actual.TouchedAccounts)
+	diff.compareLogs(expected.Logs, actual.Logs)
+	diff.compareOutputAccounts(expected.OutputAccounts, actual.OutputAccounts)
+
+	return diff.differences
+}
+
+type vmOutputDiff struct {
+	differences []string
+}
+
+func (diff *vmOutputDiff) add(format string, args ...interface{}) {
+	diff.differences = append(diff.differences, fmt.Sprintf(format, args...))
+}
+
+func (diff *vmOutputDiff) compareValues(field string, expected interface{}, actual interface{}) {
+	if expected != actual {
+		diff.add("%s: expected %v, got %v", field, expected, actual)
+	}
+}
+
+func (diff *vmOutputDiff) compareBytes(field string, expected []byte, actual []byte) {
+	if !bytes.Equal(expected, actual) {
+		diff.add("%s: expected 0x%s, got 0x%s", field, hex.EncodeToString(expected), hex.EncodeToString(actual))
+	}
+}
+
+func (diff *vmOutputDiff) compareBigInts(field string, expected *big.Int, actual *big.Int) {
+	if bigIntOrZero(expected).Cmp(bigIntOrZero(actual)) != 0 {
+		diff.add("%s: expected %s, got %s", field, bigIntOrZero(expected), bigIntOrZero(actual))
+	}
+}
+
+func (diff *vmOutputDiff) compareByteSlices(field string, expected [][]byte, actual [][]byte) {
+	if len(expected) != len(actual) {
+		diff.add("%s: expected %d items, got %d", field, len(expected), len(actual))
+		return
+	}
+	for i := range expected {
+		diff.compareBytes(fmt.Sprintf("%s[%d]", field, i), expected[i], actual[i])
+	}
+}
+
+func (diff *vmOutputDiff) compareLogs(expected []*vmcommon.LogEntry, actual []*vmcommon.LogEntry) {
+	if len(expected) != len(actual) {
+		diff.add("Logs: expected %d entries, got %d", len(expected), len(actual))
+		return
+	}
+	for i := range expected {
+		field := fmt.Sprintf("Logs[%d]", i)
+		diff.compareBytes(field+".Identifier", expected[i].Identifier, actual[i].Identifier)
+		diff.compareBytes(field+".Address", expected[i].Address, actual[i].Address)
+		diff.compareByteSlices(field+".Topics", expected[i].Topics, actual[i].Topics)
+		diff.compareByteSlices(field+".Data", expected[i].Data, actual[i].Data)
+	}
+}
+
+func (diff *vmOutputDiff) compareOutputAccounts(
+	expected map[string]*vmcommon.OutputAccount,
+	actual map[string]*vmcommon.OutputAccount,
+) {
+	for _, key := range sortedOutputAccountKeys(expected) {
+		field := fmt.Sprintf("OutputAccounts[%s]", hex.EncodeToString([]byte(key)))
+		actualAccount, found := actual[key]
+		if !found {
+			diff.add("%s: missing", field)
+			continue
+		}
+		diff.compareOutputAccount(field, expected[key], actualAccount)
+	}
+	for _, key := range sortedOutputAccountKeys(actual) {
+		_, found := expected[key]
+		if !found {
+			diff.add("OutputAccounts[%s]: unexpected", hex.EncodeToString([]byte(key)))
+		}
+	}
+}
+
+func (diff *vmOutputDiff) compareOutputAccount(field string, expected *vmcommon.OutputAccount, actual *vmcommon.OutputAccount) {
+	diff.compareValues(field+".Nonce", expected.Nonce, actual.Nonce)
+	diff.compareBigInts(field+".BalanceDelta", expected.BalanceDelta, actual.BalanceDelta)
+	diff.compareBytes(field+".Code", expected.Code, actual.Code)
+	diff.compareBytes(field+".CodeMetadata", expected.CodeMetadata, actual.CodeMetadata)
+	diff.compareBytes(field+".CodeDeployerAddress", expected.CodeDeployerAddress, actual.CodeDeployerAddress)
+	diff.compareValues(field+".GasUsed", expected.GasUsed, actual.GasUsed)
+	diff.compareValues(field+".BytesAddedToStorage", expected.BytesAddedToStorage, actual.BytesAddedToStorage)
+	diff.compareValues(field+".BytesDeletedFromStorage", expected.BytesDeletedFromStorage, actual.BytesDeletedFromStorage)
+	diff.compareStorageUpdates(field+".StorageUpdates", expected.StorageUpdates, actual.StorageUpdates)
+	diff.compareOutputTransfers(field+".OutputTransfers", expected.OutputTransfers, actual.OutputTransfers)
+}
+
+func (diff *vmOutputDiff) compareStorageUpdates(
+	field string,
+	expected map[string]*vmcommon.StorageUpdate,
+	actual map[string]*vmcommon.StorageUpdate,
+) {
+	for _, key := range sortedStorageUpdateKeys(expected) {
+		keyField := fmt.Sprintf("%s[%s]", field, hex.EncodeToString([]byte(key)))
+		actualUpdate, found := actual[key]
+		if !found {
+			diff.add("%s: missing", keyField)
+			continue
+		}
+		diff.compareBytes(keyField+".Data", expected[key].Data, actualUpdate.Data)
+		diff.compareValues(keyField+".Written", expected[key].Written, actualUpdate.Written)
+	}
+	for _, key := range sortedStorageUpdateKeys(actual) {
+		_, found := expected[key]
+		if !found {
+			diff.add("%s[%s]: unexpected", field, hex.EncodeToString([]byte(key)))
+		}
+	}
+}
+
+func (diff *vmOutputDiff) compareOutputTransfers(
+	field string,
+	expected []vmcommon.OutputTransfer,
+	actual []vmcommon.OutputTransfer,
+) {
+	if len(expected) != len(actual) {
+		diff.add("%s: expected %d items, got %d", field, len(expected), len(actual))
+		return
+	}
+	for i := range expected {
+		transferField := fmt.Sprintf("%s[%d]", field, i)
+		diff.compareBigInts(transferField+".Value", expected[i].Value, actual[i].Value)
+		diff.compareValues(transferField+".GasLimit", expected[i].GasLimit, actual[i].GasLimit)
+		diff.compareValues(transferField+".GasLocked", expected[i].GasLocked, actual[i].GasLocked)
+		diff.compareValues(transferField+".CallType", expected[i].CallType, actual[i].CallType)
+		diff.compareBytes(transferField+".Data", expected[i].Data, actual[i].Data)
+		diff.compareBytes(transferField+".AsyncData", expected[i].AsyncData, actual[i].AsyncData)
+		diff.compareBytes(transferField+".SenderAddress", expected[i].SenderAddress, actual[i].SenderAddress)
+	}
+}
+
+func describeNil(isNil bool) string {
+	if isNil {
+		return "nil"
+	}
+	return "non-nil"
+}
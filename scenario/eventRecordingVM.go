@@ -0,0 +1,43 @@
+package scenario
+
+import (
+	scenexec "github.com/multiversx/mx-chain-scenario-go/scenario/executor"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/eventabi"
+)
+
+var _ scenexec.VMInterface = (*eventRecordingVM)(nil)
+
+// eventRecordingVM passes the logs of every execution to an EventRecorder,
+// which decodes them with the registered event schemas
+type eventRecordingVM struct {
+	scenexec.VMInterface
+	recorder *eventabi.EventRecorder
+}
+
+// RunSmartContractCreate executes the deployment and records its logs
+func (vm *eventRecordingVM) RunSmartContractCreate(input *vmcommon.ContractCreateInput) (*vmcommon.VMOutput, error) {
+	vmOutput, err := vm.VMInterface.RunSmartContractCreate(input)
+	vm.recordLogs(vmOutput)
+	return vmOutput, err
+}
+
+// RunSmartContractCall executes the call and records its logs
+func (vm *eventRecordingVM) RunSmartContractCall(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	vmOutput, err := vm.VMInterface.RunSmartContractCall(input)
+	vm.recordLogs(vmOutput)
+	return vmOutput, err
+}
+
+func (vm *eventRecordingVM) recordLogs(vmOutput *vmcommon.VMOutput) {
+	if vmOutput == nil || vmOutput.ReturnCode != vmcommon.Ok {
+		return
+	}
+
+	vm.recorder.RecordLogs(vmOutput.Logs)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vm *eventRecordingVM) IsInterfaceNil() bool {
+	return vm == nil
+}
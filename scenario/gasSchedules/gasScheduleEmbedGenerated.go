@@ -68,6 +68,10 @@ const (
     CompilePerByte = 300
     AoTPreparePerByte = 300
     GetCode = 1000000
+    StorageDepositPerByte = 0
+    StorageRefundPerByte = 0
+    ClearStorageRefund = 100000
+    MaxGasRefundQuotient = 5
 
 [BaseOpsAPICost]
     GetSCAddress       = 100
@@ -122,6 +126,10 @@ const (
     GetCodeMetadata = 100
     IsBuiltinFunction = 100
     IsReservedFunctionName = 100
+    LogPerTopic = 500
+    GetCodeHash = 5000
+    GetAccountNonce = 5000
+    GetContractOwner = 5000
 
 [EthAPICost]
     UseGas = 100
@@ -266,6 +274,10 @@ const (
     MBufferGetArgument = 1000
     MBufferFinish = 1000
     MBufferSetRandom = 6000
+    MBufferStorageIterate = 50000
+    MBufferStorageIterateKey = 10000
+    MBufferTransientStore = 2000
+    MBufferTransientLoad = 1000
 
 [WASMOpcodeCost]
     AtomicFence = 10
@@ -899,6 +911,10 @@ const (
     CompilePerByte = 300
     AoTPreparePerByte = 100
     GetCode = 1000000
+    StorageDepositPerByte = 0
+    StorageRefundPerByte = 0
+    ClearStorageRefund = 100000
+    MaxGasRefundQuotient = 5
 
 [BaseOpsAPICost]
     GetSCAddress       = 100
@@ -953,6 +969,10 @@ const (
     GetCodeMetadata = 100
     IsBuiltinFunction = 100
     IsReservedFunctionName = 100
+    LogPerTopic = 500
+    GetCodeHash = 5000
+    GetAccountNonce = 5000
+    GetContractOwner = 5000
 
 [EthAPICost]
     UseGas = 100
@@ -1072,9 +1092,9 @@ const (
     UnmarshalCompressedECC = 270000
     GenerateKeyECC = 7000000
     EncodeDERSig = 10000000
-VerifySecp256r1 = 2000000
-VerifyBLSSignatureShare = 2000000
-VerifyBLSMultiSig = 2000000
+    VerifySecp256r1 = 2000000
+    VerifyBLSSignatureShare = 2000000
+    VerifyBLSMultiSig = 2000000
 
 [ManagedBufferAPICost]
     MBufferNew = 2000
@@ -1097,6 +1117,10 @@ VerifyBLSMultiSig = 2000000
     MBufferGetArgument = 1000
     MBufferFinish = 1000
     MBufferSetRandom = 6000
+    MBufferStorageIterate = 50000
+    MBufferStorageIterateKey = 10000
+    MBufferTransientStore = 2000
+    MBufferTransientLoad = 1000
 
 [WASMOpcodeCost]
     AtomicFence = 10
@@ -9,7 +9,9 @@ import (
 	"github.com/multiversx/mx-chain-scenario-go/worldmock"
 	"github.com/multiversx/mx-chain-vm-common-go/parsers"
 	"github.com/multiversx/mx-chain-vm-go/config"
+	"github.com/multiversx/mx-chain-vm-go/eventabi"
 	"github.com/multiversx/mx-chain-vm-go/executor"
+	mockworld "github.com/multiversx/mx-chain-vm-go/mock/world"
 	gasSchedules "github.com/multiversx/mx-chain-vm-go/scenario/gasSchedules"
 	"github.com/multiversx/mx-chain-vm-go/vmhost"
 	"github.com/multiversx/mx-chain-vm-go/vmhost/hostCore"
@@ -29,6 +31,10 @@ type ScenarioVMHostBuilder struct {
 	OverrideVMExecutor                  executor.ExecutorAbstractFactory
 	VMType                              []byte
 	TimeOutForSCExecutionInMilliseconds uint32
+	AsyncCallGraphTracer                vmhost.AsyncCallGraphTracer
+	// EventRecorder, when set, receives the logs of every successful execution;
+	// the VM returned by NewVM is then no longer a vmhost.VMHost
+	EventRecorder *eventabi.EventRecorder
 }
 
 // NewScenarioVMHostBuilder creates a default ScenarioVMHostBuilder.
@@ -37,6 +43,8 @@ func NewScenarioVMHostBuilder() *ScenarioVMHostBuilder {
 		OverrideVMExecutor:                  nil,
 		VMType:                              DefaultVMType,
 		TimeOutForSCExecutionInMilliseconds: DefaultTimeOutForSCExecutionInMilliseconds,
+		AsyncCallGraphTracer:                nil,
+		EventRecorder:                       nil,
 	}
 }
 
@@ -80,8 +88,8 @@ func (svb *ScenarioVMHostBuilder) NewVM(
 	blockGasLimit := uint64(10000000)
 	esdtTransferParser, _ := parsers.NewESDTTransferParser(worldmock.WorldMarshalizer)
 
-	return hostCore.NewVMHost(
-		world,
+	host, err := hostCore.NewVMHost(
+		mockworld.NewIterableMockWorld(world),
 		&vmhost.VMHostParameters{
 			VMType:                    svb.VMType,
 			OverrideVMExecutor:        svb.OverrideVMExecutor,
@@ -97,7 +105,19 @@ func (svb *ScenarioVMHostBuilder) NewVM(
 			MapOpcodeAddressIsAllowed: map[string]map[string]struct{}{},
 			TimeOutForSCExecutionInMilliseconds: svb.TimeOutForSCExecutionInMilliseconds,
 		})
+	if err != nil {
+		return nil, err
+	}
+
+	if svb.AsyncCallGraphTracer != nil {
+		host.SetAsyncCallGraphTracer(svb.AsyncCallGraphTracer)
+	}
+
+	if svb.EventRecorder != nil {
+		return &eventRecordingVM{VMInterface: host, recorder: svb.EventRecorder}, nil
+	}
 
+	return host, nil
 }
 
 // DefaultScenarioExecutor provides a scenario executor with VM 1.5, default configuration
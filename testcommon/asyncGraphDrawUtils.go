@@ -0,0 +1,135 @@
+package testcommon
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/awalterschulze/gographviz"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// GenerateSVGforExecutionGraph renders the graph traced during real executions as an SVG file
+func GenerateSVGforExecutionGraph(executionGraph *vmhost.AsyncExecutionGraph, folder string, name string) {
+	graphviz := ExecutionGraphToGraphviz(executionGraph)
+	CreateSvgWithLocation(folder, name, graphviz)
+}
+
+// ExecutionGraphToGraphviz converts the graph traced during real executions to graphviz
+func ExecutionGraphToGraphviz(executionGraph *vmhost.AsyncExecutionGraph) *gographviz.Graph {
+	return ToGraphviz(ExecutionGraphToTestCallGraph(executionGraph), true)
+}
+
+// ExecutionGraphToTestCallGraph converts the graph traced during real executions
+// to a TestCallGraph, so that it can be drawn like the graphs used in tests
+func ExecutionGraphToTestCallGraph(executionGraph *vmhost.AsyncExecutionGraph) *TestCallGraph {
+	graph := CreateTestCallGraph()
+	if executionGraph == nil {
+		return graph
+	}
+
+	testNodes := make(map[*vmhost.AsyncGraphNode]*TestCallNode)
+	for _, node := range executionGraph.Nodes {
+		testNodes[node] = addExecutionGraphNode(graph, node)
+	}
+
+	for _, node := range executionGraph.Nodes {
+		from := testNodes[node]
+		for _, edge := range node.AdjacentEdges {
+			to := testNodes[edge.To]
+			testEdge := graph.addEdge(from, to, true)
+			testEdge.Type = executionGraphEdgeType(edge.Type)
+			testEdge.Callback = edge.Callback
+			testEdge.GasLimit = edge.GasLimit
+			testEdge.GasUsed = edge.To.GasUsed()
+			testEdge.GasLocked = edge.GasLocked
+			testEdge.Fail = edge.To.Fail
+			testEdge.Label = executionGraphEdgeLabel(edge)
+			to.IncomingEdge = testEdge
+			to.GasLocked = edge.GasLocked
+		}
+	}
+
+	return graph
+}
+
+func addExecutionGraphNode(graph *TestCallGraph, node *vmhost.AsyncGraphNode) *TestCallNode {
+	contractName := readableAddress(node.Address)
+	label := contractName + "." + node.Function
+
+	graph.sequence++
+	testNode := &TestCallNode{
+		ID: graph.sequence,
+		Call: &TestCall{
+			ContractAddress:    node.Address,
+			FunctionName:       node.Function,
+			CallID:             node.CallID,
+			OriginalContractID: contractName,
+		},
+		AdjacentEdges:  make([]*TestCallEdge, 0),
+		IsStartNode:    node.IsStartNode,
+		Label:          strconv.Quote(label + "_" + strconv.Itoa(node.ID)),
+		VisualLabel:    strconv.Quote(label),
+		GasLimit:       node.GasLimit,
+		GasUsed:        node.GasUsed(),
+		GasRemaining:   node.GasRemaining,
+		GasAccumulated: node.GasAccumulated,
+		Fail:           node.Fail,
+	}
+	if !node.Executed {
+		testNode.ExecutionRound = -1
+	}
+	if node.IsStartNode && graph.StartNode == nil {
+		graph.StartNode = testNode
+	}
+
+	graph.Nodes = append(graph.Nodes, testNode)
+	return testNode
+}
+
+func executionGraphEdgeType(edgeType vmhost.AsyncGraphEdgeType) TestCallEdgeType {
+	switch edgeType {
+	case vmhost.AsyncGraphAsyncCall:
+		return Async
+	case vmhost.AsyncGraphAsyncCrossShardCall:
+		return AsyncCrossShard
+	case vmhost.AsyncGraphCallback:
+		return Callback
+	case vmhost.AsyncGraphCallbackCrossShard:
+		return CallbackCrossShard
+	default:
+		return Sync
+	}
+}
+
+func executionGraphEdgeLabel(edge *vmhost.AsyncGraphEdge) string {
+	switch edge.Type {
+	case vmhost.AsyncGraphAsyncCall, vmhost.AsyncGraphAsyncCrossShardCall:
+		label := "Async"
+		if edge.GroupID != "" {
+			label += "[" + edge.GroupID + "]"
+		}
+		return label + "\n" + edge.Callback
+	case vmhost.AsyncGraphCallback, vmhost.AsyncGraphCallbackCrossShard:
+		return "Callback"
+	default:
+		return "Sync"
+	}
+}
+
+// readableAddress returns the printable part of test and scenario addresses,
+// or the hex encoding of the address otherwise
+func readableAddress(address []byte) string {
+	trimmed := strings.TrimLeft(string(address), "\x00")
+	trimmed = strings.TrimRight(trimmed, "_.")
+	if len(trimmed) == 0 {
+		return hex.EncodeToString(address)
+	}
+	for _, r := range trimmed {
+		if !unicode.IsPrint(r) {
+			return hex.EncodeToString(address)
+		}
+	}
+	return trimmed
+}
@@ -0,0 +1,51 @@
+package crossshard
+
+import (
+	"math/rand"
+)
+
+// DeliveryOrderFunc decides the order in which the messages due in a round are executed
+type DeliveryOrderFunc func(messages []*CrossShardMessage) []*CrossShardMessage
+
+// DeliveryDelayFunc returns the number of extra rounds a message waits before being delivered
+type DeliveryDelayFunc func(message *CrossShardMessage) uint64
+
+// InOrderDelivery executes the messages in the order they were sent
+func InOrderDelivery(messages []*CrossShardMessage) []*CrossShardMessage {
+	return messages
+}
+
+// ReverseOrderDelivery executes the most recently sent messages first
+func ReverseOrderDelivery(messages []*CrossShardMessage) []*CrossShardMessage {
+	reversed := make([]*CrossShardMessage, len(messages))
+	for i, message := range messages {
+		reversed[len(messages)-1-i] = message
+	}
+	return reversed
+}
+
+// NewShuffledDelivery creates a DeliveryOrderFunc which executes the messages
+// in a random, but reproducible, order
+func NewShuffledDelivery(seed int64) DeliveryOrderFunc {
+	random := rand.New(rand.NewSource(seed))
+	return func(messages []*CrossShardMessage) []*CrossShardMessage {
+		shuffled := make([]*CrossShardMessage, len(messages))
+		copy(shuffled, messages)
+		random.Shuffle(len(shuffled), func(i, j int) {
+			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+		})
+		return shuffled
+	}
+}
+
+// NoDeliveryDelay delivers every message in the round following the one in which it was sent
+func NoDeliveryDelay(_ *CrossShardMessage) uint64 {
+	return 0
+}
+
+// NewFixedDeliveryDelay creates a DeliveryDelayFunc which delays every message by the same number of rounds
+func NewFixedDeliveryDelay(rounds uint64) DeliveryDelayFunc {
+	return func(_ *CrossShardMessage) uint64 {
+		return rounds
+	}
+}
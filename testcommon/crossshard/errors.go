@@ -0,0 +1,27 @@
+package crossshard
+
+import "errors"
+
+// ErrInvalidNumberOfShards signals that the simulator was configured with no shards
+var ErrInvalidNumberOfShards = errors.New("invalid number of shards")
+
+// ErrNilCreateHostFunc signals that no function to create the VM hosts was provided
+var ErrNilCreateHostFunc = errors.New("nil create host function")
+
+// ErrNilAccount signals that a nil account was provided
+var ErrNilAccount = errors.New("nil account")
+
+// ErrNilContractCallInput signals that a nil contract call input was provided
+var ErrNilContractCallInput = errors.New("nil contract call input")
+
+// ErrUnknownShard signals that an account or a message refers to a shard which is not simulated
+var ErrUnknownShard = errors.New("unknown shard")
+
+// ErrUnknownAddress signals that the shard of an address is not known to the simulator
+var ErrUnknownAddress = errors.New("unknown address")
+
+// ErrInvalidAsyncData signals that the async data of an output transfer could not be decoded
+var ErrInvalidAsyncData = errors.New("invalid async data")
+
+// ErrMaxRoundsReached signals that messages were still pending after the maximum number of rounds
+var ErrMaxRoundsReached = errors.New("maximum number of rounds reached with pending messages")
@@ -0,0 +1,175 @@
+package crossshard
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/crypto"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/contexts"
+)
+
+// callbackFunctionPlaceholder is the function of the callback inputs; the
+// actual callback is decided by the VM from the stored async call
+const callbackFunctionPlaceholder = "<callback>"
+
+// CrossShardMessage is a transaction or a smart contract result waiting to be
+// executed by the shard of its recipient
+type CrossShardMessage struct {
+	SenderShard      uint32
+	DestinationShard uint32
+	SentInRound      uint64
+	DeliveryRound    uint64
+	Input            *vmcommon.ContractCallInput
+}
+
+// ExecutionResult is the outcome of executing a CrossShardMessage
+type ExecutionResult struct {
+	Round    uint64
+	ShardID  uint32
+	Message  *CrossShardMessage
+	VMOutput *vmcommon.VMOutput
+	Err      error
+}
+
+// pendingAsyncCall is an async call received from another shard, for which the
+// callback is sent only after the async context of the call completes
+type pendingAsyncCall struct {
+	shardID  uint32
+	input    *vmcommon.ContractCallInput
+	vmOutput *vmcommon.VMOutput
+}
+
+func newInputFromOutputTransfer(
+	argsParser vmcommon.CallArgsParser,
+	destination []byte,
+	transfer *vmcommon.OutputTransfer,
+	parentInput *vmcommon.ContractCallInput,
+) (*vmcommon.ContractCallInput, error) {
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:         transfer.SenderAddress,
+			OriginalCallerAddr: parentInput.OriginalCallerAddr,
+			CallValue:          big.NewInt(0),
+			CallType:           transfer.CallType,
+			GasPrice:           parentInput.GasPrice,
+			GasProvided:        transfer.GasLimit,
+			GasLocked:          transfer.GasLocked,
+			OriginalTxHash:     parentInput.OriginalTxHash,
+			PrevTxHash:         parentInput.CurrentTxHash,
+			Arguments:          make([][]byte, 0),
+		},
+		RecipientAddr: destination,
+	}
+	if transfer.Value != nil {
+		input.CallValue.Set(transfer.Value)
+	}
+
+	if len(transfer.Data) > 0 {
+		function, arguments, err := argsParser.ParseData(string(transfer.Data))
+		if err == nil {
+			input.Function = function
+			input.Arguments = arguments
+		}
+	}
+
+	asyncArguments, err := newAsyncArgumentsFromAsyncData(argsParser, transfer.CallType, transfer.AsyncData)
+	if err != nil {
+		return nil, err
+	}
+	input.AsyncArguments = asyncArguments
+
+	return input, nil
+}
+
+func newAsyncArgumentsFromAsyncData(
+	argsParser vmcommon.CallArgsParser,
+	callType vm.CallType,
+	asyncData []byte,
+) (*vmcommon.AsyncArguments, error) {
+	if callType != vm.AsynchronousCall && callType != vm.AsynchronousCallBack {
+		return nil, nil
+	}
+
+	// the async data starts with "@", so the first parsed argument is always empty
+	parsed, err := argsParser.ParseArguments(string(asyncData))
+	if err != nil || len(parsed) < 3 {
+		return nil, ErrInvalidAsyncData
+	}
+
+	asyncArguments := &vmcommon.AsyncArguments{
+		CallID:       parsed[1],
+		CallerCallID: parsed[2],
+	}
+	if callType != vm.AsynchronousCallBack {
+		return asyncArguments, nil
+	}
+
+	if len(parsed) < 5 {
+		return nil, ErrInvalidAsyncData
+	}
+	asyncArguments.CallbackAsyncInitiatorCallID = parsed[3]
+	asyncArguments.GasAccumulated = big.NewInt(0).SetBytes(parsed[4]).Uint64()
+
+	return asyncArguments, nil
+}
+
+// newCallbackInput creates the callback of an async call received from another
+// shard, the same way the protocol creates it from the VMOutput of the async call;
+// the gas locked by the caller is returned to the callback in its gas limit
+func newCallbackInput(
+	hasher crypto.Hasher,
+	asyncInput *vmcommon.ContractCallInput,
+	vmOutput *vmcommon.VMOutput,
+) *vmcommon.ContractCallInput {
+	arguments := [][]byte{contexts.ReturnCodeToBytes(vmOutput.ReturnCode)}
+	callValue := big.NewInt(0)
+	if vmOutput.ReturnCode == vmcommon.Ok {
+		arguments = append(arguments, vmOutput.ReturnData...)
+	} else {
+		arguments = append(arguments, []byte(vmOutput.ReturnMessage))
+		callValue.Set(asyncInput.CallValue)
+	}
+
+	callbackParams := contexts.CreateCallbackAsyncParams(hasher, asyncInput.AsyncArguments)
+
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:         asyncInput.RecipientAddr,
+			OriginalCallerAddr: asyncInput.OriginalCallerAddr,
+			CallValue:          callValue,
+			CallType:           vm.AsynchronousCallBack,
+			GasPrice:           asyncInput.GasPrice,
+			GasProvided:        vmOutput.GasRemaining + asyncInput.GasLocked,
+			GasLocked:          0,
+			OriginalTxHash:     asyncInput.OriginalTxHash,
+			PrevTxHash:         asyncInput.CurrentTxHash,
+			Arguments:          arguments,
+			AsyncArguments: &vmcommon.AsyncArguments{
+				CallID:                       callbackParams[0],
+				CallerCallID:                 callbackParams[1],
+				CallbackAsyncInitiatorCallID: callbackParams[2],
+				GasAccumulated:               0,
+			},
+		},
+		RecipientAddr: asyncInput.CallerAddr,
+		Function:      callbackFunctionPlaceholder,
+	}
+}
+
+func vmOutputFromError(vmOutput *vmcommon.VMOutput, err error) *vmcommon.VMOutput {
+	if vmOutput != nil {
+		return vmOutput
+	}
+
+	return &vmcommon.VMOutput{
+		ReturnCode:    vmcommon.ExecutionFailed,
+		ReturnMessage: err.Error(),
+	}
+}
+
+func asyncContextStorageKey(host vmhost.VMHost, callID []byte) []byte {
+	prefix := host.Storage().GetVmProtectedPrefix(vmhost.AsyncDataPrefix)
+	return vmhost.CustomStorageKey(string(prefix), callID)
+}
@@ -0,0 +1,452 @@
+// Package crossshard simulates several shards, each with its own VM host and
+// mock world, exchanging cross-shard calls and callbacks in rounds.
+package crossshard
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-common-go/parsers"
+	"github.com/multiversx/mx-chain-vm-go/crypto"
+	"github.com/multiversx/mx-chain-vm-go/crypto/hashing"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// CreateHostFunc creates the VM host of a shard, working on the given world
+type CreateHostFunc func(shardID uint32, world *worldmock.MockWorld) (vmhost.VMHost, error)
+
+// ArgsSimulator holds the arguments needed to create a Simulator
+type ArgsSimulator struct {
+	NumShards     uint32
+	CreateHost    CreateHostFunc
+	DeliveryOrder DeliveryOrderFunc
+	DeliveryDelay DeliveryDelayFunc
+}
+
+// Shard is a simulated shard, with its own state and VM host
+type Shard struct {
+	ID    uint32
+	World *worldmock.MockWorld
+	Host  vmhost.VMHost
+}
+
+// Simulator executes transactions on several shards and routes the output
+// transfers between them, delivering them in the following rounds
+type Simulator struct {
+	shards        []*Shard
+	shardOfAddr   map[string]uint32
+	deliveryOrder DeliveryOrderFunc
+	deliveryDelay DeliveryDelayFunc
+	argsParser    vmcommon.CallArgsParser
+	hasher        crypto.Hasher
+
+	round        uint64
+	txCounter    uint64
+	messages     []*CrossShardMessage
+	pendingAsync []*pendingAsyncCall
+	results      []*ExecutionResult
+}
+
+// NewSimulator creates a Simulator with a new mock world and VM host for each shard
+func NewSimulator(args ArgsSimulator) (*Simulator, error) {
+	if args.NumShards == 0 {
+		return nil, ErrInvalidNumberOfShards
+	}
+	if args.CreateHost == nil {
+		return nil, ErrNilCreateHostFunc
+	}
+
+	simulator := &Simulator{
+		shards:        make([]*Shard, 0, args.NumShards),
+		shardOfAddr:   make(map[string]uint32),
+		deliveryOrder: args.DeliveryOrder,
+		deliveryDelay: args.DeliveryDelay,
+		argsParser:    parsers.NewCallArgsParser(),
+		hasher:        hashing.NewHasher(),
+		messages:      make([]*CrossShardMessage, 0),
+		pendingAsync:  make([]*pendingAsyncCall, 0),
+		results:       make([]*ExecutionResult, 0),
+	}
+	if simulator.deliveryOrder == nil {
+		simulator.deliveryOrder = InOrderDelivery
+	}
+	if simulator.deliveryDelay == nil {
+		simulator.deliveryDelay = NoDeliveryDelay
+	}
+
+	for shardID := uint32(0); shardID < args.NumShards; shardID++ {
+		world := worldmock.NewMockWorld()
+		world.SelfShardID = shardID
+		world.CurrentBlockInfo = &worldmock.BlockInfo{}
+
+		host, err := args.CreateHost(shardID, world)
+		if err != nil {
+			return nil, err
+		}
+
+		simulator.shards = append(simulator.shards, &Shard{
+			ID:    shardID,
+			World: world,
+			Host:  host,
+		})
+	}
+
+	return simulator, nil
+}
+
+// AddAccount adds the account to the world of its shard; the other shards
+// only learn the shard of the account and whether it is a smart contract
+func (simulator *Simulator) AddAccount(account *worldmock.Account) error {
+	if account == nil {
+		return ErrNilAccount
+	}
+	if account.ShardID >= uint32(len(simulator.shards)) {
+		return fmt.Errorf("%w: %d", ErrUnknownShard, account.ShardID)
+	}
+	if account.Balance == nil {
+		account.Balance = big.NewInt(0)
+	}
+	if account.Storage == nil {
+		account.Storage = make(map[string][]byte)
+	}
+
+	simulator.shardOfAddr[string(account.Address)] = account.ShardID
+	for _, shard := range simulator.shards {
+		if shard.ID == account.ShardID {
+			account.Exists = true
+			account.MockWorld = shard.World
+			shard.World.AcctMap.PutAccount(account)
+			continue
+		}
+
+		foreignAccount := shard.World.AcctMap.CreateAccount(account.Address, shard.World)
+		foreignAccount.ShardID = account.ShardID
+		foreignAccount.IsSmartContract = account.IsSmartContract
+		foreignAccount.CodeMetadata = account.CodeMetadata
+	}
+
+	return nil
+}
+
+// SendTransaction schedules a transaction for execution, in the next round, on the shard of its recipient
+func (simulator *Simulator) SendTransaction(input *vmcommon.ContractCallInput) error {
+	if input == nil {
+		return ErrNilContractCallInput
+	}
+
+	destinationShard, err := simulator.ShardOfAddress(input.RecipientAddr)
+	if err != nil {
+		return err
+	}
+	senderShard, err := simulator.ShardOfAddress(input.CallerAddr)
+	if err != nil {
+		senderShard = destinationShard
+	}
+
+	if len(input.CurrentTxHash) == 0 {
+		input.CurrentTxHash = simulator.newTxHash()
+	}
+	if len(input.OriginalTxHash) == 0 {
+		input.OriginalTxHash = input.CurrentTxHash
+	}
+	if len(input.OriginalCallerAddr) == 0 {
+		input.OriginalCallerAddr = input.CallerAddr
+	}
+
+	// the value leaves the sender in its own shard, as the protocol does for cross-shard transactions
+	if senderShard != destinationShard && input.CallValue != nil && input.CallValue.Sign() > 0 {
+		world := simulator.shards[senderShard].World
+		err = world.UpdateBalanceWithDelta(input.CallerAddr, big.NewInt(0).Neg(input.CallValue))
+		if err != nil {
+			return err
+		}
+	}
+
+	simulator.messages = append(simulator.messages, &CrossShardMessage{
+		SenderShard:      senderShard,
+		DestinationShard: destinationShard,
+		SentInRound:      simulator.round,
+		DeliveryRound:    simulator.round + 1,
+		Input:            input,
+	})
+
+	return nil
+}
+
+// RunRound advances to the next round and executes the messages due in it,
+// in the configured delivery order
+func (simulator *Simulator) RunRound() []*ExecutionResult {
+	simulator.round++
+	for _, shard := range simulator.shards {
+		shard.World.CurrentBlockInfo.BlockRound = simulator.round
+		shard.World.CurrentBlockInfo.BlockNonce = simulator.round
+	}
+
+	due := make([]*CrossShardMessage, 0)
+	remaining := make([]*CrossShardMessage, 0)
+	for _, message := range simulator.messages {
+		if message.DeliveryRound <= simulator.round {
+			due = append(due, message)
+		} else {
+			remaining = append(remaining, message)
+		}
+	}
+	simulator.messages = remaining
+
+	roundResults := make([]*ExecutionResult, 0, len(due))
+	for _, message := range simulator.deliveryOrder(due) {
+		result := simulator.executeMessage(message)
+		roundResults = append(roundResults, result)
+	}
+
+	simulator.results = append(simulator.results, roundResults...)
+	return roundResults
+}
+
+// RunUntilNoPendingMessages runs rounds until all the messages are delivered,
+// failing if messages are still pending after maxRounds rounds
+func (simulator *Simulator) RunUntilNoPendingMessages(maxRounds uint64) error {
+	for i := uint64(0); i < maxRounds; i++ {
+		if len(simulator.messages) == 0 {
+			return nil
+		}
+		simulator.RunRound()
+	}
+
+	if len(simulator.messages) > 0 {
+		return ErrMaxRoundsReached
+	}
+	return nil
+}
+
+// ShardOfAddress returns the shard of an address added to the simulator
+func (simulator *Simulator) ShardOfAddress(address []byte) (uint32, error) {
+	shardID, ok := simulator.shardOfAddr[string(address)]
+	if !ok {
+		return 0, fmt.Errorf("%w: %x", ErrUnknownAddress, address)
+	}
+	return shardID, nil
+}
+
+// Shard returns the simulated shard with the given ID, or nil
+func (simulator *Simulator) Shard(shardID uint32) *Shard {
+	if shardID >= uint32(len(simulator.shards)) {
+		return nil
+	}
+	return simulator.shards[shardID]
+}
+
+// GetAccount returns the account from the world of its own shard, or nil
+func (simulator *Simulator) GetAccount(address []byte) *worldmock.Account {
+	shardID, err := simulator.ShardOfAddress(address)
+	if err != nil {
+		return nil
+	}
+	return simulator.shards[shardID].World.AcctMap.GetAccount(address)
+}
+
+// CurrentRound returns the last executed round
+func (simulator *Simulator) CurrentRound() uint64 {
+	return simulator.round
+}
+
+// PendingMessages returns the messages not yet delivered
+func (simulator *Simulator) PendingMessages() []*CrossShardMessage {
+	return simulator.messages
+}
+
+// ExecutionResults returns the results of all the executed messages, in execution order
+func (simulator *Simulator) ExecutionResults() []*ExecutionResult {
+	return simulator.results
+}
+
+// Close resets the VM hosts of all the shards
+func (simulator *Simulator) Close() {
+	for _, shard := range simulator.shards {
+		shard.Host.Reset()
+	}
+}
+
+func (simulator *Simulator) executeMessage(message *CrossShardMessage) *ExecutionResult {
+	shard := simulator.shards[message.DestinationShard]
+	input := message.Input
+	if len(input.CurrentTxHash) == 0 {
+		input.CurrentTxHash = simulator.newTxHash()
+	}
+
+	result := &ExecutionResult{
+		Round:   simulator.round,
+		ShardID: shard.ID,
+		Message: message,
+	}
+
+	if !simulator.requiresExecution(shard, input) {
+		result.Err = simulator.creditValue(shard, input)
+		result.VMOutput = &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+		return result
+	}
+
+	shard.World.CreateStateBackup()
+	vmOutput, err := shard.Host.RunSmartContractCall(input)
+	result.VMOutput = vmOutput
+	result.Err = err
+	vmOutput = vmOutputFromError(vmOutput, err)
+
+	if vmOutput.ReturnCode == vmcommon.Ok {
+		simulator.applyVMOutput(shard, vmOutput)
+		simulator.routeOutputTransfers(shard, input, vmOutput)
+	}
+
+	if input.CallType == vm.AsynchronousCall {
+		simulator.pendingAsync = append(simulator.pendingAsync, &pendingAsyncCall{
+			shardID:  shard.ID,
+			input:    input,
+			vmOutput: vmOutput,
+		})
+	}
+	simulator.sendCallbacksOfCompletedAsyncCalls(shard)
+
+	return result
+}
+
+func (simulator *Simulator) requiresExecution(shard *Shard, input *vmcommon.ContractCallInput) bool {
+	if input.CallType == vm.AsynchronousCall || input.CallType == vm.AsynchronousCallBack {
+		return true
+	}
+	if len(input.Function) == 0 {
+		return false
+	}
+	return shard.World.IsSmartContract(input.RecipientAddr) || shard.Host.IsBuiltinFunctionName(input.Function)
+}
+
+func (simulator *Simulator) creditValue(shard *Shard, input *vmcommon.ContractCallInput) error {
+	if input.CallValue == nil || input.CallValue.Sign() == 0 {
+		return nil
+	}
+
+	account := shard.World.AcctMap.GetAccount(input.RecipientAddr)
+	if account == nil {
+		account = shard.World.AcctMap.CreateAccount(input.RecipientAddr, shard.World)
+		account.ShardID = shard.ID
+		simulator.shardOfAddr[string(input.RecipientAddr)] = shard.ID
+	}
+	return account.AddToBalance(input.CallValue)
+}
+
+// applyVMOutput updates only the accounts of the shard; the accounts of the
+// other shards are updated when the output transfers are delivered to them
+func (simulator *Simulator) applyVMOutput(shard *Shard, vmOutput *vmcommon.VMOutput) {
+	for _, outputAccount := range vmOutput.OutputAccounts {
+		if simulator.shardOrDefault(outputAccount.Address, shard.ID) != shard.ID {
+			continue
+		}
+		shard.World.UpdateAccountFromOutputAccount(outputAccount)
+		simulator.shardOfAddr[string(outputAccount.Address)] = shard.ID
+	}
+
+	for _, deletedAccount := range vmOutput.DeletedAccounts {
+		if simulator.shardOrDefault(deletedAccount, shard.ID) != shard.ID {
+			continue
+		}
+		shard.World.AcctMap.DeleteAccount(deletedAccount)
+	}
+}
+
+// routeOutputTransfers sends the output transfers towards other shards as
+// messages, delivered after the configured delay
+func (simulator *Simulator) routeOutputTransfers(shard *Shard, input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput) {
+	for _, outputAccount := range sortedOutputAccounts(vmOutput) {
+		destinationShard := simulator.shardOrDefault(outputAccount.Address, shard.ID)
+		if destinationShard == shard.ID {
+			continue
+		}
+
+		for i := range outputAccount.OutputTransfers {
+			transferInput, err := newInputFromOutputTransfer(simulator.argsParser, outputAccount.Address, &outputAccount.OutputTransfers[i], input)
+			if err != nil {
+				simulator.results = append(simulator.results, &ExecutionResult{
+					Round:   simulator.round,
+					ShardID: shard.ID,
+					Err:     err,
+				})
+				continue
+			}
+			simulator.sendMessage(shard.ID, destinationShard, transferInput)
+		}
+	}
+}
+
+// sendCallbacksOfCompletedAsyncCalls sends back the callbacks of the async calls
+// received from other shards whose async context is no longer stored, meaning
+// that all the calls they made in turn have completed
+func (simulator *Simulator) sendCallbacksOfCompletedAsyncCalls(shard *Shard) {
+	stillPending := make([]*pendingAsyncCall, 0, len(simulator.pendingAsync))
+	for _, pending := range simulator.pendingAsync {
+		if pending.shardID != shard.ID || simulator.isAsyncContextStored(shard, pending.input) {
+			stillPending = append(stillPending, pending)
+			continue
+		}
+
+		callbackInput := newCallbackInput(simulator.hasher, pending.input, pending.vmOutput)
+		destinationShard := simulator.shardOrDefault(callbackInput.RecipientAddr, shard.ID)
+		simulator.sendMessage(shard.ID, destinationShard, callbackInput)
+	}
+	simulator.pendingAsync = stillPending
+}
+
+func (simulator *Simulator) isAsyncContextStored(shard *Shard, input *vmcommon.ContractCallInput) bool {
+	if input.AsyncArguments == nil {
+		return false
+	}
+
+	account := shard.World.AcctMap.GetAccount(input.RecipientAddr)
+	if account == nil {
+		return false
+	}
+
+	storageKey := asyncContextStorageKey(shard.Host, input.AsyncArguments.CallID)
+	return len(account.StorageValue(string(storageKey))) > 0
+}
+
+// sortedOutputAccounts orders the output accounts by address, so that the
+// messages are routed in the same order on every run
+func sortedOutputAccounts(vmOutput *vmcommon.VMOutput) []*vmcommon.OutputAccount {
+	outputAccounts := make([]*vmcommon.OutputAccount, 0, len(vmOutput.OutputAccounts))
+	for _, outputAccount := range vmOutput.OutputAccounts {
+		outputAccounts = append(outputAccounts, outputAccount)
+	}
+	sort.Slice(outputAccounts, func(i, j int) bool {
+		return bytes.Compare(outputAccounts[i].Address, outputAccounts[j].Address) < 0
+	})
+	return outputAccounts
+}
+
+func (simulator *Simulator) sendMessage(senderShard uint32, destinationShard uint32, input *vmcommon.ContractCallInput) {
+	message := &CrossShardMessage{
+		SenderShard:      senderShard,
+		DestinationShard: destinationShard,
+		SentInRound:      simulator.round,
+		Input:            input,
+	}
+	message.DeliveryRound = simulator.round + 1 + simulator.deliveryDelay(message)
+	simulator.messages = append(simulator.messages, message)
+}
+
+func (simulator *Simulator) shardOrDefault(address []byte, defaultShard uint32) uint32 {
+	shardID, ok := simulator.shardOfAddr[string(address)]
+	if !ok {
+		return defaultShard
+	}
+	return shardID
+}
+
+func (simulator *Simulator) newTxHash() []byte {
+	simulator.txCounter++
+	txHash, _ := simulator.hasher.Sha256(big.NewInt(0).SetUint64(simulator.txCounter).Bytes())
+	return txHash
+}
@@ -0,0 +1,213 @@
+package crossshard
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	mock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	test "github.com/multiversx/mx-chain-vm-go/testcommon"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	simParentAddress = test.MakeTestSCAddress("simParentSC")
+	simChildAddress  = test.MakeTestSCAddress("simChildSC")
+	simUserAddress   = []byte("simUser_________________________")
+	simCallbackKey   = []byte("callbackArgs")
+	simChildKey      = []byte("childKey")
+	simChildResult   = []byte("child result")
+)
+
+func parentForwardAsyncCall(instanceMock *mock.InstanceMock, _ interface{}) {
+	instanceMock.AddMockMethod("forwardAsyncCall", func() *mock.InstanceMock {
+		host := instanceMock.Host
+		instance := mock.GetMockInstance(host)
+		err := host.Async().RegisterAsyncCall("group", &vmhost.AsyncCall{
+			Status:          vmhost.AsyncCallPending,
+			Destination:     simChildAddress,
+			Data:            []byte("childMethod"),
+			ValueBytes:      big.NewInt(5).Bytes(),
+			GasLimit:        100_000,
+			SuccessCallback: "callBack",
+			ErrorCallback:   "callBack",
+		})
+		require.Nil(instance.T, err)
+		return instance
+	})
+	instanceMock.AddMockMethod("callBack", func() *mock.InstanceMock {
+		host := instanceMock.Host
+		instance := mock.GetMockInstance(host)
+		arguments := host.Runtime().Arguments()
+		_, _ = host.Storage().SetStorage(simCallbackKey, arguments[len(arguments)-1])
+		return instance
+	})
+}
+
+func childMethod(instanceMock *mock.InstanceMock, _ interface{}) {
+	instanceMock.AddMockMethod("childMethod", func() *mock.InstanceMock {
+		host := instanceMock.Host
+		instance := mock.GetMockInstance(host)
+		_, _ = host.Storage().SetStorage(simChildKey, simChildResult)
+		host.Output().Finish(simChildResult)
+		return instance
+	})
+}
+
+func newSimulatorForTest(t *testing.T, order DeliveryOrderFunc, delay DeliveryDelayFunc) *Simulator {
+	executors := make(map[uint32]*mock.ExecutorMockFactory)
+	parent := test.CreateMockContractOnShard(simParentAddress, 0).WithMethods(parentForwardAsyncCall)
+	child := test.CreateMockContractOnShard(simChildAddress, 1).WithMethods(childMethod)
+
+	simulator, err := NewSimulator(ArgsSimulator{
+		NumShards: 2,
+		CreateHost: func(shardID uint32, world *worldmock.MockWorld) (vmhost.VMHost, error) {
+			executorFactory := mock.NewExecutorMockFactory(world)
+			executors[shardID] = executorFactory
+			host := test.NewTestHostBuilder(t).
+				WithExecutorFactory(executorFactory).
+				WithBlockchainHook(world).
+				Build()
+			return host, nil
+		},
+		DeliveryOrder: order,
+		DeliveryDelay: delay,
+	})
+	require.Nil(t, err)
+
+	contracts := map[string]*test.MockTestSmartContract{
+		string(simParentAddress): &parent,
+		string(simChildAddress):  &child,
+	}
+	for address, contract := range contracts {
+		shard := simulator.Shard(contract.GetShardID())
+		contract.Initialize(t, shard.Host, executors[shard.ID].LastCreatedExecutor, false)
+
+		account := &worldmock.Account{
+			Address:         []byte(address),
+			ShardID:         shard.ID,
+			IsSmartContract: true,
+			Balance:         big.NewInt(1000),
+		}
+		account.SetCodeAndMetadata([]byte(address), &vmcommon.CodeMetadata{Payable: true})
+		require.Nil(t, simulator.AddAccount(account))
+	}
+
+	require.Nil(t, simulator.AddAccount(&worldmock.Account{
+		Address: simUserAddress,
+		ShardID: 0,
+		Balance: big.NewInt(1000),
+	}))
+
+	return simulator
+}
+
+func newForwardAsyncCallInput() *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  simUserAddress,
+			CallValue:   big.NewInt(0),
+			CallType:    vm.DirectCall,
+			GasPrice:    1,
+			GasProvided: 1_000_000,
+			Arguments:   make([][]byte, 0),
+		},
+		RecipientAddr: simParentAddress,
+		Function:      "forwardAsyncCall",
+	}
+}
+
+func TestNewSimulator_InvalidArgs(t *testing.T) {
+	t.Parallel()
+
+	simulator, err := NewSimulator(ArgsSimulator{NumShards: 0})
+	require.Nil(t, simulator)
+	require.Equal(t, ErrInvalidNumberOfShards, err)
+
+	simulator, err = NewSimulator(ArgsSimulator{NumShards: 2})
+	require.Nil(t, simulator)
+	require.Equal(t, ErrNilCreateHostFunc, err)
+}
+
+func TestSimulator_AddAccount(t *testing.T) {
+	t.Parallel()
+
+	simulator := newSimulatorForTest(t, nil, nil)
+	defer simulator.Close()
+
+	require.ErrorIs(t, simulator.AddAccount(&worldmock.Account{Address: []byte("a"), ShardID: 5}), ErrUnknownShard)
+	require.Equal(t, ErrNilAccount, simulator.AddAccount(nil))
+
+	shardID, err := simulator.ShardOfAddress(simChildAddress)
+	require.Nil(t, err)
+	require.Equal(t, uint32(1), shardID)
+
+	require.Equal(t, uint32(1), simulator.Shard(0).World.GetShardOfAddress(simChildAddress))
+	require.Nil(t, simulator.Shard(0).World.AcctMap.GetAccount(simChildAddress).Code)
+	require.Equal(t, simChildAddress, simulator.GetAccount(simChildAddress).Code)
+}
+
+func TestSimulator_CrossShardAsyncCall(t *testing.T) {
+	t.Parallel()
+
+	simulator := newSimulatorForTest(t, nil, nil)
+	defer simulator.Close()
+
+	require.Nil(t, simulator.SendTransaction(newForwardAsyncCallInput()))
+
+	results := simulator.RunRound()
+	require.Len(t, results, 1)
+	require.Nil(t, results[0].Err)
+	require.Equal(t, vmcommon.Ok, results[0].VMOutput.ReturnCode)
+	require.Equal(t, uint32(0), results[0].ShardID)
+	require.Len(t, simulator.PendingMessages(), 1)
+	require.Equal(t, vm.AsynchronousCall, simulator.PendingMessages()[0].Input.CallType)
+
+	results = simulator.RunRound()
+	require.Len(t, results, 1)
+	require.Equal(t, uint32(1), results[0].ShardID)
+	require.Equal(t, "childMethod", results[0].Message.Input.Function)
+	require.Equal(t, vmcommon.Ok, results[0].VMOutput.ReturnCode)
+	require.Equal(t, simChildResult, simulator.GetAccount(simChildAddress).StorageValue(string(simChildKey)))
+	require.Equal(t, big.NewInt(1005), simulator.GetAccount(simChildAddress).Balance)
+
+	results = simulator.RunRound()
+	require.Len(t, results, 1)
+	require.Equal(t, uint32(0), results[0].ShardID)
+	require.Equal(t, vm.AsynchronousCallBack, results[0].Message.Input.CallType)
+	require.Equal(t, vmcommon.Ok, results[0].VMOutput.ReturnCode)
+
+	require.Empty(t, simulator.PendingMessages())
+	require.Len(t, simulator.ExecutionResults(), 3)
+	require.Equal(t, uint64(3), simulator.CurrentRound())
+
+	parent := simulator.GetAccount(simParentAddress)
+	require.Equal(t, simChildResult, parent.StorageValue(string(simCallbackKey)))
+	require.Equal(t, big.NewInt(995), parent.Balance)
+
+	callID := results[0].Message.Input.AsyncArguments.CallerCallID
+	storageKey := asyncContextStorageKey(simulator.Shard(0).Host, callID)
+	require.Empty(t, parent.StorageValue(string(storageKey)))
+}
+
+func TestSimulator_DeliveryDelay(t *testing.T) {
+	t.Parallel()
+
+	simulator := newSimulatorForTest(t, ReverseOrderDelivery, NewFixedDeliveryDelay(2))
+	defer simulator.Close()
+
+	require.Nil(t, simulator.SendTransaction(newForwardAsyncCallInput()))
+	require.Equal(t, ErrMaxRoundsReached, simulator.RunUntilNoPendingMessages(3))
+	require.Len(t, simulator.ExecutionResults(), 1)
+
+	require.Nil(t, simulator.RunUntilNoPendingMessages(10))
+	results := simulator.ExecutionResults()
+	require.Len(t, results, 3)
+	require.Equal(t, uint64(1), results[0].Round)
+	require.Equal(t, uint64(4), results[1].Round)
+	require.Equal(t, uint64(7), results[2].Round)
+	require.Equal(t, simChildResult, simulator.GetAccount(simParentAddress).StorageValue(string(simCallbackKey)))
+}
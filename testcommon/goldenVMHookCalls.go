@@ -0,0 +1,98 @@
+package testcommon
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	executorwrapper "github.com/multiversx/mx-chain-vm-go/executor/wrapper"
+	contextmock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	"github.com/stretchr/testify/require"
+)
+
+// GoldenDirEnvVar is the environment variable holding the directory of the VM
+// hook call golden files. When it is set, every host built by a TestHostBuilder
+// over a real executor records its VM hook calls and checks them against the
+// golden file of the test, which is created if missing.
+const GoldenDirEnvVar = "VM_GOLDEN_DIR"
+
+// GoldenUpdateEnvVar overwrites the existing golden files instead of checking them, when set to "true"
+const GoldenUpdateEnvVar = "VM_GOLDEN_UPDATE"
+
+const goldenFileExtension = ".calls.json"
+
+var (
+	goldenFileCountersMut sync.Mutex
+	goldenFileCounters    = make(map[string]int)
+)
+
+// wrapWithGoldenRecorder wraps the executor factory with a recorder of VM hook
+// calls, unless golden files are disabled, the executor is a mock, or its calls
+// are already logged by the test
+func wrapWithGoldenRecorder(tb testing.TB, executorFactory executor.ExecutorAbstractFactory) executor.ExecutorAbstractFactory {
+	goldenDir := os.Getenv(GoldenDirEnvVar)
+	if len(goldenDir) == 0 {
+		return executorFactory
+	}
+
+	switch executorFactory.(type) {
+	case *contextmock.ExecutorMockFactory, *executorwrapper.WrapperExecutorFactory:
+		return executorFactory
+	}
+
+	recorder := executorwrapper.NewGoldenRecorder()
+	goldenFilePath := nextGoldenFilePath(goldenDir, tb.Name())
+	tb.Cleanup(func() {
+		if tb.Failed() || len(recorder.Calls()) == 0 {
+			return
+		}
+		checkGoldenVMHookCalls(tb, goldenFilePath, recorder)
+	})
+
+	return executorwrapper.NewWrappedExecutorFactory(recorder, executorFactory)
+}
+
+// nextGoldenFilePath names the golden file after the test, numbering the hosts
+// built by the same test in order
+func nextGoldenFilePath(goldenDir string, testName string) string {
+	goldenFileCountersMut.Lock()
+	defer goldenFileCountersMut.Unlock()
+
+	goldenFileCounters[testName]++
+	fileName := strings.ReplaceAll(testName, "/", "__")
+	if goldenFileCounters[testName] > 1 {
+		fileName = fmt.Sprintf("%s_%d", fileName, goldenFileCounters[testName])
+	}
+
+	return filepath.Join(goldenDir, fileName+goldenFileExtension)
+}
+
+func checkGoldenVMHookCalls(tb testing.TB, goldenFilePath string, recorder *executorwrapper.GoldenRecorder) {
+	serialized, err := recorder.MarshalGolden()
+	require.Nil(tb, err)
+
+	expectedSerialized, err := os.ReadFile(goldenFilePath)
+	if os.IsNotExist(err) || os.Getenv(GoldenUpdateEnvVar) == "true" {
+		err = os.MkdirAll(filepath.Dir(goldenFilePath), 0755)
+		require.Nil(tb, err)
+		err = os.WriteFile(goldenFilePath, serialized, 0644)
+		require.Nil(tb, err)
+		return
+	}
+	require.Nil(tb, err)
+
+	expectedCalls, err := executorwrapper.UnmarshalGoldenCalls(expectedSerialized)
+	require.Nil(tb, err)
+	requireSameVMHookCalls(tb, goldenFilePath, expectedCalls, recorder.Calls())
+}
+
+func requireSameVMHookCalls(tb testing.TB, goldenFilePath string, expected []*executorwrapper.VMHookCall, actual []*executorwrapper.VMHookCall) {
+	for index := 0; index < len(expected) && index < len(actual); index++ {
+		require.Equal(tb, expected[index], actual[index], "VM hook call %d differs from %s", index, goldenFilePath)
+	}
+	require.Equal(tb, len(expected), len(actual), "number of VM hook calls differs from %s", goldenFilePath)
+}
@@ -37,6 +37,7 @@ type testTemplateConfig struct {
 	input                    *vmcommon.ContractCallInput
 	useMocks                 bool
 	wasmerSIGSEGVPassthrough bool
+	enableEpochsHandler      vmhost.EnableEpochsHandler
 }
 
 // MockInstancesTestTemplate holds the data to build a mock contract call test
@@ -83,6 +84,12 @@ func (callerTest *MockInstancesTestTemplate) WithWasmerSIGSEGVPassthrough(wasmer
 	return callerTest
 }
 
+// WithEnableEpochsHandler sets the flags of the VM used by the mock contract call test
+func (callerTest *MockInstancesTestTemplate) WithEnableEpochsHandler(enableEpochsHandler vmhost.EnableEpochsHandler) *MockInstancesTestTemplate {
+	callerTest.enableEpochsHandler = enableEpochsHandler
+	return callerTest
+}
+
 // AndAssertResults provides the function that will aserts the results
 func (callerTest *MockInstancesTestTemplate) AndAssertResults(assertResults AssertResultsFunc) (*vmcommon.VMOutput, error) {
 	return callerTest.andAssertResultsWithWorld(nil, true, nil, RunTest, nil, func(startNode *TestCallNode, world *worldmock.MockWorld, verify *VMOutputVerifier, expectedErrorsForRound []string) {
@@ -154,10 +161,13 @@ func (callerTest *MockInstancesTestTemplate) RunTest(
 	world.AcctMap.CreateAccount(UserAddress, world)
 
 	executorFactory := mock.NewExecutorMockFactory(world)
-	host := NewTestHostBuilder(callerTest.tb).
+	hostBuilder := NewTestHostBuilder(callerTest.tb).
 		WithExecutorFactory(executorFactory).
-		WithBlockchainHook(world).
-		Build()
+		WithBlockchainHook(world)
+	if callerTest.enableEpochsHandler != nil {
+		hostBuilder.WithEnableEpochsHandler(callerTest.enableEpochsHandler)
+	}
+	host := hostBuilder.Build()
 
 	defer func() {
 		host.Reset()
@@ -11,6 +11,7 @@ import (
 	"github.com/multiversx/mx-chain-vm-go/config"
 	"github.com/multiversx/mx-chain-vm-go/executor"
 	executorwrapper "github.com/multiversx/mx-chain-vm-go/executor/wrapper"
+	mockworld "github.com/multiversx/mx-chain-vm-go/mock/world"
 	"github.com/multiversx/mx-chain-vm-go/testcommon/testexecutor"
 	"github.com/multiversx/mx-chain-vm-go/vmhost"
 	"github.com/multiversx/mx-chain-vm-go/vmhost/hostCore"
@@ -39,7 +40,7 @@ func NewTestHostBuilder(tb testing.TB) *TestHostBuilder {
 			ProtectedKeyPrefix:        []byte("E" + "L" + "R" + "O" + "N" + "D"),
 			ESDTTransferParser:        esdtTransferParser,
 			EpochNotifier:             &mock.EpochNotifierStub{},
-			EnableEpochsHandler:       worldmock.EnableEpochsHandlerStubAllFlags(),
+			EnableEpochsHandler:       mockworld.EnableEpochsHandlerStubDefaultFlags(),
 			OverrideVMExecutor:        nil,
 			WasmerSIGSEGVPassthrough:  false,
 			Hasher:                    defaultHasher,
@@ -69,6 +70,13 @@ func (thb *TestHostBuilder) WithBlockchainHook(blockchainHook vmcommon.Blockchai
 	return thb
 }
 
+// WithEnableEpochsHandler sets the flags of the VM, overriding the default ones which leave
+// mockworld.OptInFlags disabled.
+func (thb *TestHostBuilder) WithEnableEpochsHandler(enableEpochsHandler vmhost.EnableEpochsHandler) *TestHostBuilder {
+	thb.vmHostParameters.EnableEpochsHandler = enableEpochsHandler
+	return thb
+}
+
 // WithBuiltinFunctions sets up builtin functions in the blockchain hook.
 // Only works if the blockchain hook is of type worldmock.MockWorld.
 func (thb *TestHostBuilder) WithBuiltinFunctions() *TestHostBuilder {
@@ -130,6 +138,7 @@ func (thb *TestHostBuilder) newHost() vmhost.VMHost {
 		exec := testexecutor.NewDefaultTestExecutorFactory(thb.tb)
 		thb.vmHostParameters.OverrideVMExecutor = exec
 	}
+	thb.vmHostParameters.OverrideVMExecutor = wrapWithGoldenRecorder(thb.tb, thb.vmHostParameters.OverrideVMExecutor)
 
 	thb.initializeBuiltInFuncContainer()
 	host, err := hostCore.NewVMHost(
@@ -23,6 +23,11 @@ type AsyncCall struct {
 	CallbackClosure []byte
 
 	IsBuiltinFunctionCall bool
+
+	// DeadlineRound and DeadlineTimestamp bound the time the caller waits for
+	// the callback; zero means no deadline
+	DeadlineRound     uint64
+	DeadlineTimestamp uint64
 }
 
 // Clone creates a deep clone of the AsyncCall
@@ -38,6 +43,9 @@ func (ac *AsyncCall) Clone() *AsyncCall {
 		ValueBytes:      make([]byte, len(ac.ValueBytes)),
 		SuccessCallback: ac.SuccessCallback,
 		ErrorCallback:   ac.ErrorCallback,
+
+		DeadlineRound:     ac.DeadlineRound,
+		DeadlineTimestamp: ac.DeadlineTimestamp,
 	}
 
 	copy(clone.Destination, ac.Destination)
@@ -118,6 +126,25 @@ func (ac *AsyncCall) Reject() {
 	ac.Status = AsyncCallRejected
 }
 
+// TimeOut sets the timed out status for this async call
+func (ac *AsyncCall) TimeOut() {
+	ac.Status = AsyncCallTimedOut
+}
+
+// HasDeadline returns true if the async call defines a deadline in rounds or as a timestamp
+func (ac *AsyncCall) HasDeadline() bool {
+	return ac.DeadlineRound > 0 || ac.DeadlineTimestamp > 0
+}
+
+// IsDeadlineExpired returns true if the given round or timestamp is past the deadline of the async call
+func (ac *AsyncCall) IsDeadlineExpired(round uint64, timestamp uint64) bool {
+	if ac.DeadlineRound > 0 && round > ac.DeadlineRound {
+		return true
+	}
+
+	return ac.DeadlineTimestamp > 0 && timestamp > ac.DeadlineTimestamp
+}
+
 // GetCallbackName returns the name of the callback to execute, depending on
 // the status of the async call
 func (ac *AsyncCall) GetCallbackName() string {
@@ -146,6 +173,9 @@ func (ac *AsyncCall) toSerializable() *SerializableAsyncCall {
 		SuccessCallback: ac.SuccessCallback,
 		ErrorCallback:   ac.ErrorCallback,
 		CallbackClosure: ac.CallbackClosure,
+
+		DeadlineRound:     ac.DeadlineRound,
+		DeadlineTimestamp: ac.DeadlineTimestamp,
 	}
 }
 
@@ -170,5 +200,8 @@ func (serAsyncCall *SerializableAsyncCall) fromSerializable() *AsyncCall {
 		SuccessCallback: serAsyncCall.SuccessCallback,
 		ErrorCallback:   serAsyncCall.ErrorCallback,
 		CallbackClosure: serAsyncCall.CallbackClosure,
+
+		DeadlineRound:     serAsyncCall.DeadlineRound,
+		DeadlineTimestamp: serAsyncCall.DeadlineTimestamp,
 	}
 }
@@ -33,18 +33,21 @@ const (
 	SerializableAsyncCallPending  SerializableAsyncCallStatus = 0
 	SerializableAsyncCallResolved SerializableAsyncCallStatus = 1
 	SerializableAsyncCallRejected SerializableAsyncCallStatus = 2
+	SerializableAsyncCallTimedOut SerializableAsyncCallStatus = 3
 )
 
 var SerializableAsyncCallStatus_name = map[int32]string{
 	0: "SerializableAsyncCallPending",
 	1: "SerializableAsyncCallResolved",
 	2: "SerializableAsyncCallRejected",
+	3: "SerializableAsyncCallTimedOut",
 }
 
 var SerializableAsyncCallStatus_value = map[string]int32{
 	"SerializableAsyncCallPending":  0,
 	"SerializableAsyncCallResolved": 1,
 	"SerializableAsyncCallRejected": 2,
+	"SerializableAsyncCallTimedOut": 3,
 }
 
 func (SerializableAsyncCallStatus) EnumDescriptor() ([]byte, []int) {
@@ -79,17 +82,19 @@ func (SerializableAsyncCallExecutionMode) EnumDescriptor() ([]byte, []int) {
 }
 
 type SerializableAsyncCall struct {
-	CallID          []byte                             `protobuf:"bytes,1,opt,name=CallID,proto3" json:"CallID,omitempty"`
-	Status          SerializableAsyncCallStatus        `protobuf:"varint,2,opt,name=Status,proto3,enum=vmhost.SerializableAsyncCallStatus" json:"Status,omitempty"`
-	ExecutionMode   SerializableAsyncCallExecutionMode `protobuf:"varint,3,opt,name=ExecutionMode,proto3,enum=vmhost.SerializableAsyncCallExecutionMode" json:"ExecutionMode,omitempty"`
-	Destination     []byte                             `protobuf:"bytes,5,opt,name=Destination,proto3" json:"Destination,omitempty"`
-	Data            []byte                             `protobuf:"bytes,6,opt,name=Data,proto3" json:"Data,omitempty"`
-	GasLimit        uint64                             `protobuf:"varint,7,opt,name=GasLimit,proto3" json:"GasLimit,omitempty"`
-	GasLocked       uint64                             `protobuf:"varint,8,opt,name=GasLocked,proto3" json:"GasLocked,omitempty"`
-	ValueBytes      []byte                             `protobuf:"bytes,9,opt,name=ValueBytes,proto3" json:"ValueBytes,omitempty"`
-	SuccessCallback string                             `protobuf:"bytes,10,opt,name=SuccessCallback,proto3" json:"SuccessCallback,omitempty"`
-	ErrorCallback   string                             `protobuf:"bytes,11,opt,name=ErrorCallback,proto3" json:"ErrorCallback,omitempty"`
-	CallbackClosure []byte                             `protobuf:"bytes,12,opt,name=CallbackClosure,proto3" json:"CallbackClosure,omitempty"`
+	CallID            []byte                             `protobuf:"bytes,1,opt,name=CallID,proto3" json:"CallID,omitempty"`
+	Status            SerializableAsyncCallStatus        `protobuf:"varint,2,opt,name=Status,proto3,enum=vmhost.SerializableAsyncCallStatus" json:"Status,omitempty"`
+	ExecutionMode     SerializableAsyncCallExecutionMode `protobuf:"varint,3,opt,name=ExecutionMode,proto3,enum=vmhost.SerializableAsyncCallExecutionMode" json:"ExecutionMode,omitempty"`
+	Destination       []byte                             `protobuf:"bytes,5,opt,name=Destination,proto3" json:"Destination,omitempty"`
+	Data              []byte                             `protobuf:"bytes,6,opt,name=Data,proto3" json:"Data,omitempty"`
+	GasLimit          uint64                             `protobuf:"varint,7,opt,name=GasLimit,proto3" json:"GasLimit,omitempty"`
+	GasLocked         uint64                             `protobuf:"varint,8,opt,name=GasLocked,proto3" json:"GasLocked,omitempty"`
+	ValueBytes        []byte                             `protobuf:"bytes,9,opt,name=ValueBytes,proto3" json:"ValueBytes,omitempty"`
+	SuccessCallback   string                             `protobuf:"bytes,10,opt,name=SuccessCallback,proto3" json:"SuccessCallback,omitempty"`
+	ErrorCallback     string                             `protobuf:"bytes,11,opt,name=ErrorCallback,proto3" json:"ErrorCallback,omitempty"`
+	CallbackClosure   []byte                             `protobuf:"bytes,12,opt,name=CallbackClosure,proto3" json:"CallbackClosure,omitempty"`
+	DeadlineRound     uint64                             `protobuf:"varint,13,opt,name=DeadlineRound,proto3" json:"DeadlineRound,omitempty"`
+	DeadlineTimestamp uint64                             `protobuf:"varint,14,opt,name=DeadlineTimestamp,proto3" json:"DeadlineTimestamp,omitempty"`
 }
 
 func (m *SerializableAsyncCall) Reset()      { *m = SerializableAsyncCall{} }
@@ -197,6 +202,20 @@ func (m *SerializableAsyncCall) GetCallbackClosure() []byte {
 	return nil
 }
 
+func (m *SerializableAsyncCall) GetDeadlineRound() uint64 {
+	if m != nil {
+		return m.DeadlineRound
+	}
+	return 0
+}
+
+func (m *SerializableAsyncCall) GetDeadlineTimestamp() uint64 {
+	if m != nil {
+		return m.DeadlineTimestamp
+	}
+	return 0
+}
+
 type SerializableAsyncCallGroup struct {
 	Callback     string                   `protobuf:"bytes,1,opt,name=Callback,proto3" json:"Callback,omitempty"`
 	GasLocked    uint64                   `protobuf:"varint,2,opt,name=GasLocked,proto3" json:"GasLocked,omitempty"`
@@ -278,43 +297,45 @@ func init() {
 func init() { proto.RegisterFile("asyncCall.proto", fileDescriptor_a0e9b586d6e1f667) }
 
 var fileDescriptor_a0e9b586d6e1f667 = []byte{
-	// 564 bytes of a gzipped FileDescriptorProto
+	// 605 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcd, 0x6e, 0x13, 0x31,
-	0x10, 0x5e, 0x27, 0x6d, 0x68, 0xa7, 0x2d, 0x8d, 0x2c, 0x81, 0x4c, 0x69, 0xad, 0x25, 0x20, 0xb4,
-	0x8a, 0x44, 0x2a, 0x95, 0x23, 0x42, 0x82, 0xfe, 0x50, 0x55, 0x02, 0xa9, 0xda, 0x08, 0x0e, 0xdc,
-	0x9c, 0x5d, 0x37, 0x35, 0xd9, 0xd8, 0xd5, 0xda, 0x5b, 0x08, 0x27, 0x2e, 0xdc, 0x79, 0x0c, 0x5e,
-	0x81, 0x37, 0xe8, 0x31, 0xc7, 0x70, 0x23, 0x9b, 0x0b, 0xc7, 0x3e, 0x02, 0x5a, 0x27, 0x59, 0x92,
-	0x34, 0x4a, 0x4f, 0x3b, 0xf3, 0xcd, 0x37, 0xdf, 0x37, 0x9e, 0xb5, 0x0c, 0x9b, 0x4c, 0x77, 0x64,
-	0x70, 0xc0, 0xa2, 0xa8, 0x76, 0x11, 0x2b, 0xa3, 0x70, 0xe9, 0xb2, 0x7d, 0xae, 0xb4, 0xd9, 0x7a,
-	0xd6, 0x14, 0xe6, 0x3c, 0x69, 0xd4, 0x02, 0xd5, 0xde, 0x6d, 0xaa, 0xa6, 0xda, 0xb5, 0xe5, 0x46,
-	0x72, 0x66, 0x33, 0x9b, 0xd8, 0x68, 0xd8, 0x56, 0xb9, 0x2a, 0xc2, 0xbd, 0x3a, 0x8f, 0x05, 0x8b,
-	0xc4, 0x57, 0xd6, 0x88, 0xf8, 0xeb, 0xb1, 0x2c, 0xbe, 0x0f, 0xa5, 0xec, 0x7b, 0x72, 0x48, 0x90,
-	0x8b, 0xbc, 0x75, 0x7f, 0x94, 0xe1, 0x17, 0x50, 0xaa, 0x1b, 0x66, 0x12, 0x4d, 0x0a, 0x2e, 0xf2,
-	0xee, 0xee, 0x3d, 0xae, 0x0d, 0x9d, 0x6b, 0x73, 0x65, 0x86, 0x54, 0x7f, 0xd4, 0x82, 0x4f, 0x61,
-	0xe3, 0xe8, 0x0b, 0x0f, 0x12, 0x23, 0x94, 0x7c, 0xa7, 0x42, 0x4e, 0x8a, 0x56, 0xa3, 0xba, 0x50,
-	0x63, 0xaa, 0xc3, 0x9f, 0x16, 0xc0, 0x2e, 0xac, 0x1d, 0x72, 0x6d, 0x84, 0x64, 0x19, 0x44, 0x96,
-	0xed, 0xac, 0x93, 0x10, 0xc6, 0xb0, 0x74, 0xc8, 0x0c, 0x23, 0x25, 0x5b, 0xb2, 0x31, 0xde, 0x82,
-	0x95, 0x63, 0xa6, 0xdf, 0x8a, 0xb6, 0x30, 0xe4, 0x8e, 0x8b, 0xbc, 0x25, 0x3f, 0xcf, 0xf1, 0x36,
-	0xac, 0x66, 0xb1, 0x0a, 0x5a, 0x3c, 0x24, 0x2b, 0xb6, 0xf8, 0x1f, 0xc0, 0x14, 0xe0, 0x03, 0x8b,
-	0x12, 0xbe, 0xdf, 0x31, 0x5c, 0x93, 0x55, 0xab, 0x39, 0x81, 0x60, 0x0f, 0x36, 0xeb, 0x49, 0x10,
-	0x70, 0xad, 0xb3, 0xd1, 0x1b, 0x2c, 0x68, 0x11, 0x70, 0x91, 0xb7, 0xea, 0xcf, 0xc2, 0xf8, 0x09,
-	0x6c, 0x1c, 0xc5, 0xb1, 0x8a, 0x73, 0xde, 0x9a, 0xe5, 0x4d, 0x83, 0x99, 0xde, 0x38, 0x3e, 0x88,
-	0x94, 0x4e, 0x62, 0x4e, 0xd6, 0xad, 0xe9, 0x2c, 0x5c, 0xf9, 0x8d, 0x60, 0x6b, 0xee, 0xfe, 0x8e,
-	0x63, 0x95, 0x5c, 0x64, 0x47, 0xce, 0x9d, 0x90, 0x75, 0xca, 0xf3, 0xe9, 0x23, 0x17, 0x66, 0x8f,
-	0x5c, 0x81, 0xf5, 0x31, 0xd3, 0x2e, 0xb2, 0x68, 0xfd, 0xa7, 0xb0, 0x6c, 0x2d, 0x27, 0x21, 0x97,
-	0x46, 0x9c, 0x09, 0x1e, 0x93, 0x25, 0xab, 0x3f, 0x81, 0xe0, 0x97, 0x00, 0xf9, 0x3c, 0x9a, 0x2c,
-	0xbb, 0x45, 0x6f, 0x6d, 0x6f, 0x67, 0xe1, 0x5f, 0xf7, 0x27, 0x1a, 0xaa, 0xdf, 0x11, 0x3c, 0x5c,
-	0x70, 0xbf, 0xb0, 0x0b, 0xdb, 0x73, 0xcb, 0xa7, 0x5c, 0x86, 0x42, 0x36, 0xcb, 0x0e, 0x7e, 0x04,
-	0x3b, 0xf3, 0x6d, 0xb8, 0x56, 0xd1, 0x25, 0x0f, 0xcb, 0x68, 0x01, 0xe5, 0x13, 0x0f, 0x0c, 0x0f,
-	0xcb, 0x85, 0xea, 0x2f, 0x04, 0x95, 0xdb, 0xef, 0x28, 0xde, 0x81, 0x07, 0x93, 0xac, 0x7a, 0x47,
-	0x06, 0x39, 0xa1, 0xec, 0xe0, 0x2a, 0x3c, 0xbd, 0x21, 0xb2, 0x9f, 0x88, 0xc8, 0x08, 0xf9, 0x26,
-	0x91, 0xc1, 0x89, 0x34, 0x31, 0xab, 0x9f, 0xb3, 0x38, 0x1b, 0xea, 0x16, 0xee, 0x41, 0xac, 0xb4,
-	0x1e, 0x72, 0x0b, 0x78, 0x1b, 0xc8, 0x0d, 0xee, 0x7b, 0xd9, 0x92, 0xea, 0xb3, 0x2c, 0x17, 0xf7,
-	0x5f, 0x75, 0xfb, 0xd4, 0xe9, 0xf5, 0xa9, 0x73, 0xdd, 0xa7, 0xe8, 0x5b, 0x4a, 0xd1, 0xcf, 0x94,
-	0xa2, 0xab, 0x94, 0xa2, 0x6e, 0x4a, 0x51, 0x2f, 0xa5, 0xe8, 0x4f, 0x4a, 0xd1, 0xdf, 0x94, 0x3a,
-	0xd7, 0x29, 0x45, 0x3f, 0x06, 0xd4, 0xe9, 0x0e, 0xa8, 0xd3, 0x1b, 0x50, 0xe7, 0xe3, 0xe8, 0x6d,
-	0x69, 0x94, 0xec, 0x9b, 0xf1, 0xfc, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xde, 0x7d, 0x58, 0x3d,
-	0x7d, 0x04, 0x00, 0x00,
+	0x10, 0x5e, 0x27, 0x69, 0x68, 0xa7, 0x7f, 0xc1, 0x12, 0xc8, 0x94, 0xd6, 0x5a, 0x02, 0x42, 0x51,
+	0x04, 0xa9, 0x54, 0x8e, 0x08, 0x09, 0xda, 0x94, 0xaa, 0x12, 0x88, 0x6a, 0x03, 0x1c, 0xb8, 0x39,
+	0xbb, 0x6e, 0x6a, 0xba, 0xb1, 0xab, 0xb5, 0x5d, 0x28, 0x27, 0x1e, 0x81, 0x07, 0xe0, 0x01, 0xfa,
+	0x0a, 0xbc, 0x01, 0xc7, 0x1e, 0xcb, 0x8d, 0x6e, 0x2f, 0x1c, 0xfb, 0x08, 0x68, 0x9d, 0x26, 0x64,
+	0x9b, 0x90, 0x9e, 0x32, 0xf3, 0xcd, 0x37, 0x9f, 0xbf, 0xcc, 0x78, 0x0d, 0x8b, 0x4c, 0x1f, 0xc9,
+	0x70, 0x83, 0xc5, 0x71, 0xe3, 0x20, 0x51, 0x46, 0xe1, 0xf2, 0x61, 0x77, 0x4f, 0x69, 0xb3, 0xf4,
+	0xb8, 0x23, 0xcc, 0x9e, 0x6d, 0x37, 0x42, 0xd5, 0x5d, 0xed, 0xa8, 0x8e, 0x5a, 0x75, 0xe5, 0xb6,
+	0xdd, 0x75, 0x99, 0x4b, 0x5c, 0xd4, 0x6b, 0xab, 0x7e, 0x2f, 0xc1, 0xad, 0x16, 0x4f, 0x04, 0x8b,
+	0xc5, 0x17, 0xd6, 0x8e, 0xf9, 0x8b, 0xbe, 0x2c, 0xbe, 0x0d, 0xe5, 0xec, 0x77, 0xbb, 0x49, 0x90,
+	0x8f, 0x6a, 0x73, 0xc1, 0x65, 0x86, 0x9f, 0x42, 0xb9, 0x65, 0x98, 0xb1, 0x9a, 0x14, 0x7c, 0x54,
+	0x5b, 0x58, 0xbb, 0xdf, 0xe8, 0x9d, 0xdc, 0x18, 0x2b, 0xd3, 0xa3, 0x06, 0x97, 0x2d, 0x78, 0x07,
+	0xe6, 0x37, 0x3f, 0xf3, 0xd0, 0x1a, 0xa1, 0xe4, 0x6b, 0x15, 0x71, 0x52, 0x74, 0x1a, 0xf5, 0x89,
+	0x1a, 0xb9, 0x8e, 0x20, 0x2f, 0x80, 0x7d, 0x98, 0x6d, 0x72, 0x6d, 0x84, 0x64, 0x19, 0x44, 0xa6,
+	0x9c, 0xd7, 0x61, 0x08, 0x63, 0x28, 0x35, 0x99, 0x61, 0xa4, 0xec, 0x4a, 0x2e, 0xc6, 0x4b, 0x30,
+	0xbd, 0xc5, 0xf4, 0x2b, 0xd1, 0x15, 0x86, 0xdc, 0xf0, 0x51, 0xad, 0x14, 0x0c, 0x72, 0xbc, 0x0c,
+	0x33, 0x59, 0xac, 0xc2, 0x7d, 0x1e, 0x91, 0x69, 0x57, 0xfc, 0x07, 0x60, 0x0a, 0xf0, 0x9e, 0xc5,
+	0x96, 0xaf, 0x1f, 0x19, 0xae, 0xc9, 0x8c, 0xd3, 0x1c, 0x42, 0x70, 0x0d, 0x16, 0x5b, 0x36, 0x0c,
+	0xb9, 0xd6, 0x99, 0xf5, 0x36, 0x0b, 0xf7, 0x09, 0xf8, 0xa8, 0x36, 0x13, 0x5c, 0x85, 0xf1, 0x03,
+	0x98, 0xdf, 0x4c, 0x12, 0x95, 0x0c, 0x78, 0xb3, 0x8e, 0x97, 0x07, 0x33, 0xbd, 0x7e, 0xbc, 0x11,
+	0x2b, 0x6d, 0x13, 0x4e, 0xe6, 0xdc, 0xa1, 0x57, 0xe1, 0x4c, 0xaf, 0xc9, 0x59, 0x14, 0x0b, 0xc9,
+	0x03, 0x65, 0x65, 0x44, 0xe6, 0x9d, 0xf7, 0x3c, 0x88, 0x1f, 0xc1, 0xcd, 0x3e, 0xf0, 0x56, 0x74,
+	0xb9, 0x36, 0xac, 0x7b, 0x40, 0x16, 0x1c, 0x73, 0xb4, 0x50, 0xfd, 0x85, 0x60, 0x69, 0xec, 0x4e,
+	0xb6, 0x12, 0x65, 0x0f, 0xb2, 0x31, 0x0e, 0xdc, 0x23, 0xe7, 0x7e, 0x90, 0xe7, 0xc7, 0x58, 0xb8,
+	0x3a, 0xc6, 0x2a, 0xcc, 0xf5, 0x99, 0x6e, 0x39, 0x45, 0xf7, 0x9f, 0x72, 0x58, 0x36, 0xea, 0xed,
+	0x88, 0x4b, 0x23, 0x76, 0x05, 0x4f, 0x48, 0xc9, 0xe9, 0x0f, 0x21, 0xf8, 0x19, 0xc0, 0xc0, 0x8f,
+	0x26, 0x53, 0x7e, 0xb1, 0x36, 0xbb, 0xb6, 0x32, 0xf1, 0x26, 0x05, 0x43, 0x0d, 0xf5, 0x63, 0x04,
+	0x77, 0x27, 0xdc, 0x59, 0xec, 0xc3, 0xf2, 0xd8, 0xf2, 0x0e, 0x97, 0x91, 0x90, 0x9d, 0x8a, 0x87,
+	0xef, 0xc1, 0xca, 0xf8, 0x63, 0xb8, 0x56, 0xf1, 0x21, 0x8f, 0x2a, 0x68, 0x02, 0xe5, 0x23, 0x0f,
+	0x0d, 0x8f, 0x2a, 0x85, 0xff, 0x52, 0xb2, 0x2d, 0x44, 0x6f, 0xac, 0xa9, 0x14, 0xeb, 0x3f, 0x10,
+	0x54, 0xaf, 0xff, 0x34, 0xf0, 0x0a, 0xdc, 0x19, 0x66, 0xb5, 0x8e, 0x64, 0x38, 0x20, 0x54, 0x3c,
+	0x5c, 0x87, 0x87, 0x23, 0x22, 0xeb, 0x56, 0xc4, 0x46, 0xc8, 0x97, 0x56, 0x86, 0xdb, 0xd2, 0x24,
+	0xac, 0xb5, 0xc7, 0x92, 0xcc, 0xf7, 0x35, 0xdc, 0x8d, 0x44, 0x69, 0xdd, 0xe3, 0x16, 0xf0, 0x32,
+	0x90, 0x11, 0xee, 0x3b, 0xb9, 0x2f, 0xd5, 0x27, 0x59, 0x29, 0xae, 0x3f, 0x3f, 0x39, 0xa3, 0xde,
+	0xe9, 0x19, 0xf5, 0x2e, 0xce, 0x28, 0xfa, 0x9a, 0x52, 0x74, 0x9c, 0x52, 0xf4, 0x33, 0xa5, 0xe8,
+	0x24, 0xa5, 0xe8, 0x34, 0xa5, 0xe8, 0x77, 0x4a, 0xd1, 0x9f, 0x94, 0x7a, 0x17, 0x29, 0x45, 0xdf,
+	0xce, 0xa9, 0x77, 0x72, 0x4e, 0xbd, 0xd3, 0x73, 0xea, 0x7d, 0xb8, 0x7c, 0xd2, 0xda, 0x65, 0xf7,
+	0x54, 0x3d, 0xf9, 0x3b, 0x00, 0xff, 0x19, 0x0f, 0x3e, 0xf4, 0x04, 0x00, 0x00,
 }
 
 func (x SerializableAsyncCallStatus) String() string {
@@ -383,6 +404,12 @@ func (this *SerializableAsyncCall) Equal(that interface{}) bool {
 	if !bytes.Equal(this.CallbackClosure, that1.CallbackClosure) {
 		return false
 	}
+	if this.DeadlineRound != that1.DeadlineRound {
+		return false
+	}
+	if this.DeadlineTimestamp != that1.DeadlineTimestamp {
+		return false
+	}
 	return true
 }
 func (this *SerializableAsyncCallGroup) Equal(that interface{}) bool {
@@ -430,7 +457,7 @@ func (this *SerializableAsyncCall) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 15)
+	s := make([]string, 0, 17)
 	s = append(s, "&vmhost.SerializableAsyncCall{")
 	s = append(s, "CallID: "+fmt.Sprintf("%#v", this.CallID)+",\n")
 	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
@@ -443,6 +470,8 @@ func (this *SerializableAsyncCall) GoString() string {
 	s = append(s, "SuccessCallback: "+fmt.Sprintf("%#v", this.SuccessCallback)+",\n")
 	s = append(s, "ErrorCallback: "+fmt.Sprintf("%#v", this.ErrorCallback)+",\n")
 	s = append(s, "CallbackClosure: "+fmt.Sprintf("%#v", this.CallbackClosure)+",\n")
+	s = append(s, "DeadlineRound: "+fmt.Sprintf("%#v", this.DeadlineRound)+",\n")
+	s = append(s, "DeadlineTimestamp: "+fmt.Sprintf("%#v", this.DeadlineTimestamp)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -490,6 +519,16 @@ func (m *SerializableAsyncCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DeadlineTimestamp != 0 {
+		i = encodeVarintAsyncCall(dAtA, i, uint64(m.DeadlineTimestamp))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.DeadlineRound != 0 {
+		i = encodeVarintAsyncCall(dAtA, i, uint64(m.DeadlineRound))
+		i--
+		dAtA[i] = 0x68
+	}
 	if len(m.CallbackClosure) > 0 {
 		i -= len(m.CallbackClosure)
 		copy(dAtA[i:], m.CallbackClosure)
@@ -682,6 +721,12 @@ func (m *SerializableAsyncCall) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAsyncCall(uint64(l))
 	}
+	if m.DeadlineRound != 0 {
+		n += 1 + sovAsyncCall(uint64(m.DeadlineRound))
+	}
+	if m.DeadlineTimestamp != 0 {
+		n += 1 + sovAsyncCall(uint64(m.DeadlineTimestamp))
+	}
 	return n
 }
 
@@ -737,6 +782,8 @@ func (this *SerializableAsyncCall) String() string {
 		`SuccessCallback:` + fmt.Sprintf("%v", this.SuccessCallback) + `,`,
 		`ErrorCallback:` + fmt.Sprintf("%v", this.ErrorCallback) + `,`,
 		`CallbackClosure:` + fmt.Sprintf("%v", this.CallbackClosure) + `,`,
+		`DeadlineRound:` + fmt.Sprintf("%v", this.DeadlineRound) + `,`,
+		`DeadlineTimestamp:` + fmt.Sprintf("%v", this.DeadlineTimestamp) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1107,6 +1154,44 @@ func (m *SerializableAsyncCall) Unmarshal(dAtA []byte) error {
 				m.CallbackClosure = []byte{}
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineRound", wireType)
+			}
+			m.DeadlineRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAsyncCall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineTimestamp", wireType)
+			}
+			m.DeadlineTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAsyncCall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAsyncCall(dAtA[iNdEx:])
@@ -0,0 +1,107 @@
+package vmhost
+
+import "bytes"
+
+// AsyncGraphEdgeType is the kind of call represented by an AsyncGraphEdge
+type AsyncGraphEdgeType int
+
+const (
+	// AsyncGraphSyncCall is a synchronous call executed on the destination context
+	AsyncGraphSyncCall AsyncGraphEdgeType = iota
+	// AsyncGraphAsyncCall is an async call executed in the same shard
+	AsyncGraphAsyncCall
+	// AsyncGraphAsyncCrossShardCall is an async call sent to another shard
+	AsyncGraphAsyncCrossShardCall
+	// AsyncGraphCallback is the callback of an async call, executed in the same shard
+	AsyncGraphCallback
+	// AsyncGraphCallbackCrossShard is the callback of an async call, received from another shard
+	AsyncGraphCallbackCrossShard
+)
+
+// AsyncGraphNode is a contract call observed during execution
+type AsyncGraphNode struct {
+	ID       int
+	Address  []byte
+	Function string
+	CallID   []byte
+
+	IsStartNode bool
+	Executed    bool
+	Fail        bool
+
+	GasLimit       uint64
+	GasRemaining   uint64
+	GasAccumulated uint64
+
+	AdjacentEdges []*AsyncGraphEdge
+}
+
+// GasUsed returns the gas consumed by the call, including its children
+func (node *AsyncGraphNode) GasUsed() uint64 {
+	if node.GasRemaining > node.GasLimit {
+		return 0
+	}
+	return node.GasLimit - node.GasRemaining
+}
+
+// AsyncGraphEdge is a call from one AsyncGraphNode to another
+type AsyncGraphEdge struct {
+	Type     AsyncGraphEdgeType
+	To       *AsyncGraphNode
+	GroupID  string
+	Callback string
+
+	GasLimit  uint64
+	GasLocked uint64
+}
+
+// AsyncExecutionGraph holds the calls observed during one or more executions,
+// together with the sync calls, async calls and callbacks connecting them
+type AsyncExecutionGraph struct {
+	Nodes      []*AsyncGraphNode
+	StartNodes []*AsyncGraphNode
+}
+
+// NewAsyncExecutionGraph creates an empty AsyncExecutionGraph
+func NewAsyncExecutionGraph() *AsyncExecutionGraph {
+	return &AsyncExecutionGraph{
+		Nodes:      make([]*AsyncGraphNode, 0),
+		StartNodes: make([]*AsyncGraphNode, 0),
+	}
+}
+
+// AddNode adds a new node to the graph
+func (graph *AsyncExecutionGraph) AddNode(address []byte, function string, callID []byte) *AsyncGraphNode {
+	node := &AsyncGraphNode{
+		ID:            len(graph.Nodes) + 1,
+		Address:       address,
+		Function:      function,
+		CallID:        callID,
+		AdjacentEdges: make([]*AsyncGraphEdge, 0),
+	}
+	graph.Nodes = append(graph.Nodes, node)
+	return node
+}
+
+// AddEdge connects two nodes of the graph
+func (graph *AsyncExecutionGraph) AddEdge(from *AsyncGraphNode, to *AsyncGraphNode, edgeType AsyncGraphEdgeType) *AsyncGraphEdge {
+	edge := &AsyncGraphEdge{
+		Type: edgeType,
+		To:   to,
+	}
+	from.AdjacentEdges = append(from.AdjacentEdges, edge)
+	return edge
+}
+
+// FindNodeByCallID returns the most recently added node with the given call ID, or nil
+func (graph *AsyncExecutionGraph) FindNodeByCallID(callID []byte) *AsyncGraphNode {
+	if len(callID) == 0 {
+		return nil
+	}
+	for i := len(graph.Nodes) - 1; i >= 0; i-- {
+		if bytes.Equal(graph.Nodes[i].CallID, callID) {
+			return graph.Nodes[i]
+		}
+	}
+	return nil
+}
@@ -106,6 +106,29 @@ func (acg *AsyncCallGroup) DeleteCompletedAsyncCalls() {
 	acg.AsyncCalls = remainingAsyncCalls
 }
 
+// PendingCallbacks returns the names of the callbacks that the caller contract
+// must still export, for the pending AsyncCalls and for the group itself
+func (acg *AsyncCallGroup) PendingCallbacks() []string {
+	callbacks := make([]string, 0)
+	for _, asyncCall := range acg.AsyncCalls {
+		if asyncCall.Status != AsyncCallPending {
+			continue
+		}
+		if asyncCall.SuccessCallback != "" {
+			callbacks = append(callbacks, asyncCall.SuccessCallback)
+		}
+		if asyncCall.ErrorCallback != "" {
+			callbacks = append(callbacks, asyncCall.ErrorCallback)
+		}
+	}
+
+	if acg.HasCallback() {
+		callbacks = append(callbacks, acg.Callback)
+	}
+
+	return callbacks
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (acg *AsyncCallGroup) IsInterfaceNil() bool {
 	return acg == nil
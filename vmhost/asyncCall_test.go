@@ -0,0 +1,68 @@
+package vmhost
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsyncCall_IsDeadlineExpired(t *testing.T) {
+	t.Parallel()
+
+	asyncCall := &AsyncCall{}
+	require.False(t, asyncCall.HasDeadline())
+	require.False(t, asyncCall.IsDeadlineExpired(100, 100))
+
+	asyncCall.DeadlineRound = 10
+	require.True(t, asyncCall.HasDeadline())
+	require.False(t, asyncCall.IsDeadlineExpired(10, 100))
+	require.True(t, asyncCall.IsDeadlineExpired(11, 0))
+
+	asyncCall = &AsyncCall{DeadlineTimestamp: 1000}
+	require.True(t, asyncCall.HasDeadline())
+	require.False(t, asyncCall.IsDeadlineExpired(100, 1000))
+	require.True(t, asyncCall.IsDeadlineExpired(0, 1001))
+}
+
+func TestAsyncCall_TimeOut(t *testing.T) {
+	t.Parallel()
+
+	asyncCall := &AsyncCall{Status: AsyncCallPending}
+	asyncCall.TimeOut()
+	require.Equal(t, AsyncCallTimedOut, asyncCall.Status)
+}
+
+func TestAsyncCall_SerializationKeepsDeadline(t *testing.T) {
+	t.Parallel()
+
+	asyncCall := &AsyncCall{
+		CallID:            []byte("callID"),
+		Status:            AsyncCallTimedOut,
+		Destination:       []byte("destination"),
+		DeadlineRound:     10,
+		DeadlineTimestamp: 1000,
+	}
+
+	serializable := asyncCall.toSerializable()
+	require.Equal(t, SerializableAsyncCallTimedOut, serializable.Status)
+
+	deserialized := fromSerializableAsyncCalls([]*SerializableAsyncCall{serializable})[0]
+	require.Equal(t, asyncCall.Status, deserialized.Status)
+	require.Equal(t, asyncCall.DeadlineRound, deserialized.DeadlineRound)
+	require.Equal(t, asyncCall.DeadlineTimestamp, deserialized.DeadlineTimestamp)
+	require.Equal(t, asyncCall.DeadlineRound, asyncCall.Clone().DeadlineRound)
+}
+
+func TestAsyncCallGroup_PendingCallbacks(t *testing.T) {
+	t.Parallel()
+
+	group := NewAsyncCallGroup("group")
+	require.Empty(t, group.PendingCallbacks())
+
+	group.AddAsyncCall(&AsyncCall{Status: AsyncCallPending, SuccessCallback: "succ", ErrorCallback: "err"})
+	group.AddAsyncCall(&AsyncCall{Status: AsyncCallResolved, SuccessCallback: "resolved"})
+	group.AddAsyncCall(&AsyncCall{Status: AsyncCallPending})
+	group.Callback = "groupCallback"
+
+	require.Equal(t, []string{"succ", "err", "groupCallback"}, group.PendingCallbacks())
+}
@@ -0,0 +1,68 @@
+package vmhost
+
+import (
+	"fmt"
+	"sort"
+)
+
+// CodeLimits holds the limits enforced on contract code at deployment and upgrade, zero meaning no limit
+type CodeLimits struct {
+	MaxCodeSize         uint64
+	MaxFunctions        uint32
+	MaxImports          uint32
+	MaxTableSize        uint32
+	MaxDataSegmentsSize uint64
+}
+
+// EpochCodeLimits holds the code limits enforced starting with an epoch
+type EpochCodeLimits struct {
+	EnableEpoch uint32
+	Limits      CodeLimits
+}
+
+// CodeValidationPolicy holds the code limits of each epoch, so that they can be tightened in time
+type CodeValidationPolicy struct {
+	limitsByEpoch []EpochCodeLimits
+}
+
+// NewCodeValidationPolicy creates a new CodeValidationPolicy
+func NewCodeValidationPolicy(limitsByEpoch []EpochCodeLimits) (*CodeValidationPolicy, error) {
+	sortedLimits := make([]EpochCodeLimits, len(limitsByEpoch))
+	copy(sortedLimits, limitsByEpoch)
+	sort.Slice(sortedLimits, func(i, j int) bool {
+		return sortedLimits[i].EnableEpoch < sortedLimits[j].EnableEpoch
+	})
+
+	for i := 1; i < len(sortedLimits); i++ {
+		if sortedLimits[i].EnableEpoch == sortedLimits[i-1].EnableEpoch {
+			return nil, fmt.Errorf("%w: %d", ErrDuplicateCodeLimitsEpoch, sortedLimits[i].EnableEpoch)
+		}
+	}
+
+	return &CodeValidationPolicy{
+		limitsByEpoch: sortedLimits,
+	}, nil
+}
+
+// LimitsForEpoch returns the code limits enforced in the epoch, if any
+func (policy *CodeValidationPolicy) LimitsForEpoch(epoch uint32) (CodeLimits, bool) {
+	if policy == nil {
+		return CodeLimits{}, false
+	}
+
+	for i := len(policy.limitsByEpoch) - 1; i >= 0; i-- {
+		if policy.limitsByEpoch[i].EnableEpoch <= epoch {
+			return policy.limitsByEpoch[i].Limits, true
+		}
+	}
+
+	return CodeLimits{}, false
+}
+
+// HasModuleLimits returns true if any limit requires decoding the module, i.e. any limit other than the code size
+func (limits CodeLimits) HasModuleLimits() bool {
+	return limits.MaxFunctions > 0 ||
+		limits.MaxImports > 0 ||
+		limits.MaxTableSize > 0 ||
+		limits.MaxDataSegmentsSize > 0
+}
@@ -0,0 +1,49 @@
+package vmhost
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCodeValidationPolicy_DuplicateEpoch(t *testing.T) {
+	t.Parallel()
+
+	policy, err := NewCodeValidationPolicy([]EpochCodeLimits{
+		{EnableEpoch: 3},
+		{EnableEpoch: 3},
+	})
+	require.Nil(t, policy)
+	require.ErrorIs(t, err, ErrDuplicateCodeLimitsEpoch)
+}
+
+func TestCodeValidationPolicy_LimitsForEpoch(t *testing.T) {
+	t.Parallel()
+
+	looseLimits := CodeLimits{MaxCodeSize: 1000}
+	tightLimits := CodeLimits{MaxCodeSize: 500, MaxImports: 10}
+	policy, err := NewCodeValidationPolicy([]EpochCodeLimits{
+		{EnableEpoch: 10, Limits: tightLimits},
+		{EnableEpoch: 5, Limits: looseLimits},
+	})
+	require.Nil(t, err)
+
+	_, hasLimits := policy.LimitsForEpoch(4)
+	require.False(t, hasLimits)
+
+	limits, hasLimits := policy.LimitsForEpoch(5)
+	require.True(t, hasLimits)
+	require.Equal(t, looseLimits, limits)
+	require.False(t, limits.HasModuleLimits())
+
+	limits, _ = policy.LimitsForEpoch(9)
+	require.Equal(t, looseLimits, limits)
+
+	limits, _ = policy.LimitsForEpoch(100)
+	require.Equal(t, tightLimits, limits)
+	require.True(t, limits.HasModuleLimits())
+
+	var nilPolicy *CodeValidationPolicy
+	_, hasLimits = nilPolicy.LimitsForEpoch(100)
+	require.False(t, hasLimits)
+}
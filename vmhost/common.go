@@ -92,6 +92,10 @@ const (
 
 	// DeployFromSourceString is the human-readable label for transfer type
 	DeployFromSourceString = "DeployFromSource"
+
+	// AsyncCallTimedOutString is the identifier of the log entry marking a callback
+	// which arrived after the deadline of its async call
+	AsyncCallTimedOutString = "asyncCallTimedOut"
 )
 
 // String returns the human-readable name of a BreakpointValue
@@ -145,6 +149,17 @@ const TimeLockKeyPrefix = "TIMELOCK"
 // AsyncDataPrefix is the storage key prefix used for AsyncContext-related storage.
 const AsyncDataPrefix = "ASYNC"
 
+// StorageUsedKey is the storage key holding the number of storage bytes used by a contract.
+const StorageUsedKey = "STORAGE_USED"
+
+// StorageDepositKey is the storage key holding the storage deposit paid by a contract.
+const StorageDepositKey = "STORAGE_DEPOSIT"
+
+// AsyncCallTimeoutReturnCode is the return code received by the error callback
+// of an async call whose deadline passed; it is distinct from all the return
+// codes a destination call can produce.
+const AsyncCallTimeoutReturnCode = vmcommon.ReturnCode(100)
+
 // AsyncCallStatus represents the different status an async call can have
 type AsyncCallStatus uint8
 
@@ -162,6 +177,9 @@ const (
 	// AsyncCallRejected is the status of an async call that was executed completely but unsuccessfully
 	AsyncCallRejected
 
+	// AsyncCallTimedOut is the status of an async call whose deadline passed before it was executed or sent
+	AsyncCallTimedOut
+
 	// AddressLen specifies the length of the address
 	AddressLen = 32
 
@@ -210,6 +228,10 @@ type VMHostParameters struct {
 	Hasher                              HashComputer
 	TimeOutForSCExecutionInMilliseconds uint32
 	MapOpcodeAddressIsAllowed           map[string]map[string]struct{}
+	CodeValidationPolicy                *CodeValidationPolicy
+	LogLimits                           LogLimits
+	QueryGasLimit                       uint64
+	StorageDepositAddress               []byte
 }
 
 // AsyncCallInfo contains the information required to handle the asynchronous call of another SmartContract
@@ -530,6 +530,8 @@ func (context *asyncContext) RegisterAsyncCall(groupID string, call *vmhost.Asyn
 		return err
 	}
 
+	context.host.AsyncCallGraphTracer().TraceRegisterAsyncCall(groupID, call)
+
 	return nil
 }
 
@@ -675,11 +677,20 @@ func (context *asyncContext) Execute() error {
 
 	if context.HasPendingCallGroups() {
 		logAsync.Trace("async.Execute() begin", "gas left", gasLeft, "gas acc", context.gasAccumulated)
+		logAsync.Trace("async.Execute() time out expired calls")
+
+		// Step 0: AsyncCalls whose deadline has already passed are neither
+		// executed nor sent, but complete directly with their error callback
+		err := context.executeExpiredAsyncCalls()
+		if err != nil {
+			return err
+		}
+
 		logAsync.Trace("async.Execute() execute locals")
 
 		// Step 1: execute all AsyncCalls that can be executed synchronously
 		// (includes smart contracts and built-in functions in the same shard)
-		err := context.executeAsyncLocalCalls()
+		err = context.executeAsyncLocalCalls()
 		if err != nil {
 			return err
 		}
@@ -759,6 +770,7 @@ func (context *asyncContext) UpdateCurrentAsyncCallStatus(
 	// The first argument of the callback is the return code of the destination call
 	destReturnCode := big.NewInt(0).SetBytes(vmInput.Arguments[0]).Uint64()
 	call.UpdateStatus(vmcommon.ReturnCode(destReturnCode))
+	context.markLateCrossShardCallback(call, vmInput)
 
 	return call, loadedContext.HasLegacyGroup(), nil
 }
@@ -802,7 +814,13 @@ func (context *asyncContext) executeAsyncCall(asyncCall *vmhost.AsyncCall) error
 		return nil
 	}
 
-	return context.sendAsyncCallCrossShard(asyncCall)
+	err := context.sendAsyncCallCrossShard(asyncCall)
+	if err != nil {
+		return err
+	}
+
+	context.host.AsyncCallGraphTracer().TraceAsyncCallExecution(asyncCall)
+	return nil
 }
 
 func (context *asyncContext) computeGasLockForLegacyAsyncCall() (uint64, error) {
@@ -919,6 +937,34 @@ func (context *asyncContext) getContextFromStack(address []byte, callID []byte)
 	return loadedContext
 }
 
+// GetPendingCallbacks returns the callbacks that the AsyncContexts of the given
+// address, the current one and those on the stack, still have to call
+func (context *asyncContext) GetPendingCallbacks(address []byte) []string {
+	callbacks := make([]string, 0)
+	if bytes.Equal(context.address, address) {
+		callbacks = append(callbacks, context.pendingCallbacks()...)
+	}
+	for _, stackContext := range context.stateStack {
+		if bytes.Equal(stackContext.address, address) {
+			callbacks = append(callbacks, stackContext.pendingCallbacks()...)
+		}
+	}
+
+	return callbacks
+}
+
+func (context *asyncContext) pendingCallbacks() []string {
+	callbacks := make([]string, 0)
+	for _, group := range context.asyncCallGroups {
+		callbacks = append(callbacks, group.PendingCallbacks()...)
+	}
+	if context.HasCallback() {
+		callbacks = append(callbacks, context.callback)
+	}
+
+	return callbacks
+}
+
 func (context *asyncContext) determineExecutionMode(call *vmhost.AsyncCall) (vmhost.AsyncCallExecutionMode, error) {
 	runtime := context.host.Runtime()
 	blockchain := context.host.Blockchain()
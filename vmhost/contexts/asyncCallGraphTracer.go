@@ -0,0 +1,305 @@
+package contexts
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+var _ vmhost.AsyncCallGraphTracer = (*asyncCallGraphTracer)(nil)
+var _ vmhost.AsyncCallGraphTracer = (*disabledAsyncCallGraphTracer)(nil)
+
+type asyncRegistration struct {
+	groupID   string
+	asyncCall *vmhost.AsyncCall
+}
+
+type announcedCall struct {
+	from     *vmhost.AsyncGraphNode
+	edgeType vmhost.AsyncGraphEdgeType
+	groupID  string
+	callback string
+	gasLimit uint64
+	gasLock  uint64
+}
+
+// asyncCallGraphTracer builds the execution graph of sync calls, async calls
+// and callbacks from the events emitted by the host and the async context
+type asyncCallGraphTracer struct {
+	graph         *vmhost.AsyncExecutionGraph
+	callStack     []*vmhost.AsyncGraphNode
+	registrations map[*vmhost.AsyncGraphNode][]*asyncRegistration
+	announced     *announcedCall
+}
+
+// NewAsyncCallGraphTracer creates a new asyncCallGraphTracer
+func NewAsyncCallGraphTracer() *asyncCallGraphTracer {
+	return &asyncCallGraphTracer{
+		graph:         vmhost.NewAsyncExecutionGraph(),
+		callStack:     make([]*vmhost.AsyncGraphNode, 0),
+		registrations: make(map[*vmhost.AsyncGraphNode][]*asyncRegistration),
+	}
+}
+
+// NewDisabledAsyncCallGraphTracer creates a new disabledAsyncCallGraphTracer
+func NewDisabledAsyncCallGraphTracer() *disabledAsyncCallGraphTracer {
+	return &disabledAsyncCallGraphTracer{}
+}
+
+// BeginTransaction adds the node of a top-level call; cross-shard async calls
+// and callbacks are connected to the nodes which sent them, when present
+func (tracer *asyncCallGraphTracer) BeginTransaction(input *vmcommon.ContractCallInput) {
+	tracer.callStack = tracer.callStack[:0]
+	tracer.announced = nil
+
+	var node *vmhost.AsyncGraphNode
+	switch input.CallType {
+	case vm.AsynchronousCall:
+		node = tracer.graph.FindNodeByCallID(asyncArgumentsCallID(input))
+		if node != nil && node.Executed {
+			node = nil
+		}
+	case vm.AsynchronousCallBack:
+		if input.AsyncArguments != nil {
+			asyncNode := tracer.graph.FindNodeByCallID(input.AsyncArguments.CallerCallID)
+			if asyncNode != nil {
+				node = tracer.graph.AddNode(input.RecipientAddr, input.Function, asyncArgumentsCallID(input))
+				tracer.graph.AddEdge(asyncNode, node, vmhost.AsyncGraphCallbackCrossShard)
+			}
+		}
+	}
+
+	if node == nil {
+		node = tracer.graph.AddNode(input.RecipientAddr, input.Function, asyncArgumentsCallID(input))
+		node.IsStartNode = true
+		tracer.graph.StartNodes = append(tracer.graph.StartNodes, node)
+	}
+
+	tracer.startNode(node, input)
+}
+
+// EndTransaction completes the node of the top-level call
+func (tracer *asyncCallGraphTracer) EndTransaction(vmOutput *vmcommon.VMOutput) {
+	if len(tracer.callStack) == 0 {
+		return
+	}
+	tracer.endNode(tracer.callStack[0], vmOutput, nil)
+	tracer.callStack = tracer.callStack[:0]
+	tracer.announced = nil
+}
+
+// BeginCall adds the node of a call executed on the destination context,
+// connected to its caller by the previously announced async call or callback,
+// or by a sync call otherwise
+func (tracer *asyncCallGraphTracer) BeginCall(input *vmcommon.ContractCallInput) {
+	node := tracer.graph.AddNode(input.RecipientAddr, input.Function, asyncArgumentsCallID(input))
+
+	announced := tracer.announced
+	tracer.announced = nil
+	switch {
+	case announced != nil:
+		edge := tracer.graph.AddEdge(announced.from, node, announced.edgeType)
+		edge.GroupID = announced.groupID
+		edge.Callback = announced.callback
+		edge.GasLimit = announced.gasLimit
+		edge.GasLocked = announced.gasLock
+	case len(tracer.callStack) > 0:
+		edge := tracer.graph.AddEdge(tracer.currentNode(), node, vmhost.AsyncGraphSyncCall)
+		edge.GasLimit = input.GasProvided
+	default:
+		node.IsStartNode = true
+		tracer.graph.StartNodes = append(tracer.graph.StartNodes, node)
+	}
+
+	tracer.startNode(node, input)
+}
+
+// EndCall completes the node of the call on top of the stack
+func (tracer *asyncCallGraphTracer) EndCall(vmOutput *vmcommon.VMOutput, err error) {
+	if len(tracer.callStack) == 0 {
+		return
+	}
+	node := tracer.currentNode()
+	tracer.callStack = tracer.callStack[:len(tracer.callStack)-1]
+	tracer.endNode(node, vmOutput, err)
+}
+
+// TraceRegisterAsyncCall remembers the group of an async call registered by the current call
+func (tracer *asyncCallGraphTracer) TraceRegisterAsyncCall(groupID string, asyncCall *vmhost.AsyncCall) {
+	node := tracer.currentNode()
+	if node == nil {
+		return
+	}
+	tracer.registrations[node] = append(tracer.registrations[node], &asyncRegistration{
+		groupID:   groupID,
+		asyncCall: asyncCall.Clone(),
+	})
+}
+
+// TraceAsyncCallExecution announces a local async call, which will be executed
+// next on the destination context, or adds the node of a cross-shard async call
+func (tracer *asyncCallGraphTracer) TraceAsyncCallExecution(asyncCall *vmhost.AsyncCall) {
+	from := tracer.currentNode()
+	if from == nil {
+		return
+	}
+
+	announced := &announcedCall{
+		from:     from,
+		edgeType: vmhost.AsyncGraphAsyncCall,
+		groupID:  tracer.consumeRegistrationGroup(from, asyncCall),
+		callback: asyncCall.SuccessCallback,
+		gasLimit: asyncCall.GasLimit,
+		gasLock:  asyncCall.GasLocked,
+	}
+	if asyncCall.IsRemote() {
+		announced.edgeType = vmhost.AsyncGraphAsyncCrossShardCall
+	}
+
+	if asyncCall.ExecutionMode == vmhost.AsyncUnknown {
+		node := tracer.graph.AddNode(asyncCall.Destination, functionFromCallData(asyncCall.Data), asyncCall.CallID)
+		node.GasLimit = asyncCall.GasLimit
+		edge := tracer.graph.AddEdge(from, node, announced.edgeType)
+		edge.GroupID = announced.groupID
+		edge.Callback = announced.callback
+		edge.GasLimit = announced.gasLimit
+		edge.GasLocked = announced.gasLock
+		return
+	}
+
+	tracer.announced = announced
+}
+
+// TraceCallbackExecution announces the callback of an async call, which will be executed next
+func (tracer *asyncCallGraphTracer) TraceCallbackExecution(asyncCall *vmhost.AsyncCall) {
+	from := tracer.graph.FindNodeByCallID(asyncCall.CallID)
+	edgeType := vmhost.AsyncGraphCallback
+	if from == nil {
+		from = tracer.currentNode()
+	} else if !from.Executed {
+		edgeType = vmhost.AsyncGraphCallbackCrossShard
+	}
+	if from == nil {
+		return
+	}
+
+	tracer.announced = &announcedCall{
+		from:     from,
+		edgeType: edgeType,
+		gasLock:  asyncCall.GasLocked,
+	}
+}
+
+// TraceChildComplete adds the gas returned by a completed async call to its node
+func (tracer *asyncCallGraphTracer) TraceChildComplete(callID []byte, gasAccumulated uint64) {
+	node := tracer.graph.FindNodeByCallID(callID)
+	if node == nil {
+		return
+	}
+	node.GasAccumulated += gasAccumulated
+}
+
+// GetExecutionGraph returns the graph built so far
+func (tracer *asyncCallGraphTracer) GetExecutionGraph() *vmhost.AsyncExecutionGraph {
+	return tracer.graph
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tracer *asyncCallGraphTracer) IsInterfaceNil() bool {
+	return tracer == nil
+}
+
+func (tracer *asyncCallGraphTracer) currentNode() *vmhost.AsyncGraphNode {
+	if len(tracer.callStack) == 0 {
+		return nil
+	}
+	return tracer.callStack[len(tracer.callStack)-1]
+}
+
+func (tracer *asyncCallGraphTracer) startNode(node *vmhost.AsyncGraphNode, input *vmcommon.ContractCallInput) {
+	node.Executed = true
+	node.GasLimit = input.GasProvided
+	tracer.callStack = append(tracer.callStack, node)
+}
+
+func (tracer *asyncCallGraphTracer) endNode(node *vmhost.AsyncGraphNode, vmOutput *vmcommon.VMOutput, err error) {
+	delete(tracer.registrations, node)
+	node.Fail = err != nil
+	if vmOutput == nil {
+		return
+	}
+	node.GasRemaining = vmOutput.GasRemaining
+	node.Fail = node.Fail || vmOutput.ReturnCode != vmcommon.Ok
+}
+
+func (tracer *asyncCallGraphTracer) consumeRegistrationGroup(node *vmhost.AsyncGraphNode, asyncCall *vmhost.AsyncCall) string {
+	registrations := tracer.registrations[node]
+	for i, registration := range registrations {
+		if !bytes.Equal(registration.asyncCall.Destination, asyncCall.Destination) ||
+			!bytes.Equal(registration.asyncCall.Data, asyncCall.Data) {
+			continue
+		}
+		tracer.registrations[node] = append(registrations[:i], registrations[i+1:]...)
+		return registration.groupID
+	}
+	return ""
+}
+
+func asyncArgumentsCallID(input *vmcommon.ContractCallInput) []byte {
+	if input.AsyncArguments == nil {
+		return nil
+	}
+	return input.AsyncArguments.CallID
+}
+
+func functionFromCallData(data []byte) string {
+	return strings.Split(string(data), "@")[0]
+}
+
+type disabledAsyncCallGraphTracer struct {
+}
+
+// BeginTransaction does nothing
+func (dt *disabledAsyncCallGraphTracer) BeginTransaction(_ *vmcommon.ContractCallInput) {
+}
+
+// EndTransaction does nothing
+func (dt *disabledAsyncCallGraphTracer) EndTransaction(_ *vmcommon.VMOutput) {
+}
+
+// BeginCall does nothing
+func (dt *disabledAsyncCallGraphTracer) BeginCall(_ *vmcommon.ContractCallInput) {
+}
+
+// EndCall does nothing
+func (dt *disabledAsyncCallGraphTracer) EndCall(_ *vmcommon.VMOutput, _ error) {
+}
+
+// TraceRegisterAsyncCall does nothing
+func (dt *disabledAsyncCallGraphTracer) TraceRegisterAsyncCall(_ string, _ *vmhost.AsyncCall) {
+}
+
+// TraceAsyncCallExecution does nothing
+func (dt *disabledAsyncCallGraphTracer) TraceAsyncCallExecution(_ *vmhost.AsyncCall) {
+}
+
+// TraceCallbackExecution does nothing
+func (dt *disabledAsyncCallGraphTracer) TraceCallbackExecution(_ *vmhost.AsyncCall) {
+}
+
+// TraceChildComplete does nothing
+func (dt *disabledAsyncCallGraphTracer) TraceChildComplete(_ []byte, _ uint64) {
+}
+
+// GetExecutionGraph returns nil
+func (dt *disabledAsyncCallGraphTracer) GetExecutionGraph() *vmhost.AsyncExecutionGraph {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dt *disabledAsyncCallGraphTracer) IsInterfaceNil() bool {
+	return dt == nil
+}
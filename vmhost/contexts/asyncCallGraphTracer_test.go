@@ -0,0 +1,150 @@
+package contexts
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	tracerCallerSC = []byte("caller__________________________")
+	tracerCalleeSC = []byte("callee__________________________")
+)
+
+func makeTracerCallInput(recipient []byte, function string, gas uint64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallType:    vm.DirectCall,
+			GasProvided: gas,
+		},
+		RecipientAddr: recipient,
+		Function:      function,
+	}
+}
+
+func TestAsyncCallGraphTracer_SyncCall(t *testing.T) {
+	t.Parallel()
+
+	tracer := NewAsyncCallGraphTracer()
+	tracer.BeginTransaction(makeTracerCallInput(tracerCallerSC, "forward", 1000))
+	tracer.BeginCall(makeTracerCallInput(tracerCalleeSC, "inc", 400))
+	tracer.EndCall(&vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: 100}, nil)
+	tracer.EndTransaction(&vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: 200})
+
+	graph := tracer.GetExecutionGraph()
+	require.Len(t, graph.Nodes, 2)
+	require.Len(t, graph.StartNodes, 1)
+
+	start := graph.StartNodes[0]
+	require.Equal(t, "forward", start.Function)
+	require.Equal(t, uint64(800), start.GasUsed())
+	require.Len(t, start.AdjacentEdges, 1)
+
+	edge := start.AdjacentEdges[0]
+	require.Equal(t, vmhost.AsyncGraphSyncCall, edge.Type)
+	require.Equal(t, uint64(400), edge.GasLimit)
+	require.Equal(t, "inc", edge.To.Function)
+	require.Equal(t, uint64(300), edge.To.GasUsed())
+	require.True(t, edge.To.Executed)
+	require.False(t, edge.To.Fail)
+}
+
+func TestAsyncCallGraphTracer_LocalAsyncCallWithCallback(t *testing.T) {
+	t.Parallel()
+
+	asyncCall := &vmhost.AsyncCall{
+		CallID:          []byte("callID"),
+		ExecutionMode:   vmhost.SyncExecution,
+		Destination:     tracerCalleeSC,
+		Data:            []byte("inc@01"),
+		GasLimit:        500,
+		GasLocked:       150,
+		SuccessCallback: "callBack",
+	}
+
+	tracer := NewAsyncCallGraphTracer()
+	tracer.BeginTransaction(makeTracerCallInput(tracerCallerSC, "forward", 1000))
+	tracer.TraceRegisterAsyncCall("group", asyncCall)
+
+	tracer.TraceAsyncCallExecution(asyncCall)
+	asyncInput := makeTracerCallInput(tracerCalleeSC, "inc", 500)
+	asyncInput.CallType = vm.AsynchronousCall
+	asyncInput.AsyncArguments = &vmcommon.AsyncArguments{CallID: asyncCall.CallID}
+	tracer.BeginCall(asyncInput)
+	tracer.EndCall(&vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil)
+
+	tracer.TraceCallbackExecution(asyncCall)
+	tracer.BeginCall(makeTracerCallInput(tracerCallerSC, "callBack", 150))
+	tracer.EndCall(&vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: 50}, nil)
+	tracer.TraceChildComplete(asyncCall.CallID, 50)
+	tracer.EndTransaction(&vmcommon.VMOutput{ReturnCode: vmcommon.Ok})
+
+	graph := tracer.GetExecutionGraph()
+	require.Len(t, graph.Nodes, 3)
+
+	start := graph.StartNodes[0]
+	require.Len(t, start.AdjacentEdges, 1)
+	asyncEdge := start.AdjacentEdges[0]
+	require.Equal(t, vmhost.AsyncGraphAsyncCall, asyncEdge.Type)
+	require.Equal(t, "group", asyncEdge.GroupID)
+	require.Equal(t, "callBack", asyncEdge.Callback)
+	require.Equal(t, uint64(150), asyncEdge.GasLocked)
+
+	asyncNode := asyncEdge.To
+	require.True(t, asyncNode.Fail)
+	require.Equal(t, uint64(50), asyncNode.GasAccumulated)
+	require.Len(t, asyncNode.AdjacentEdges, 1)
+	require.Equal(t, vmhost.AsyncGraphCallback, asyncNode.AdjacentEdges[0].Type)
+	require.Equal(t, "callBack", asyncNode.AdjacentEdges[0].To.Function)
+}
+
+func TestAsyncCallGraphTracer_CrossShardAsyncCall(t *testing.T) {
+	t.Parallel()
+
+	asyncCall := &vmhost.AsyncCall{
+		CallID:        []byte("callID"),
+		ExecutionMode: vmhost.AsyncUnknown,
+		Destination:   tracerCalleeSC,
+		Data:          []byte("inc@01"),
+		GasLimit:      500,
+	}
+
+	tracer := NewAsyncCallGraphTracer()
+	tracer.BeginTransaction(makeTracerCallInput(tracerCallerSC, "forward", 1000))
+	tracer.TraceAsyncCallExecution(asyncCall)
+	tracer.EndTransaction(&vmcommon.VMOutput{ReturnCode: vmcommon.Ok})
+
+	graph := tracer.GetExecutionGraph()
+	require.Len(t, graph.Nodes, 2)
+	remoteNode := graph.FindNodeByCallID(asyncCall.CallID)
+	require.NotNil(t, remoteNode)
+	require.False(t, remoteNode.Executed)
+	require.Equal(t, "inc", remoteNode.Function)
+
+	crossShardInput := makeTracerCallInput(tracerCalleeSC, "inc", 500)
+	crossShardInput.CallType = vm.AsynchronousCall
+	crossShardInput.AsyncArguments = &vmcommon.AsyncArguments{CallID: asyncCall.CallID}
+	tracer.BeginTransaction(crossShardInput)
+	tracer.EndTransaction(&vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: 100})
+
+	require.Len(t, graph.Nodes, 2)
+	require.Len(t, graph.StartNodes, 1)
+	require.True(t, remoteNode.Executed)
+	require.Equal(t, uint64(400), remoteNode.GasUsed())
+	require.Equal(t, vmhost.AsyncGraphAsyncCrossShardCall, graph.StartNodes[0].AdjacentEdges[0].Type)
+}
+
+func TestDisabledAsyncCallGraphTracer(t *testing.T) {
+	t.Parallel()
+
+	tracer := NewDisabledAsyncCallGraphTracer()
+	tracer.BeginTransaction(makeTracerCallInput(tracerCallerSC, "forward", 1000))
+	tracer.BeginCall(makeTracerCallInput(tracerCalleeSC, "inc", 400))
+	tracer.EndCall(nil, nil)
+	tracer.EndTransaction(nil)
+	require.Nil(t, tracer.GetExecutionGraph())
+	require.False(t, tracer.IsInterfaceNil())
+}
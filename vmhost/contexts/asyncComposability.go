@@ -41,6 +41,7 @@ func (context *asyncContext) CompleteChildConditional(isChildComplete bool, call
 	}
 	context.decrementCallsCounter()
 	context.accumulateGas(gasToAccumulate)
+	context.host.AsyncCallGraphTracer().TraceChildComplete(callID, gasToAccumulate)
 	if callID != nil {
 		err := context.DeleteAsyncCallAndCleanGroup(callID)
 		if err != nil {
@@ -0,0 +1,114 @@
+package contexts
+
+import (
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// isDeadlineExpired returns true if the deadlines of async calls are active
+// and the current block is past the deadline of the given async call
+func (context *asyncContext) isDeadlineExpired(asyncCall *vmhost.AsyncCall) bool {
+	if !asyncCall.HasDeadline() {
+		return false
+	}
+	if !context.host.EnableEpochsHandler().IsFlagEnabled(vmhost.AsyncCallDeadlinesFlag) {
+		return false
+	}
+
+	blockchain := context.host.Blockchain()
+	return asyncCall.IsDeadlineExpired(blockchain.CurrentRound(), blockchain.CurrentTimeStamp())
+}
+
+// executeExpiredAsyncCalls times out the pending async calls whose deadline
+// passed before they could be executed or sent to their destination
+func (context *asyncContext) executeExpiredAsyncCalls() error {
+	expiredCalls := make([]*vmhost.AsyncCall, 0)
+
+	for _, group := range context.asyncCallGroups {
+		for _, call := range group.AsyncCalls {
+			if call.Status != vmhost.AsyncCallPending || call.ExecutionMode == vmhost.ESDTTransferOnCallBack {
+				continue
+			}
+			if context.isDeadlineExpired(call) {
+				expiredCalls = append(expiredCalls, call)
+			}
+		}
+	}
+
+	for _, call := range expiredCalls {
+		err := context.timeOutAsyncCall(call)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// timeOutAsyncCall completes an expired async call without executing it, by
+// calling its error callback with the timeout return code; the callback receives
+// the gas locked for it together with the unused gas limit of the async call
+func (context *asyncContext) timeOutAsyncCall(asyncCall *vmhost.AsyncCall) error {
+	logAsync.Trace("timeOutAsyncCall",
+		"dest", string(asyncCall.Destination),
+		"deadline round", asyncCall.DeadlineRound,
+		"deadline timestamp", asyncCall.DeadlineTimestamp)
+
+	context.incrementCallsCounter()
+	asyncCall.CallID = context.generateNewCallID()
+	asyncCall.TimeOut()
+
+	// The gas limit was consumed in its entirety by addAsyncCall(), but the
+	// async call will never use it.
+	context.host.Metering().RestoreGas(asyncCall.GetGasLimit())
+
+	if !asyncCall.HasCallback() {
+		return context.completeChild(asyncCall.CallID, 0)
+	}
+
+	timeoutVMOutput := createTimeoutVMOutput(asyncCall)
+	isCallbackComplete, callbackVMOutput := context.ExecuteSyncCallbackAndFinishOutput(asyncCall, timeoutVMOutput, nil, 0, nil)
+	if callbackVMOutput == nil {
+		return vmhost.ErrAsyncNoOutputFromCallback
+	}
+
+	context.host.CompleteLogEntriesWithCallType(callbackVMOutput, vmhost.AsyncCallbackString)
+
+	if !isCallbackComplete {
+		return nil
+	}
+
+	callbackGasRemaining := callbackVMOutput.GasRemaining
+	callbackVMOutput.GasRemaining = 0
+	return context.completeChild(asyncCall.CallID, callbackGasRemaining)
+}
+
+// markLateCrossShardCallback marks the callback of a cross-shard async call which
+// arrives past its deadline with an asyncCallTimedOut log entry. The destination
+// call has already been executed and its value transfers are part of the callback,
+// so the callback still receives the real result of the destination call.
+func (context *asyncContext) markLateCrossShardCallback(asyncCall *vmhost.AsyncCall, vmInput *vmcommon.VMInput) {
+	if !context.isDeadlineExpired(asyncCall) {
+		return
+	}
+
+	logAsync.Trace("markLateCrossShardCallback",
+		"dest", string(asyncCall.Destination),
+		"deadline round", asyncCall.DeadlineRound,
+		"deadline timestamp", asyncCall.DeadlineTimestamp)
+
+	context.host.Output().WriteLogWithIdentifier(
+		context.address,
+		[][]byte{asyncCall.CallID, asyncCall.Destination},
+		[][]byte{vmInput.Arguments[0]},
+		[]byte(vmhost.AsyncCallTimedOutString),
+	)
+}
+
+func createTimeoutVMOutput(asyncCall *vmhost.AsyncCall) *vmcommon.VMOutput {
+	return &vmcommon.VMOutput{
+		ReturnCode:    vmhost.AsyncCallTimeoutReturnCode,
+		ReturnMessage: vmhost.ErrAsyncCallDeadlineExpired.Error(),
+		GasRemaining:  asyncCall.GetGasLimit(),
+	}
+}
@@ -19,7 +19,7 @@ func (context *asyncContext) executeAsyncLocalCalls() error {
 
 	for _, group := range context.asyncCallGroups {
 		for _, call := range group.AsyncCalls {
-			if call.IsLocal() {
+			if call.IsLocal() && call.Status != vmhost.AsyncCallTimedOut {
 				localCalls = append(localCalls, call)
 			}
 		}
@@ -58,6 +58,7 @@ func (context *asyncContext) executeAsyncLocalCall(asyncCall *vmhost.AsyncCall)
 	metering := context.host.Metering()
 	metering.RestoreGas(asyncCall.GetGasLimit())
 
+	context.host.AsyncCallGraphTracer().TraceAsyncCallExecution(asyncCall)
 	vmOutput, isComplete, err := context.host.ExecuteOnDestContext(destinationCallInput)
 	if vmOutput == nil {
 		return vmhost.ErrNilDestinationCallVMOutput
@@ -137,6 +138,7 @@ func (context *asyncContext) executeSyncCallback(
 		"gasLocked", callbackInput.GasLocked)
 
 	context.host.Metering().RestoreGas(asyncCall.GasLocked)
+	context.host.AsyncCallGraphTracer().TraceCallbackExecution(asyncCall)
 	callbackVMOutput, isComplete, callbackErr := context.host.ExecuteOnDestContext(callbackInput)
 	if callbackVMOutput != nil {
 		logAsync.Trace("async call: sync callback call",
@@ -173,6 +175,7 @@ func (context *asyncContext) executeSyncHalfOfBuiltinFunction(asyncCall *vmhost.
 	metering := context.host.Metering()
 	metering.RestoreGas(asyncCall.GetGasLimit())
 
+	context.host.AsyncCallGraphTracer().TraceAsyncCallExecution(asyncCall)
 	vmOutput, _, err := context.host.ExecuteOnDestContext(destinationCallInput)
 	if err != nil {
 		return err
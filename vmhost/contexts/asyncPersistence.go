@@ -8,6 +8,9 @@ import (
 	"github.com/multiversx/mx-chain-vm-go/vmhost"
 )
 
+const persistedContextsBatchSize = 100
+const getPersistedPendingCallbacksName = "getPersistedPendingCallbacks"
+
 // Save serializes and saves the AsyncContext to the storage of the contract, under a protected key.
 func (context *asyncContext) Save() error {
 	address := context.address
@@ -123,6 +126,67 @@ func deserializeAsyncContext(data []byte, marshalizer *marshal.GogoProtoMarshali
 	return fromSerializable(deserializedAsyncContext), nil
 }
 
+// PendingCallbacksOfPersistedContext returns the callbacks that an AsyncContext,
+// as persisted by Save in the storage of its contract, still has to call
+func PendingCallbacksOfPersistedContext(data []byte, marshalizer *marshal.GogoProtoMarshalizer) ([]string, error) {
+	loadedContext, err := deserializeAsyncContext(data, marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	return loadedContext.pendingCallbacks(), nil
+}
+
+// GetPersistedPendingCallbacks returns the callbacks that the AsyncContexts persisted in the
+// storage of the given address still have to call. Each persisted context is charged as a
+// storage load. ErrStorageIterationNotSupported is returned when StorageIterationFlag is not
+// active or the BlockchainHook cannot iterate the storage, as the persisted contexts can then
+// only be read by their call ID.
+func (context *asyncContext) GetPersistedPendingCallbacks(address []byte) ([]string, error) {
+	if !context.host.EnableEpochsHandler().IsFlagEnabled(vmhost.StorageIterationFlag) {
+		return nil, vmhost.ErrStorageIterationNotSupported
+	}
+
+	storage := context.host.Storage()
+	metering := context.host.Metering()
+	callbacks := make([]string, 0)
+
+	cursor := make([]byte, 0)
+	for {
+		keys, err := storage.GetVMProtectedStorageKeysFromAddress(getPersistedPendingCallbacksName, address, vmhost.AsyncDataPrefix, cursor, persistedContextsBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, key := range keys {
+			data, trieDepth, usedCache, err := storage.GetStorageFromAddressNoChecks(address, key)
+			if err != nil {
+				return nil, err
+			}
+
+			err = storage.UseGasForStorageLoad(
+				getPersistedPendingCallbacksName,
+				int64(trieDepth),
+				metering.GasSchedule().BaseOpsAPICost.StorageLoad,
+				usedCache)
+			if err != nil {
+				return nil, err
+			}
+
+			persistedCallbacks, err := PendingCallbacksOfPersistedContext(data, context.marshalizer)
+			if err != nil {
+				return nil, err
+			}
+			callbacks = append(callbacks, persistedCallbacks...)
+		}
+
+		if len(keys) < persistedContextsBatchSize {
+			return callbacks, nil
+		}
+		cursor = keys[len(keys)-1]
+	}
+}
+
 func (context *asyncContext) toSerializable() *SerializableAsyncContext {
 	return &SerializableAsyncContext{
 		Address:                      context.address,
@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-scenario-go/worldmock"
@@ -15,6 +16,7 @@ import (
 	"github.com/multiversx/mx-chain-vm-go/crypto/factory"
 	"github.com/multiversx/mx-chain-vm-go/executor"
 	contextmock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	mockworld "github.com/multiversx/mx-chain-vm-go/mock/world"
 	"github.com/multiversx/mx-chain-vm-go/testcommon/testexecutor"
 	"github.com/multiversx/mx-chain-vm-go/vmhost"
 	"github.com/multiversx/mx-chain-vm-go/vmhost/vmhooks"
@@ -273,6 +275,26 @@ func TestAsyncContext_RegisterAsyncCall_ExistingGroup(t *testing.T) {
 	require.True(t, async.HasPendingCallGroups())
 }
 
+func TestAsyncContext_RegisterAsyncCall_HostMockTracer(t *testing.T) {
+	host := &contextmock.VMHostMock{
+		RuntimeContext: &contextmock.RuntimeContextMock{
+			SCAddress: Alice,
+			VMInput:   &vmcommon.ContractCallInput{Function: "function"},
+		},
+		MeteringContext:          &contextmock.MeteringContextMock{GasLeftMock: 1000},
+		EnableEpochsHandlerField: &worldmock.EnableEpochsHandlerStub{},
+	}
+	host.StorageContext, _ = NewStorageContext(host, worldmock.NewMockWorld(), reservedTestPrefix)
+	async := makeAsyncContext(t, host, Alice)
+
+	err := async.RegisterAsyncCall("group", &vmhost.AsyncCall{
+		Destination: Bob,
+		GasLimit:    10,
+	})
+	require.Nil(t, err)
+	require.True(t, async.HasPendingCallGroups())
+}
+
 func TestAsyncContext_RegisterAsyncCall_ValidationAndFields(t *testing.T) {
 	// TODO execution mode
 	// TODO non-nil destination
@@ -1043,3 +1065,176 @@ func defaultOutputDataLengthAsArgs(asyncCall *vmhost.AsyncCall, vmOutput *vmcomm
 
 	return uint64(dataLength)
 }
+
+func TestAsyncContext_MarkLateCrossShardCallback(t *testing.T) {
+	host := &contextmock.VMHostMock{
+		RuntimeContext: &contextmock.RuntimeContextMock{},
+		EnableEpochsHandlerField: &worldmock.EnableEpochsHandlerStub{
+			IsFlagEnabledCalled: func(flag core.EnableEpochFlag) bool {
+				return flag == vmhost.AsyncCallDeadlinesFlag
+			},
+		},
+	}
+	world := worldmock.NewMockWorld()
+	world.CurrentBlockInfo = &worldmock.BlockInfo{BlockRound: 10}
+	host.BlockchainContext, _ = NewBlockchainContext(host, world)
+	outputCtx, _ := NewOutputContext(host)
+	host.OutputContext = outputCtx
+	host.StorageContext, _ = NewStorageContext(host, world, reservedTestPrefix)
+	async := makeAsyncContext(t, host, Alice)
+
+	asyncCall := &vmhost.AsyncCall{
+		CallID:          []byte("callID"),
+		Status:          vmhost.AsyncCallResolved,
+		Destination:     Bob,
+		SuccessCallback: "succ",
+		ErrorCallback:   "err",
+		DeadlineRound:   10,
+	}
+	arguments := [][]byte{{byte(vmcommon.Ok)}, []byte("result")}
+	vmInput := &vmcommon.VMInput{Arguments: arguments}
+
+	async.markLateCrossShardCallback(asyncCall, vmInput)
+	require.Empty(t, outputCtx.outputState.Logs)
+
+	// past the deadline, the callback keeps the result of the destination call
+	world.CurrentBlockInfo.BlockRound = 11
+	async.markLateCrossShardCallback(asyncCall, vmInput)
+	require.Equal(t, arguments, vmInput.Arguments)
+	require.Equal(t, vmhost.AsyncCallResolved, asyncCall.Status)
+	require.Equal(t, "succ", asyncCall.GetCallbackName())
+
+	require.Len(t, outputCtx.outputState.Logs, 1)
+	logEntry := outputCtx.outputState.Logs[0]
+	require.Equal(t, []byte(vmhost.AsyncCallTimedOutString), logEntry.Identifier)
+	require.Equal(t, Alice, logEntry.Address)
+	require.Equal(t, [][]byte{[]byte("callID"), Bob}, logEntry.Topics)
+	require.Equal(t, [][]byte{{byte(vmcommon.Ok)}}, logEntry.Data)
+}
+
+func TestAsyncContext_GetPendingCallbacks(t *testing.T) {
+	group := vmhost.NewAsyncCallGroup("group")
+	group.AddAsyncCall(&vmhost.AsyncCall{
+		Status:          vmhost.AsyncCallPending,
+		SuccessCallback: "succ",
+		ErrorCallback:   "err",
+	})
+
+	stackedAsync := &asyncContext{
+		address:         []byte("alice"),
+		asyncCallGroups: []*vmhost.AsyncCallGroup{group},
+	}
+	otherAsync := &asyncContext{
+		address:         []byte("bob"),
+		asyncCallGroups: []*vmhost.AsyncCallGroup{group},
+	}
+	async := &asyncContext{
+		address:         []byte("alice"),
+		callback:        "contextCallback",
+		asyncCallGroups: make([]*vmhost.AsyncCallGroup, 0),
+		stateStack:      []*asyncContext{stackedAsync, otherAsync},
+	}
+
+	require.Equal(t, []string{"contextCallback", "succ", "err"}, async.GetPendingCallbacks([]byte("alice")))
+	require.Equal(t, []string{"succ", "err"}, async.GetPendingCallbacks([]byte("bob")))
+	require.Empty(t, async.GetPendingCallbacks([]byte("carol")))
+}
+
+func TestAsyncContext_GetPersistedPendingCallbacks(t *testing.T) {
+	mockRuntime := &contextmock.RuntimeContextMock{}
+	host := &contextmock.VMHostMock{
+		RuntimeContext: mockRuntime,
+		EnableEpochsHandlerField: &worldmock.EnableEpochsHandlerStub{
+			IsFlagEnabledCalled: func(flag core.EnableEpochFlag) bool {
+				return flag == vmhost.StorageIterationFlag
+			},
+		},
+	}
+	meteringCtx, _ := NewMeteringContext(host, config.MakeGasMapForTests(), uint64(15000))
+	meteringCtx.gasForExecution = 1000
+	host.MeteringContext = meteringCtx
+	host.OutputContext, _ = NewOutputContext(host)
+	world := worldmock.NewMockWorld()
+
+	host.StorageContext, _ = NewStorageContext(host, mockworld.NewIterableMockWorld(world), reservedTestPrefix)
+	group := vmhost.NewAsyncCallGroup("group")
+	group.AddAsyncCall(&vmhost.AsyncCall{
+		Status:          vmhost.AsyncCallPending,
+		SuccessCallback: "succ",
+		ErrorCallback:   "err",
+	})
+	storedAsync := makeAsyncContext(t, host, Alice)
+	storedAsync.callID = []byte("callID")
+	storedAsync.asyncCallGroups = []*vmhost.AsyncCallGroup{group}
+	err := storedAsync.Save()
+	require.Nil(t, err)
+
+	async := makeAsyncContext(t, host, Alice)
+	callbacks, err := async.GetPersistedPendingCallbacks(Alice)
+	require.Nil(t, err)
+	require.Equal(t, []string{"succ", "err"}, callbacks)
+
+	// the saved context is still a pending StorageUpdate, so it is charged as a cached load
+	expectedGas := meteringCtx.GasSchedule().BaseOpsAPICost.CachedStorageLoad
+	require.Equal(t, expectedGas, mockRuntime.GetPointsUsed())
+
+	callbacks, err = async.GetPersistedPendingCallbacks(Bob)
+	require.Nil(t, err)
+	require.Empty(t, callbacks)
+
+	mockRuntime.SetPointsUsed(0)
+	meteringCtx.gasForExecution = expectedGas - 1
+	callbacks, err = async.GetPersistedPendingCallbacks(Alice)
+	require.Nil(t, callbacks)
+	require.Equal(t, vmhost.ErrNotEnoughGas, err)
+}
+
+func TestAsyncContext_GetPersistedPendingCallbacks_IterationNotSupported(t *testing.T) {
+	enableEpochsHandler := &worldmock.EnableEpochsHandlerStub{}
+	host := &contextmock.VMHostMock{
+		RuntimeContext:           &contextmock.RuntimeContextMock{},
+		MeteringContext:          &contextmock.MeteringContextMock{},
+		EnableEpochsHandlerField: enableEpochsHandler,
+	}
+	host.OutputContext, _ = NewOutputContext(host)
+	host.StorageContext, _ = NewStorageContext(host, mockworld.NewIterableMockWorld(worldmock.NewMockWorld()), reservedTestPrefix)
+	async := makeAsyncContext(t, host, Alice)
+
+	callbacks, err := async.GetPersistedPendingCallbacks(Alice)
+	require.Nil(t, callbacks)
+	require.Equal(t, vmhost.ErrStorageIterationNotSupported, err)
+
+	enableEpochsHandler.IsFlagEnabledCalled = func(flag core.EnableEpochFlag) bool {
+		return flag == vmhost.StorageIterationFlag
+	}
+	host.StorageContext, _ = NewStorageContext(host, &contextmock.BlockchainHookStub{}, reservedTestPrefix)
+	callbacks, err = async.GetPersistedPendingCallbacks(Alice)
+	require.Nil(t, callbacks)
+	require.Equal(t, vmhost.ErrStorageIterationNotSupported, err)
+}
+
+func TestAsyncContext_PendingCallbacksOfPersistedContext(t *testing.T) {
+	group := vmhost.NewAsyncCallGroup("group")
+	group.Callback = "groupCallback"
+	group.AddAsyncCall(&vmhost.AsyncCall{
+		Status:          vmhost.AsyncCallPending,
+		SuccessCallback: "callBack",
+		ErrorCallback:   "callBack",
+	})
+	async := &asyncContext{
+		address:         []byte("alice"),
+		callID:          []byte("callID"),
+		asyncCallGroups: []*vmhost.AsyncCallGroup{group},
+	}
+
+	data, err := marshalizer.Marshal(async.toSerializable())
+	require.Nil(t, err)
+
+	callbacks, err := PendingCallbacksOfPersistedContext(data, marshalizer)
+	require.Nil(t, err)
+	require.Equal(t, []string{"callBack", "callBack", "groupCallback"}, callbacks)
+
+	callbacks, err = PendingCallbacksOfPersistedContext([]byte{0xff}, marshalizer)
+	require.Nil(t, callbacks)
+	require.NotNil(t, err)
+}
@@ -2,6 +2,9 @@ package contexts
 
 import (
 	"fmt"
+	"math/big"
+	"sort"
+
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -192,6 +195,63 @@ func (context *meteringContext) UpdateGasStateOnFailure(_ *vmcommon.VMOutput) {
 	logMetering.Trace("UpdateGasStateOnFailure", "instance gas left", context.GasLeft())
 }
 
+// ApplyGasRefund returns to the caller the gas refunded for releasing storage,
+// capped at a fraction of the gas used by the transaction. The refund is moved
+// from the GasUsed of the output accounts, starting with the called contract,
+// into the GasRemaining of the VMOutput. The GasRefund of the VMOutput is then
+// reset, so that the refund is not credited a second time.
+func (context *meteringContext) ApplyGasRefund(vmOutput *vmcommon.VMOutput) {
+	if !context.host.EnableEpochsHandler().IsFlagEnabled(vmhost.StorageGasRefundFlag) {
+		return
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok || vmOutput.GasRefund == nil || !vmOutput.GasRefund.IsUint64() {
+		return
+	}
+
+	addresses := make([]string, 0, len(vmOutput.OutputAccounts))
+	totalGasUsed := uint64(0)
+	for address, account := range vmOutput.OutputAccounts {
+		addresses = append(addresses, address)
+		totalGasUsed = math.AddUint64(totalGasUsed, account.GasUsed)
+	}
+
+	contractAddress := string(context.host.Runtime().GetContextAddress())
+	sort.Slice(addresses, func(i, j int) bool {
+		if addresses[i] == contractAddress || addresses[j] == contractAddress {
+			return addresses[i] == contractAddress
+		}
+		return addresses[i] < addresses[j]
+	})
+
+	refund := vmOutput.GasRefund.Uint64()
+	maxRefund := totalGasUsed
+	if context.gasSchedule.BaseOperationCost.MaxGasRefundQuotient > 0 {
+		maxRefund = totalGasUsed / context.gasSchedule.BaseOperationCost.MaxGasRefundQuotient
+	}
+	if refund > maxRefund {
+		refund = maxRefund
+	}
+
+	refundLeft := refund
+	for _, address := range addresses {
+		account := vmOutput.OutputAccounts[address]
+		accountRefund := refundLeft
+		if accountRefund > account.GasUsed {
+			accountRefund = account.GasUsed
+		}
+
+		account.GasUsed -= accountRefund
+		refundLeft -= accountRefund
+		if refundLeft == 0 {
+			break
+		}
+	}
+
+	vmOutput.GasRemaining = math.AddUint64(vmOutput.GasRemaining, refund)
+	vmOutput.GasRefund = big.NewInt(0)
+	logMetering.Trace("ApplyGasRefund", "refund", refund, "gas remaining", vmOutput.GasRemaining)
+}
+
 func (context *meteringContext) updateSCGasUsed() {
 	runtime := context.host.Runtime()
 	output := context.host.Output()
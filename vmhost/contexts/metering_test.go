@@ -4,7 +4,9 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/multiversx/mx-chain-vm-go/config"
 	"github.com/multiversx/mx-chain-vm-go/math"
@@ -95,6 +97,58 @@ func TestMeteringContext_FreeGas(t *testing.T) {
 	require.Equal(t, gasToFree+moreGasToFree, gasRefunded)
 }
 
+func TestMeteringContext_ApplyGasRefund(t *testing.T) {
+	t.Parallel()
+	const BlockGasLimit = uint64(15000)
+
+	isRefundFlagEnabled := true
+	host := &contextmock.VMHostMock{
+		RuntimeContext: &contextmock.RuntimeContextMock{SCAddress: []byte("sc")},
+		EnableEpochsHandlerField: &worldmock.EnableEpochsHandlerStub{
+			IsFlagEnabledCalled: func(flag core.EnableEpochFlag) bool {
+				return flag == vmhost.StorageGasRefundFlag && isRefundFlagEnabled
+			},
+		},
+	}
+
+	gasMap := config.MakeGasMapForTests()
+	gasMap["BaseOperationCost"]["MaxGasRefundQuotient"] = 5
+	meteringCtx, _ := NewMeteringContext(host, gasMap, BlockGasLimit)
+
+	newVMOutput := func(gasRefund int64) *vmcommon.VMOutput {
+		return &vmcommon.VMOutput{
+			ReturnCode:   vmcommon.Ok,
+			GasRemaining: 1000,
+			GasRefund:    big.NewInt(gasRefund),
+			OutputAccounts: map[string]*vmcommon.OutputAccount{
+				"other": {Address: []byte("other"), GasUsed: 200},
+				"sc":    {Address: []byte("sc"), GasUsed: 300},
+			},
+		}
+	}
+
+	// the refund is capped at a fifth of the gas used, taken from the called contract first
+	vmOutput := newVMOutput(150)
+	meteringCtx.ApplyGasRefund(vmOutput)
+	require.Equal(t, uint64(1100), vmOutput.GasRemaining)
+	require.Equal(t, big.NewInt(0), vmOutput.GasRefund)
+	require.Equal(t, uint64(200), vmOutput.OutputAccounts["sc"].GasUsed)
+	require.Equal(t, uint64(200), vmOutput.OutputAccounts["other"].GasUsed)
+
+	vmOutput = newVMOutput(40)
+	meteringCtx.ApplyGasRefund(vmOutput)
+	require.Equal(t, uint64(1040), vmOutput.GasRemaining)
+	require.Equal(t, big.NewInt(0), vmOutput.GasRefund)
+	require.Equal(t, uint64(260), vmOutput.OutputAccounts["sc"].GasUsed)
+
+	isRefundFlagEnabled = false
+	vmOutput = newVMOutput(40)
+	meteringCtx.ApplyGasRefund(vmOutput)
+	require.Equal(t, uint64(1000), vmOutput.GasRemaining)
+	require.Equal(t, big.NewInt(40), vmOutput.GasRefund)
+	require.Equal(t, uint64(300), vmOutput.OutputAccounts["sc"].GasUsed)
+}
+
 func TestMeteringContext_BoundGasLimit(t *testing.T) {
 	t.Parallel()
 	const BlockGasLimit = uint64(15000)
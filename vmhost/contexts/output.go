@@ -27,6 +27,19 @@ type outputContext struct {
 	codeUpdates      map[string]struct{}
 	crtTransferIndex uint32
 	callArgsParser   vmcommon.CallArgsParser
+
+	transientStorage            *transientStorage
+	transientStorageCheckpoints []int
+
+	logLimits      vmhost.LogLimits
+	logsUsage      logsUsage
+	logsUsageStack []logsUsage
+}
+
+// logsUsage counts the log entries written during the transaction, along with their size
+type logsUsage struct {
+	numLogs  uint32
+	numBytes uint64
 }
 
 // NewOutputContext creates a new outputContext
@@ -40,6 +53,9 @@ func NewOutputContext(host vmhost.VMHost) (*outputContext, error) {
 		stateStack:       make([]*vmcommon.VMOutput, 0),
 		crtTransferIndex: 1,
 		callArgsParser:   parsers.NewCallArgsParser(),
+
+		transientStorageCheckpoints: make([]int, 0),
+		logsUsageStack:              make([]logsUsage, 0),
 	}
 
 	context.InitState()
@@ -52,6 +68,8 @@ func (context *outputContext) InitState() {
 	context.outputState = newVMOutput()
 	context.codeUpdates = make(map[string]struct{})
 	context.crtTransferIndex = 1
+	context.transientStorage = newTransientStorage()
+	context.logsUsage = logsUsage{}
 }
 
 func newVMOutput() *vmcommon.VMOutput {
@@ -86,6 +104,8 @@ func (context *outputContext) PushState() {
 	newState := newVMOutput()
 	mergeVMOutputs(newState, context.outputState)
 	context.stateStack = append(context.stateStack, newState)
+	context.transientStorageCheckpoints = append(context.transientStorageCheckpoints, context.transientStorage.checkpoint())
+	context.logsUsageStack = append(context.logsUsageStack, context.logsUsage)
 }
 
 // PopSetActiveState removes the latest entry from the state stack and sets it as the current vm output
@@ -98,6 +118,9 @@ func (context *outputContext) PopSetActiveState() {
 	prevState := context.stateStack[stateStackLen-1]
 	context.stateStack = context.stateStack[:stateStackLen-1]
 	context.outputState = prevState
+
+	context.popTransientStorage(true)
+	context.logsUsage = context.popLogsUsage()
 }
 
 // PopMergeActiveState merges the current state into the head of the stateStack,
@@ -114,9 +137,22 @@ func (context *outputContext) PopMergeActiveState() {
 	prevState := context.stateStack[stateStackLen-1]
 	context.stateStack = context.stateStack[:stateStackLen-1]
 
+	// the active state was censored when pushed, so its refund only holds the
+	// refund of the nested call, which is added to the refund of the caller
+	var gasRefund *big.Int
+	if context.host.EnableEpochsHandler().IsFlagEnabled(vmhost.StorageGasRefundFlag) {
+		gasRefund = big.NewInt(0).Add(prevState.GasRefund, context.outputState.GasRefund)
+	}
+
 	mergeVMOutputs(prevState, context.outputState)
+	if gasRefund != nil {
+		prevState.GasRefund = gasRefund
+	}
 	context.outputState = newVMOutput()
 	mergeVMOutputs(context.outputState, prevState)
+
+	context.popTransientStorage(false)
+	_ = context.popLogsUsage()
 }
 
 // PopDiscard removes the latest entry from the state stack, but maintaining
@@ -128,11 +164,45 @@ func (context *outputContext) PopDiscard() {
 	}
 
 	context.stateStack = context.stateStack[:stateStackLen-1]
+	context.popTransientStorage(false)
+	_ = context.popLogsUsage()
 }
 
 // ClearStateStack reinitializes the state stack.
 func (context *outputContext) ClearStateStack() {
 	context.stateStack = make([]*vmcommon.VMOutput, 0)
+	context.transientStorageCheckpoints = make([]int, 0)
+	context.transientStorage.clearJournal()
+	context.logsUsageStack = make([]logsUsage, 0)
+}
+
+// popTransientStorage removes the checkpoint taken by the matching PushState, first reverting
+// the transient storage to it if required; the journal is cleared once no checkpoint is left
+func (context *outputContext) popTransientStorage(revert bool) {
+	stackLen := len(context.transientStorageCheckpoints)
+	if stackLen == 0 {
+		return
+	}
+
+	checkpoint := context.transientStorageCheckpoints[stackLen-1]
+	context.transientStorageCheckpoints = context.transientStorageCheckpoints[:stackLen-1]
+	if revert {
+		context.transientStorage.revertToCheckpoint(checkpoint)
+	}
+	if stackLen == 1 {
+		context.transientStorage.clearJournal()
+	}
+}
+
+func (context *outputContext) popLogsUsage() logsUsage {
+	stackLen := len(context.logsUsageStack)
+	if stackLen == 0 {
+		return context.logsUsage
+	}
+
+	prevLogsUsage := context.logsUsageStack[stackLen-1]
+	context.logsUsageStack = context.logsUsageStack[:stackLen-1]
+	return prevLogsUsage
 }
 
 // CensorVMOutput will cause the next executed SC to appear isolated, as if
@@ -260,6 +330,9 @@ func (context *outputContext) WriteLogWithIdentifier(address []byte, topics [][]
 		return
 	}
 
+	context.logsUsage.numLogs++
+	context.logsUsage.numBytes += logEntrySize(topics, data)
+
 	newLogEntry := &vmcommon.LogEntry{
 		Address:    address,
 		Data:       data,
@@ -283,6 +356,44 @@ func (context *outputContext) WriteLog(address []byte, topics [][]byte, data [][
 	context.WriteLogWithIdentifier(address, topics, data, []byte(context.host.Runtime().FunctionName()))
 }
 
+// SetLogLimits sets the limits enforced on the logs written by contracts
+func (context *outputContext) SetLogLimits(limits vmhost.LogLimits) {
+	context.logLimits = limits
+}
+
+// CheckLogLimits verifies whether a new log entry with the given topics and data
+// fits in the log limits of the transaction, once LogLimitsFlag is active
+func (context *outputContext) CheckLogLimits(topics [][]byte, data [][]byte) error {
+	if !context.host.EnableEpochsHandler().IsFlagEnabled(vmhost.LogLimitsFlag) {
+		return nil
+	}
+
+	limits := context.logLimits
+	if limits.MaxTopicsPerLog > 0 && uint64(len(topics)) > uint64(limits.MaxTopicsPerLog) {
+		return vmhost.ErrTooManyLogTopics
+	}
+	if limits.MaxLogsPerTransaction > 0 && context.logsUsage.numLogs >= limits.MaxLogsPerTransaction {
+		return vmhost.ErrTooManyLogs
+	}
+	if limits.MaxLogBytesPerTransaction > 0 &&
+		context.logsUsage.numBytes+logEntrySize(topics, data) > limits.MaxLogBytesPerTransaction {
+		return vmhost.ErrLogsSizeLimitExceeded
+	}
+
+	return nil
+}
+
+func logEntrySize(topics [][]byte, data [][]byte) uint64 {
+	size := uint64(0)
+	for _, topic := range topics {
+		size += uint64(len(topic))
+	}
+	for _, dataItem := range data {
+		size += uint64(len(dataItem))
+	}
+	return size
+}
+
 // TransferValueOnly will transfer the big.int value and checks if it is possible
 func (context *outputContext) TransferValueOnly(destination []byte, sender []byte, value *big.Int, checkPayable bool) error {
 	logOutput.Trace("transfer value", "sender", sender, "dest", destination, "value", value)
@@ -541,6 +652,18 @@ func (context *outputContext) RemoveNonUpdatedStorage() {
 	}
 }
 
+// GetTransientStorage returns the value of the key in the transient storage of the address,
+// which lives until the end of the transaction and is never part of the VMOutput
+func (context *outputContext) GetTransientStorage(address []byte, key []byte) []byte {
+	return context.transientStorage.get(address, key)
+}
+
+// SetTransientStorage sets the value of the key in the transient storage of the address,
+// an empty value deleting the key. The value is reverted with the rest of the output state.
+func (context *outputContext) SetTransientStorage(address []byte, key []byte, value []byte) {
+	context.transientStorage.set(address, key, value)
+}
+
 // GetVMOutput updates the current VMOutput and returns it
 func (context *outputContext) GetVMOutput() *vmcommon.VMOutput {
 	context.removeNonUpdatedCode()
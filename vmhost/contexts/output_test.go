@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-scenario-go/worldmock"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	contextmock "github.com/multiversx/mx-chain-vm-go/mock/context"
@@ -106,6 +107,175 @@ func TestOutputContext_PushPopState(t *testing.T) {
 	require.Equal(t, 0, len(outputContext.stateStack))
 }
 
+func TestOutputContext_TransientStorage(t *testing.T) {
+	t.Parallel()
+
+	host := &contextmock.VMHostMock{
+		EnableEpochsHandlerField: &worldmock.EnableEpochsHandlerStub{},
+	}
+	outputContext, _ := NewOutputContext(host)
+
+	address1 := []byte("address1")
+	address2 := []byte("address2")
+	key := []byte("lock")
+
+	outputContext.SetTransientStorage(address1, key, []byte("value1"))
+	require.Equal(t, []byte("value1"), outputContext.GetTransientStorage(address1, key))
+	require.Nil(t, outputContext.GetTransientStorage(address2, key))
+
+	// a successful nested call keeps its transient storage
+	outputContext.PushState()
+	outputContext.SetTransientStorage(address2, key, []byte("value2"))
+	outputContext.PopMergeActiveState()
+	require.Equal(t, []byte("value2"), outputContext.GetTransientStorage(address2, key))
+
+	// a failed nested call reverts its transient storage
+	outputContext.PushState()
+	outputContext.SetTransientStorage(address1, key, []byte("reverted"))
+	outputContext.SetTransientStorage(address2, key, nil)
+	require.Nil(t, outputContext.GetTransientStorage(address2, key))
+	outputContext.PopSetActiveState()
+	require.Equal(t, []byte("value1"), outputContext.GetTransientStorage(address1, key))
+	require.Equal(t, []byte("value2"), outputContext.GetTransientStorage(address2, key))
+
+	outputContext.PushState()
+	outputContext.SetTransientStorage(address1, key, []byte("kept"))
+	outputContext.PopDiscard()
+	require.Equal(t, []byte("kept"), outputContext.GetTransientStorage(address1, key))
+
+	// the transient storage never reaches the VMOutput and ends with the transaction
+	require.Equal(t, 0, len(outputContext.outputState.OutputAccounts))
+
+	outputContext.InitState()
+	require.Nil(t, outputContext.GetTransientStorage(address1, key))
+	require.Nil(t, outputContext.GetTransientStorage(address2, key))
+}
+
+func TestOutputContext_TransientStorageNestedCalls(t *testing.T) {
+	t.Parallel()
+
+	host := &contextmock.VMHostMock{
+		EnableEpochsHandlerField: &worldmock.EnableEpochsHandlerStub{},
+	}
+	outputContext, _ := NewOutputContext(host)
+
+	address := []byte("address")
+	key1 := []byte("key1")
+	key2 := []byte("key2")
+
+	outputContext.PushState()
+	outputContext.SetTransientStorage(address, key1, []byte("outer"))
+
+	// the reverted writes of an inner call do not affect those of the outer call
+	outputContext.PushState()
+	outputContext.SetTransientStorage(address, key1, []byte("inner1"))
+	outputContext.SetTransientStorage(address, key1, []byte("inner2"))
+	outputContext.PopSetActiveState()
+	require.Equal(t, []byte("outer"), outputContext.GetTransientStorage(address, key1))
+
+	// the merged writes of an inner call are reverted together with the outer call
+	outputContext.PushState()
+	outputContext.SetTransientStorage(address, key2, []byte("inner"))
+	outputContext.PopMergeActiveState()
+	require.Equal(t, []byte("inner"), outputContext.GetTransientStorage(address, key2))
+
+	outputContext.PopSetActiveState()
+	require.Nil(t, outputContext.GetTransientStorage(address, key1))
+	require.Nil(t, outputContext.GetTransientStorage(address, key2))
+
+	// only the writes done since the last pushed state are journaled
+	require.Empty(t, outputContext.transientStorage.journal)
+	outputContext.PushState()
+	outputContext.SetTransientStorage(address, key1, []byte("value"))
+	require.Len(t, outputContext.transientStorage.journal, 1)
+	outputContext.PopMergeActiveState()
+	require.Empty(t, outputContext.transientStorage.journal)
+	require.Equal(t, []byte("value"), outputContext.GetTransientStorage(address, key1))
+}
+
+func TestOutputContext_GasRefundAcrossNestedCalls(t *testing.T) {
+	t.Parallel()
+
+	host := &contextmock.VMHostMock{
+		EnableEpochsHandlerField: &worldmock.EnableEpochsHandlerStub{
+			IsFlagEnabledCalled: func(flag core.EnableEpochFlag) bool {
+				return flag == vmhost.StorageGasRefundFlag
+			},
+		},
+	}
+	outputContext, _ := NewOutputContext(host)
+	outputContext.SetRefund(100)
+
+	// the refund of a successful nested call is added to the refund of the caller
+	outputContext.PushState()
+	outputContext.CensorVMOutput()
+	outputContext.SetRefund(30)
+	outputContext.PopMergeActiveState()
+	require.Equal(t, uint64(130), outputContext.GetRefund())
+
+	// the refund of a failed nested call is dropped
+	outputContext.PushState()
+	outputContext.CensorVMOutput()
+	outputContext.SetRefund(50)
+	outputContext.PopSetActiveState()
+	require.Equal(t, uint64(130), outputContext.GetRefund())
+
+	// the refund of a call on the same context is kept
+	outputContext.PushState()
+	outputContext.SetRefund(outputContext.GetRefund() + 20)
+	outputContext.PopDiscard()
+	require.Equal(t, uint64(150), outputContext.GetRefund())
+}
+
+func TestOutputContext_GasRefundAcrossDeeplyNestedCalls(t *testing.T) {
+	t.Parallel()
+
+	host := &contextmock.VMHostMock{
+		EnableEpochsHandlerField: &worldmock.EnableEpochsHandlerStub{
+			IsFlagEnabledCalled: func(flag core.EnableEpochFlag) bool {
+				return flag == vmhost.StorageGasRefundFlag
+			},
+		},
+	}
+	outputContext, _ := NewOutputContext(host)
+	outputContext.SetRefund(100)
+
+	// the refunds of successful nested calls reach the caller level by level
+	outputContext.PushState()
+	outputContext.CensorVMOutput()
+	outputContext.SetRefund(30)
+	outputContext.PushState()
+	outputContext.CensorVMOutput()
+	outputContext.SetRefund(20)
+	outputContext.PopMergeActiveState()
+	require.Equal(t, uint64(50), outputContext.GetRefund())
+	outputContext.PopMergeActiveState()
+	require.Equal(t, uint64(150), outputContext.GetRefund())
+
+	// the refund merged by a nested call is dropped when its caller fails
+	outputContext.PushState()
+	outputContext.CensorVMOutput()
+	outputContext.SetRefund(10)
+	outputContext.PushState()
+	outputContext.CensorVMOutput()
+	outputContext.SetRefund(5)
+	outputContext.PopMergeActiveState()
+	require.Equal(t, uint64(15), outputContext.GetRefund())
+	outputContext.PopSetActiveState()
+	require.Equal(t, uint64(150), outputContext.GetRefund())
+
+	// the refund of a call on the same context, nested in a successful call, is kept
+	outputContext.PushState()
+	outputContext.CensorVMOutput()
+	outputContext.SetRefund(40)
+	outputContext.PushState()
+	outputContext.SetRefund(outputContext.GetRefund() + 7)
+	outputContext.PopDiscard()
+	require.Equal(t, uint64(47), outputContext.GetRefund())
+	outputContext.PopMergeActiveState()
+	require.Equal(t, uint64(197), outputContext.GetRefund())
+}
+
 func TestOutputContext_GetOutputAccount(t *testing.T) {
 	t.Parallel()
 
@@ -571,6 +741,57 @@ func TestOutputContext_WriteLog(t *testing.T) {
 	require.Equal(t, outputContext.outputState.Logs[2].Topics, [][]byte{topic})
 }
 
+func TestOutputContext_CheckLogLimits(t *testing.T) {
+	t.Parallel()
+
+	flagEnabled := false
+	host := &contextmock.VMHostMock{
+		RuntimeContext: &contextmock.RuntimeContextMock{
+			CallFunction: "function",
+		},
+		EnableEpochsHandlerField: &worldmock.EnableEpochsHandlerStub{
+			IsFlagEnabledCalled: func(flag core.EnableEpochFlag) bool {
+				return flag == vmhost.LogLimitsFlag && flagEnabled
+			},
+		},
+	}
+	outputContext, _ := NewOutputContext(host)
+	outputContext.SetLogLimits(vmhost.LogLimits{
+		MaxLogsPerTransaction:     2,
+		MaxTopicsPerLog:           2,
+		MaxLogBytesPerTransaction: 20,
+	})
+
+	address := []byte("address")
+	topics := [][]byte{[]byte("topic1"), []byte("topic2"), []byte("topic3")}
+	data := [][]byte{[]byte("data")}
+
+	// the limits are only enforced once the flag is active
+	require.Nil(t, outputContext.CheckLogLimits(topics, data))
+
+	flagEnabled = true
+	require.Equal(t, vmhost.ErrTooManyLogTopics, outputContext.CheckLogLimits(topics, data))
+	require.Equal(t, vmhost.ErrLogsSizeLimitExceeded, outputContext.CheckLogLimits(topics[:1], [][]byte{make([]byte, 15)}))
+
+	require.Nil(t, outputContext.CheckLogLimits(topics[:1], data))
+	outputContext.WriteLog(address, topics[:1], data)
+
+	// the logs of a failed nested call no longer count
+	outputContext.PushState()
+	outputContext.WriteLog(address, topics[:1], data)
+	require.Equal(t, vmhost.ErrTooManyLogs, outputContext.CheckLogLimits(nil, nil))
+	outputContext.PopSetActiveState()
+	require.Nil(t, outputContext.CheckLogLimits(topics[:1], data))
+
+	outputContext.PushState()
+	outputContext.WriteLog(address, topics[:1], data)
+	outputContext.PopMergeActiveState()
+	require.Equal(t, vmhost.ErrTooManyLogs, outputContext.CheckLogLimits(nil, nil))
+
+	outputContext.InitState()
+	require.Nil(t, outputContext.CheckLogLimits(topics[:2], data))
+}
+
 func TestOutputContext_PopSetActiveStateIfStackIsEmptyShouldNotPanic(t *testing.T) {
 	t.Parallel()
 
@@ -17,15 +17,6 @@ var logRuntime = logger.GetOrCreate("vm/runtime")
 
 var _ vmhost.RuntimeContext = (*runtimeContext)(nil)
 
-var mapNewCryptoAPI = map[string]struct{}{
-	"managedVerifyBLSSignatureShare":           {},
-	"managedVerifyBLSAggregatedSignature":      {},
-	"managedVerifySecp256r1":                   {},
-	"managedGetOriginalCallerAddr":             {},
-	"managedGetRelayerAddr":                    {},
-	"managedMultiTransferESDTNFTExecuteByUser": {},
-}
-
 const warmCacheSize = 100
 
 // WarmInstancesEnabled controls the usage of warm instances
@@ -47,9 +38,10 @@ type runtimeContext struct {
 
 	stateStack []*runtimeContext
 
-	validator *wasmValidator
-	errors    vmhost.WrappableError
-	hasher    vmhost.HashComputer
+	validator            *wasmValidator
+	codeValidationPolicy *vmhost.CodeValidationPolicy
+	errors               vmhost.WrappableError
+	hasher               vmhost.HashComputer
 }
 
 // NewRuntimeContext creates a new runtimeContext
@@ -251,7 +243,7 @@ func (context *runtimeContext) makeInstanceFromContractByteCode(contract []byte,
 	}
 
 	if newCode {
-		err = context.VerifyContractCode()
+		err = context.VerifyContractCode(contract)
 		if err != nil {
 			context.iTracker.ForceCleanInstance(true)
 			logRuntime.Trace("instance creation", "from", "bytecode", "error", err)
@@ -354,6 +346,11 @@ func (context *runtimeContext) MustVerifyNextContractCode() {
 	context.verifyCode = true
 }
 
+// SetCodeValidationPolicy sets the limits enforced on new contract code, nil meaning no limits
+func (context *runtimeContext) SetCodeValidationPolicy(policy *vmhost.CodeValidationPolicy) {
+	context.codeValidationPolicy = policy
+}
+
 // SetMaxInstanceStackSize sets the maximum number of allowed Wasmer instances on
 // the instance stack, for recursivity.
 func (context *runtimeContext) SetMaxInstanceStackSize(maxInstances uint64) {
@@ -653,14 +650,24 @@ func (context *runtimeContext) GetRuntimeBreakpointValue() vmhost.BreakpointValu
 	return vmhost.BreakpointValue(context.iTracker.Instance().GetBreakpointValue())
 }
 
-// VerifyContractCode performs validation on the WASM bytecode (declaration of memory and legal functions).
-func (context *runtimeContext) VerifyContractCode() error {
+// VerifyContractCode performs validation on the WASM bytecode (declaration of memory and legal functions,
+// and the limits of the code validation policy in the current epoch).
+func (context *runtimeContext) VerifyContractCode(code []byte) error {
 	if !context.verifyCode {
 		return nil
 	}
 
 	context.verifyCode = false
 
+	limits, hasLimits := context.codeValidationPolicy.LimitsForEpoch(context.host.Blockchain().CurrentEpoch())
+	if hasLimits {
+		err := context.validator.verifyCodeLimits(code, limits)
+		if err != nil {
+			logRuntime.Trace("verify contract code", "error", err)
+			return err
+		}
+	}
+
 	err := context.validator.verifyMemoryDeclaration(context.iTracker.Instance())
 	if err != nil {
 		logRuntime.Trace("verify contract code", "error", err)
@@ -680,10 +687,14 @@ func (context *runtimeContext) VerifyContractCode() error {
 	}
 
 	enableEpochsHandler := context.host.EnableEpochsHandler()
-	if !enableEpochsHandler.IsFlagEnabled(vmhost.CryptoOpcodesV2Flag) {
-		err = context.checkIfContainsNewCryptoApi()
+	for _, activation := range vmhost.VMHooksActivations {
+		if enableEpochsHandler.IsFlagEnabled(activation.Flag) {
+			continue
+		}
+
+		err = context.checkIfContainsFunctions(activation.Hooks)
 		if err != nil {
-			logRuntime.Trace("verify contract code", "error", err)
+			logRuntime.Trace("verify contract code", "error", err, "flag", activation.Flag)
 			return err
 		}
 	}
@@ -693,8 +704,8 @@ func (context *runtimeContext) VerifyContractCode() error {
 	return nil
 }
 
-func (context *runtimeContext) checkIfContainsNewCryptoApi() error {
-	for funcName := range mapNewCryptoAPI {
+func (context *runtimeContext) checkIfContainsFunctions(functions []string) error {
+	for _, funcName := range functions {
 		if context.iTracker.Instance().IsFunctionImported(funcName) {
 			return vmhost.ErrContractInvalid
 		}
@@ -896,21 +907,7 @@ func (context *runtimeContext) checkNumRunningInstances() error {
 
 // ValidateCallbackName verifies whether the provided function name may be used as AsyncCall callback
 func (context *runtimeContext) ValidateCallbackName(callbackName string) error {
-	err := context.validator.verifyValidFunctionName(callbackName)
-	if err != nil {
-		return vmhost.ErrInvalidFunctionName
-	}
-	if callbackName == vmhost.InitFunctionName {
-		return vmhost.ErrInvalidFunctionName
-	}
-	if context.host.IsBuiltinFunctionName(callbackName) {
-		return vmhost.ErrCannotUseBuiltinAsCallback
-	}
-	if !context.HasFunction(callbackName) {
-		return executor.ErrFuncNotFound
-	}
-
-	return nil
+	return context.validator.verifyCallbackName(callbackName, context.host.IsBuiltinFunctionName, context.HasFunction)
 }
 
 // IsReservedFunctionName checks if the function name is reserved
@@ -3,8 +3,9 @@ package contexts
 import (
 	"bytes"
 	"fmt"
-	"github.com/multiversx/mx-chain-core-go/core"
+	"sort"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -25,11 +26,13 @@ const VMStoragePrefix = "VM@"
 type storageContext struct {
 	host                       vmhost.VMHost
 	blockChainHook             vmcommon.BlockchainHook
+	storageIterator            vmhost.StorageIteratorHook
 	address                    []byte
 	stateStack                 [][]byte
 	protectedKeyPrefix         []byte
 	vmProtectedKeyPrefix       []byte
 	vmStorageProtectionEnabled bool
+	storageDepositAddress      []byte
 }
 
 // NewStorageContext creates a new storageContext
@@ -52,13 +55,17 @@ func NewStorageContext(
 		return nil, vmhost.ErrNilVMHost
 	}
 
+	storageIterator, _ := blockChainHook.(vmhost.StorageIteratorHook)
+
 	context := &storageContext{
 		host:                       host,
 		blockChainHook:             blockChainHook,
+		storageIterator:            storageIterator,
 		stateStack:                 make([][]byte, 0),
 		protectedKeyPrefix:         protectedKeyPrefix,
 		vmProtectedKeyPrefix:       append(protectedKeyPrefix, []byte(VMStoragePrefix)...),
 		vmStorageProtectionEnabled: true,
+		storageDepositAddress:      core.SystemAccountAddress,
 	}
 
 	return context, nil
@@ -249,6 +256,123 @@ func (context *storageContext) GetStorageUnmetered(key []byte) ([]byte, uint32,
 	return context.getStorageFromAddressUnmetered(context.address, key)
 }
 
+// GetStorageKeysWithPrefix returns, in ascending order, at most maxKeys keys of the current
+// contract which start with the prefix and come after the cursor, an empty cursor meaning
+// the first key. The pending StorageUpdates are merged with the keys of the BlockchainHook,
+// while the keys protected by the protocol are never returned. Every key read from the
+// BlockchainHook costs MBufferStorageIterateKey, including the keys which are skipped.
+func (context *storageContext) GetStorageKeysWithPrefix(tracedFunctionName string, prefix []byte, cursor []byte, maxKeys uint32) ([][]byte, error) {
+	return context.getStorageKeysWithPrefix(tracedFunctionName, context.address, prefix, cursor, maxKeys, false)
+}
+
+// GetVMProtectedStorageKeysFromAddress works like GetStorageKeysWithPrefix, but lists the keys
+// of the given address which start with the VM protected prefix followed by the given prefix.
+func (context *storageContext) GetVMProtectedStorageKeysFromAddress(tracedFunctionName string, address []byte, prefix string, cursor []byte, maxKeys uint32) ([][]byte, error) {
+	return context.getStorageKeysWithPrefix(tracedFunctionName, address, context.GetVmProtectedPrefix(prefix), cursor, maxKeys, true)
+}
+
+func (context *storageContext) getStorageKeysWithPrefix(
+	tracedFunctionName string,
+	address []byte,
+	prefix []byte,
+	cursor []byte,
+	maxKeys uint32,
+	withProtectedKeys bool,
+) ([][]byte, error) {
+	if check.IfNilReflect(context.storageIterator) {
+		return nil, vmhost.ErrStorageIterationNotSupported
+	}
+	if maxKeys == 0 {
+		return make([][]byte, 0), nil
+	}
+
+	metering := context.host.Metering()
+	gasPerKey := metering.GasSchedule().ManagedBufferAPICost.MBufferStorageIterateKey
+	storageUpdates := context.GetStorageUpdates(address)
+	keys := make(map[string]struct{})
+
+	// The keys of the BlockchainHook are read in batches, until enough of them
+	// are left after removing those deleted by the pending StorageUpdates.
+	blockchainCursor := cursor
+	isBlockchainExhausted := false
+	for !isBlockchainExhausted && uint32(len(keys)) < maxKeys {
+		blockchainKeys, err := context.storageIterator.GetStorageKeysWithPrefix(address, prefix, blockchainCursor, maxKeys)
+		if err != nil {
+			return nil, err
+		}
+
+		err = metering.UseGasBoundedAndAddTracedGas(tracedFunctionName, math.MulUint64(gasPerKey, uint64(len(blockchainKeys))))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, key := range blockchainKeys {
+			update, isUpdated := storageUpdates[string(key)]
+			if isUpdated && len(update.Data) == 0 {
+				continue
+			}
+			if !withProtectedKeys && context.isProtocolProtectedKey(key) {
+				continue
+			}
+			keys[string(key)] = struct{}{}
+		}
+
+		isBlockchainExhausted = uint32(len(blockchainKeys)) < maxKeys
+		if len(blockchainKeys) > 0 {
+			blockchainCursor = blockchainKeys[len(blockchainKeys)-1]
+		}
+	}
+
+	for key, update := range storageUpdates {
+		isInRange := bytes.HasPrefix([]byte(key), prefix) && bytes.Compare([]byte(key), cursor) > 0
+		if !isInRange || len(update.Data) == 0 {
+			continue
+		}
+		if !withProtectedKeys && context.isProtocolProtectedKey([]byte(key)) {
+			continue
+		}
+		// keys after the last one read from the BlockchainHook might precede unread ones
+		if !isBlockchainExhausted && bytes.Compare([]byte(key), blockchainCursor) > 0 {
+			continue
+		}
+		keys[key] = struct{}{}
+	}
+
+	sortedKeys := make([][]byte, 0, len(keys))
+	for key := range keys {
+		sortedKeys = append(sortedKeys, []byte(key))
+	}
+	sort.Slice(sortedKeys, func(i, j int) bool {
+		return bytes.Compare(sortedKeys[i], sortedKeys[j]) < 0
+	})
+	if uint32(len(sortedKeys)) > maxKeys {
+		sortedKeys = sortedKeys[:maxKeys]
+	}
+
+	logStorage.Trace("get keys with prefix", "prefix", prefix, "cursor", cursor, "num keys", len(sortedKeys))
+	return sortedKeys, nil
+}
+
+// GetTransientStorage returns the value of the key in the transient storage of the current contract.
+func (context *storageContext) GetTransientStorage(key []byte) []byte {
+	value := context.host.Output().GetTransientStorage(context.address, key)
+	logStorage.Trace("get transient", "key", key, "value", value)
+	return value
+}
+
+// SetTransientStorage sets the value of the key in the transient storage of the current contract,
+// which is discarded at the end of the transaction.
+func (context *storageContext) SetTransientStorage(key []byte, value []byte) error {
+	if context.host.Runtime().ReadOnly() {
+		logStorage.Trace("set transient", "error", "cannot set transient storage in readonly mode")
+		return vmhost.ErrCannotWriteOnReadOnly
+	}
+
+	context.host.Output().SetTransientStorage(context.address, key, value)
+	logStorage.Trace("set transient", "key", key, "value", value)
+	return nil
+}
+
 // enableStorageProtection will prevent writing to protected keys
 func (context *storageContext) enableStorageProtection() {
 	context.vmStorageProtectionEnabled = true
@@ -320,7 +444,7 @@ func (context *storageContext) setStorageToAddress(address []byte, key []byte, v
 	}
 
 	deltaBytes := len(value) - len(oldValue)
-	context.addDeltaBytes(deltaBytes)
+	context.addDeltaBytes(address, deltaBytes)
 
 	context.changeStorageUpdate(key, value, storageUpdates)
 
@@ -385,8 +509,13 @@ func (context *storageContext) checkReservedAndProtection(key []byte) error {
 	return nil
 }
 
-func (context *storageContext) addDeltaBytes(deltaBytes int) {
-	account, _ := context.host.Output().GetOutputAccount(context.address)
+func (context *storageContext) addDeltaBytes(address []byte, deltaBytes int) {
+	// the storage accounting needs the bytes of the account actually written
+	if !context.host.EnableEpochsHandler().IsFlagEnabled(vmhost.StorageAccountingFlag) {
+		address = context.address
+	}
+
+	account, _ := context.host.Output().GetOutputAccount(address)
 	if deltaBytes > 0 {
 		account.BytesAddedToStorage += uint64(deltaBytes)
 	} else {
@@ -436,6 +565,9 @@ func (context *storageContext) storageAdded(length int, key []byte, value []byte
 func (context *storageContext) storageDeleted(lengthOldValue int, key []byte) (vmhost.StorageStatus, error) {
 	metering := context.host.Metering()
 	freeGas := math.MulUint64(metering.GasSchedule().BaseOperationCost.ReleasePerByte, uint64(lengthOldValue))
+	if context.host.EnableEpochsHandler().IsFlagEnabled(vmhost.StorageGasRefundFlag) {
+		freeGas = math.AddUint64(freeGas, metering.GasSchedule().BaseOperationCost.ClearStorageRefund)
+	}
 	metering.FreeGas(freeGas)
 
 	logStorage.Trace("storage deleted", "key", key)
@@ -0,0 +1,131 @@
+package contexts
+
+import (
+	"math/big"
+	"sort"
+
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// SetStorageDepositAddress sets the account holding the storage deposits, the
+// system account by default, which exists in every shard
+func (context *storageContext) SetStorageDepositAddress(address []byte) {
+	if len(address) == 0 {
+		return
+	}
+	context.storageDepositAddress = address
+}
+
+// ApplyStorageAccounting updates the number of storage bytes used by each account
+// whose storage was changed by the transaction, moving the storage deposit from
+// its balance to the storage deposit account for the added bytes and back for the
+// removed bytes, so the balance deltas of the VMOutput still sum to zero.
+// Both figures are kept under VM protected keys, so they reach the VMOutput as
+// StorageUpdates of the account.
+func (context *storageContext) ApplyStorageAccounting() error {
+	if !context.host.EnableEpochsHandler().IsFlagEnabled(vmhost.StorageAccountingFlag) {
+		return nil
+	}
+
+	outputAccounts := context.host.Output().GetOutputAccounts()
+	addresses := make([]string, 0, len(outputAccounts))
+	for address, account := range outputAccounts {
+		if account.BytesAddedToStorage != account.BytesDeletedFromStorage {
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Strings(addresses)
+
+	for _, address := range addresses {
+		err := context.applyStorageAccountingToAccount(outputAccounts[address])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (context *storageContext) applyStorageAccountingToAccount(account *vmcommon.OutputAccount) error {
+	storageUsedKey := context.getStorageAccountingKey(vmhost.StorageUsedKey)
+	storageUsed, err := context.getStorageAccountingValue(account.Address, storageUsedKey)
+	if err != nil {
+		return err
+	}
+
+	depositKey := context.getStorageAccountingKey(vmhost.StorageDepositKey)
+	deposit, err := context.getStorageAccountingValue(account.Address, depositKey)
+	if err != nil {
+		return err
+	}
+
+	costs := context.host.Metering().GasSchedule().BaseOperationCost
+	if account.BytesAddedToStorage > account.BytesDeletedFromStorage {
+		addedBytes := big.NewInt(0).SetUint64(account.BytesAddedToStorage - account.BytesDeletedFromStorage)
+		charge := big.NewInt(0).Mul(addedBytes, big.NewInt(0).SetUint64(costs.StorageDepositPerByte))
+		if context.host.Blockchain().GetBalanceBigInt(account.Address).Cmp(charge) < 0 {
+			logStorage.Trace("storage accounting", "error", vmhost.ErrNotEnoughStorageDeposit, "address", account.Address)
+			return vmhost.ErrNotEnoughStorageDeposit
+		}
+
+		storageUsed.Add(storageUsed, addedBytes)
+		deposit.Add(deposit, charge)
+		context.moveStorageDeposit(account, charge)
+	} else {
+		removedBytes := big.NewInt(0).SetUint64(account.BytesDeletedFromStorage - account.BytesAddedToStorage)
+		refund := big.NewInt(0).Mul(removedBytes, big.NewInt(0).SetUint64(costs.StorageRefundPerByte))
+		// the bytes stored before the activation of the accounting were never paid for
+		if refund.Cmp(deposit) > 0 {
+			refund.Set(deposit)
+		}
+
+		storageUsed.Sub(storageUsed, removedBytes)
+		if storageUsed.Sign() < 0 {
+			storageUsed.SetUint64(0)
+		}
+		deposit.Sub(deposit, refund)
+		context.moveStorageDeposit(account, big.NewInt(0).Neg(refund))
+	}
+
+	_, err = context.SetProtectedStorageToAddressUnmetered(account.Address, storageUsedKey, storageUsed.Bytes())
+	if err != nil {
+		return err
+	}
+
+	_, err = context.SetProtectedStorageToAddressUnmetered(account.Address, depositKey, deposit.Bytes())
+	if err != nil {
+		return err
+	}
+
+	logStorage.Trace("storage accounting", "address", account.Address, "storageUsed", storageUsed, "deposit", deposit)
+	return nil
+}
+
+// moveStorageDeposit moves the value from the account to the storage deposit account,
+// a negative value being moved back to the account
+func (context *storageContext) moveStorageDeposit(account *vmcommon.OutputAccount, value *big.Int) {
+	if value.Sign() == 0 {
+		return
+	}
+
+	depositAccount, _ := context.host.Output().GetOutputAccount(context.storageDepositAddress)
+	account.BalanceDelta.Sub(account.BalanceDelta, value)
+	depositAccount.BalanceDelta.Add(depositAccount.BalanceDelta, value)
+}
+
+// getStorageAccountingKey returns a new slice, because the storage keys are kept by the StorageUpdates
+func (context *storageContext) getStorageAccountingKey(name string) []byte {
+	key := make([]byte, 0, len(context.vmProtectedKeyPrefix)+len(name))
+	key = append(key, context.vmProtectedKeyPrefix...)
+	return append(key, name...)
+}
+
+func (context *storageContext) getStorageAccountingValue(address []byte, key []byte) (*big.Int, error) {
+	value, _, _, err := context.getStorageFromAddressUnmetered(address, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return big.NewInt(0).SetBytes(value), nil
+}
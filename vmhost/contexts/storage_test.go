@@ -12,12 +12,15 @@ import (
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/multiversx/mx-chain-vm-go/config"
 	contextmock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	mockworld "github.com/multiversx/mx-chain-vm-go/mock/world"
 	"github.com/multiversx/mx-chain-vm-go/vmhost"
 	"github.com/stretchr/testify/require"
 )
 
 var reservedTestPrefix = []byte("RESERVED")
 
+const iterateKeysName = "iterateKeys"
+
 func TestNewStorageContext(t *testing.T) {
 	t.Parallel()
 
@@ -173,6 +176,156 @@ func TestStorageContext_GetStorageUpdates(t *testing.T) {
 	require.Equal(t, []byte("some data"), storageUpdates["update"].Data)
 }
 
+func TestStorageContext_GetStorageKeysWithPrefix(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("account")
+	mockOutput := &contextmock.OutputContextMock{}
+	account := mockOutput.NewVMOutputAccount(address)
+	mockOutput.OutputAccountMock = account
+	mockOutput.OutputAccountIsNew = false
+
+	account.StorageUpdates["item0"] = &vmcommon.StorageUpdate{Offset: []byte("item0"), Data: []byte("added")}
+	account.StorageUpdates["item2"] = &vmcommon.StorageUpdate{Offset: []byte("item2"), Data: []byte{}}
+	account.StorageUpdates["item4"] = &vmcommon.StorageUpdate{Offset: []byte("item4"), Data: []byte("added")}
+
+	mockMetering := &contextmock.MeteringContextMock{}
+	mockMetering.SetGasSchedule(config.MakeGasMapForTests())
+
+	host := &contextmock.VMHostMock{
+		OutputContext:            mockOutput,
+		MeteringContext:          mockMetering,
+		EnableEpochsHandlerField: &worldmock.EnableEpochsHandlerStub{},
+	}
+
+	world := worldmock.NewMockWorld()
+	world.AcctMap.PutAccount(&worldmock.Account{
+		Address: address,
+		Storage: map[string][]byte{
+			"item1":            []byte("stored"),
+			"item2":            []byte("stored"),
+			"item3":            []byte("stored"),
+			"other":            []byte("stored"),
+			"RESERVEDitemLock": []byte("stored"),
+		},
+	})
+
+	storageCtx, _ := NewStorageContext(host, mockworld.NewIterableMockWorld(world), reservedTestPrefix)
+	storageCtx.SetAddress(address)
+
+	keys, err := storageCtx.GetStorageKeysWithPrefix(iterateKeysName, []byte("item"), nil, 2)
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{[]byte("item0"), []byte("item1")}, keys)
+
+	keys, err = storageCtx.GetStorageKeysWithPrefix(iterateKeysName, []byte("item"), []byte("item1"), 2)
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{[]byte("item3"), []byte("item4")}, keys)
+
+	keys, err = storageCtx.GetStorageKeysWithPrefix(iterateKeysName, []byte("item"), []byte("item4"), 2)
+	require.Nil(t, err)
+	require.Empty(t, keys)
+
+	keys, err = storageCtx.GetStorageKeysWithPrefix(iterateKeysName, nil, nil, 10)
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{[]byte("item0"), []byte("item1"), []byte("item3"), []byte("item4"), []byte("other")}, keys)
+
+	keys, err = storageCtx.GetStorageKeysWithPrefix(iterateKeysName, nil, nil, 0)
+	require.Nil(t, err)
+	require.Empty(t, keys)
+
+	storageCtx, _ = NewStorageContext(host, &contextmock.BlockchainHookStub{}, reservedTestPrefix)
+	keys, err = storageCtx.GetStorageKeysWithPrefix(iterateKeysName, []byte("item"), nil, 2)
+	require.Nil(t, keys)
+	require.Equal(t, vmhost.ErrStorageIterationNotSupported, err)
+}
+
+func TestStorageContext_GetVMProtectedStorageKeysFromAddress(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("account")
+	mockMetering := &contextmock.MeteringContextMock{}
+	mockMetering.SetGasSchedule(config.MakeGasMapForTests())
+
+	host := &contextmock.VMHostMock{
+		OutputContext:            &contextmock.OutputContextMock{},
+		MeteringContext:          mockMetering,
+		EnableEpochsHandlerField: &worldmock.EnableEpochsHandlerStub{},
+	}
+
+	world := worldmock.NewMockWorld()
+	storageCtx, _ := NewStorageContext(host, mockworld.NewIterableMockWorld(world), reservedTestPrefix)
+	asyncKey := vmhost.CustomStorageKey(string(storageCtx.GetVmProtectedPrefix(vmhost.AsyncDataPrefix)), []byte("callID"))
+	world.AcctMap.PutAccount(&worldmock.Account{
+		Address: address,
+		Storage: map[string][]byte{
+			string(asyncKey): []byte("context"),
+			"item":           []byte("stored"),
+		},
+	})
+	mockOutput := host.OutputContext.(*contextmock.OutputContextMock)
+	mockOutput.OutputAccountMock = mockOutput.NewVMOutputAccount(address)
+
+	keys, err := storageCtx.GetVMProtectedStorageKeysFromAddress(iterateKeysName, address, vmhost.AsyncDataPrefix, nil, 10)
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{asyncKey}, keys)
+
+	storageCtx.SetAddress(address)
+	keys, err = storageCtx.GetStorageKeysWithPrefix(iterateKeysName, nil, nil, 10)
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{[]byte("item")}, keys)
+}
+
+func TestStorageContext_GetStorageKeysWithPrefix_GasUsage(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("account")
+	mockOutput := &contextmock.OutputContextMock{}
+	account := mockOutput.NewVMOutputAccount(address)
+	mockOutput.OutputAccountMock = account
+	account.StorageUpdates["item2"] = &vmcommon.StorageUpdate{Offset: []byte("item2"), Data: []byte{}}
+
+	gasPerKey := uint64(10)
+	gasMap := config.MakeGasMapForTests()
+	gasMap["ManagedBufferAPICost"]["MBufferStorageIterateKey"] = gasPerKey
+
+	mockRuntime := &contextmock.RuntimeContextMock{}
+	host := &contextmock.VMHostMock{
+		OutputContext:            mockOutput,
+		RuntimeContext:           mockRuntime,
+		EnableEpochsHandlerField: &worldmock.EnableEpochsHandlerStub{},
+	}
+	meteringCtx, _ := NewMeteringContext(host, gasMap, uint64(15000))
+	host.MeteringContext = meteringCtx
+
+	world := worldmock.NewMockWorld()
+	world.AcctMap.PutAccount(&worldmock.Account{
+		Address: address,
+		Storage: map[string][]byte{
+			"RESERVEDlock": []byte("stored"),
+			"item1":        []byte("stored"),
+			"item2":        []byte("stored"),
+			"item3":        []byte("stored"),
+		},
+	})
+
+	storageCtx, _ := NewStorageContext(host, mockworld.NewIterableMockWorld(world), reservedTestPrefix)
+	storageCtx.SetAddress(address)
+
+	// the protected and the deleted keys are skipped, but their reads are still charged
+	meteringCtx.gasForExecution = 1000
+	keys, err := storageCtx.GetStorageKeysWithPrefix(iterateKeysName, nil, nil, 2)
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{[]byte("item1"), []byte("item3")}, keys)
+	require.Equal(t, 4*gasPerKey, mockRuntime.GetPointsUsed())
+
+	mockRuntime.SetPointsUsed(0)
+	meteringCtx.gasForExecution = 3 * gasPerKey
+	keys, err = storageCtx.GetStorageKeysWithPrefix(iterateKeysName, nil, nil, 2)
+	require.Nil(t, keys)
+	require.Equal(t, vmhost.ErrNotEnoughGas, err)
+	require.Equal(t, 3*gasPerKey, mockRuntime.GetPointsUsed())
+}
+
 func TestStorageContext_SetStorage(t *testing.T) {
 	t.Parallel()
 
@@ -442,6 +595,116 @@ func TestStorageContext_StorageProtection(t *testing.T) {
 	require.Len(t, storageCtx.GetStorageUpdates(address), 1)
 }
 
+func TestStorageContext_ApplyStorageAccounting(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("account")
+	depositAddress := []byte("deposit")
+
+	mockMetering := &contextmock.MeteringContextMock{}
+	mockMetering.SetGasSchedule(config.MakeGasMapForTests())
+	mockMetering.BlockGasLimitMock = uint64(15000)
+	mockMetering.GasLeftMock = 20000
+
+	enableEpochsHandler := &worldmock.EnableEpochsHandlerStub{
+		IsFlagEnabledCalled: func(flag core.EnableEpochFlag) bool {
+			return flag == vmhost.StorageAccountingFlag
+		},
+	}
+	host := &contextmock.VMHostMock{
+		MeteringContext:          mockMetering,
+		RuntimeContext:           &contextmock.RuntimeContextMock{},
+		EnableEpochsHandlerField: enableEpochsHandler,
+	}
+	host.OutputContext, _ = NewOutputContext(host)
+	account, _ := host.OutputContext.GetOutputAccount(address)
+	account.Balance = big.NewInt(15)
+
+	bcHook := &contextmock.BlockchainHookStub{}
+	host.BlockchainContext, _ = NewBlockchainContext(host, bcHook)
+	storageCtx, _ := NewStorageContext(host, bcHook, reservedTestPrefix)
+	storageCtx.SetAddress(address)
+	storageCtx.SetStorageDepositAddress(depositAddress)
+
+	storageUsedKey := storageCtx.GetVmProtectedPrefix(vmhost.StorageUsedKey)
+	depositKey := storageCtx.GetVmProtectedPrefix(vmhost.StorageDepositKey)
+	key := []byte("key")
+	outputAccounts := host.OutputContext.GetOutputAccounts()
+
+	// the deposit is moved to the deposit account for the added bytes
+	_, err := storageCtx.SetStorage(key, []byte("0123456789"))
+	require.Nil(t, err)
+	err = storageCtx.ApplyStorageAccounting()
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(-10), account.BalanceDelta)
+	require.Equal(t, big.NewInt(10), outputAccounts[string(depositAddress)].BalanceDelta)
+	require.Equal(t, []byte{10}, account.StorageUpdates[string(storageUsedKey)].Data)
+	require.Equal(t, []byte{10}, account.StorageUpdates[string(depositKey)].Data)
+
+	// the deposit is moved back for the removed bytes
+	account.BytesAddedToStorage = 0
+	_, err = storageCtx.SetStorage(key, []byte("0123"))
+	require.Nil(t, err)
+	err = storageCtx.ApplyStorageAccounting()
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(-4), account.BalanceDelta)
+	require.Equal(t, big.NewInt(4), outputAccounts[string(depositAddress)].BalanceDelta)
+	require.Equal(t, []byte{4}, account.StorageUpdates[string(storageUsedKey)].Data)
+	require.Equal(t, []byte{4}, account.StorageUpdates[string(depositKey)].Data)
+
+	// the balance must cover the deposit
+	account.BytesDeletedFromStorage = 0
+	_, err = storageCtx.SetStorage(key, []byte("01234567890123456789"))
+	require.Nil(t, err)
+	err = storageCtx.ApplyStorageAccounting()
+	require.Equal(t, vmhost.ErrNotEnoughStorageDeposit, err)
+	require.Equal(t, big.NewInt(-4), account.BalanceDelta)
+	require.Equal(t, big.NewInt(4), outputAccounts[string(depositAddress)].BalanceDelta)
+}
+
+func TestStorageContext_ApplyStorageAccountingToSystemAccount(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("account")
+
+	mockMetering := &contextmock.MeteringContextMock{}
+	mockMetering.SetGasSchedule(config.MakeGasMapForTests())
+	mockMetering.BlockGasLimitMock = uint64(15000)
+	mockMetering.GasLeftMock = 20000
+
+	host := &contextmock.VMHostMock{
+		MeteringContext: mockMetering,
+		RuntimeContext:  &contextmock.RuntimeContextMock{},
+		EnableEpochsHandlerField: &worldmock.EnableEpochsHandlerStub{
+			IsFlagEnabledCalled: func(flag core.EnableEpochFlag) bool {
+				return flag == vmhost.StorageAccountingFlag
+			},
+		},
+	}
+	host.OutputContext, _ = NewOutputContext(host)
+	account, _ := host.OutputContext.GetOutputAccount(address)
+	account.Balance = big.NewInt(15)
+
+	bcHook := &contextmock.BlockchainHookStub{}
+	host.BlockchainContext, _ = NewBlockchainContext(host, bcHook)
+	storageCtx, _ := NewStorageContext(host, bcHook, reservedTestPrefix)
+	storageCtx.SetAddress(address)
+	storageCtx.SetStorageDepositAddress(nil)
+
+	_, err := storageCtx.SetStorage([]byte("key"), []byte("012"))
+	require.Nil(t, err)
+	err = storageCtx.ApplyStorageAccounting()
+	require.Nil(t, err)
+
+	sumOfDeltas := big.NewInt(0)
+	for _, outputAccount := range host.OutputContext.GetOutputAccounts() {
+		sumOfDeltas.Add(sumOfDeltas, outputAccount.BalanceDelta)
+	}
+	require.Equal(t, big.NewInt(-3), account.BalanceDelta)
+	require.Equal(t, big.NewInt(3), host.OutputContext.GetOutputAccounts()[string(core.SystemAccountAddress)].BalanceDelta)
+	require.Zero(t, sumOfDeltas.Sign())
+}
+
 func TestStorageContext_GetStorageFromAddress(t *testing.T) {
 	t.Parallel()
 
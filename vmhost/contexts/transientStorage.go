@@ -0,0 +1,77 @@
+package contexts
+
+// transientStorage holds the values of the transient storage, by contract address and key,
+// along with a journal of the values overwritten, so that the writes of a failed nested
+// call are reverted without copying the transient storage for each call
+type transientStorage struct {
+	values  map[string]map[string][]byte
+	journal []transientStorageChange
+}
+
+// transientStorageChange records the value held by a key before it was set
+type transientStorageChange struct {
+	address   string
+	key       string
+	prevValue []byte
+}
+
+func newTransientStorage() *transientStorage {
+	return &transientStorage{
+		values:  make(map[string]map[string][]byte),
+		journal: make([]transientStorageChange, 0),
+	}
+}
+
+// get returns a copy of the value, so that it can be modified by the caller
+func (storage *transientStorage) get(address []byte, key []byte) []byte {
+	value, ok := storage.values[string(address)][string(key)]
+	if !ok {
+		return nil
+	}
+	return append(make([]byte, 0, len(value)), value...)
+}
+
+func (storage *transientStorage) set(address []byte, key []byte, value []byte) {
+	storage.journal = append(storage.journal, transientStorageChange{
+		address:   string(address),
+		key:       string(key),
+		prevValue: storage.values[string(address)][string(key)],
+	})
+
+	storage.setValue(string(address), string(key), append(make([]byte, 0, len(value)), value...))
+}
+
+// setValue stores the value without copying it, as the stored values are never modified
+// in place, which lets the journal keep the previous values without copying them either
+func (storage *transientStorage) setValue(address string, key string, value []byte) {
+	accountStorage, ok := storage.values[address]
+	if !ok {
+		accountStorage = make(map[string][]byte)
+		storage.values[address] = accountStorage
+	}
+
+	if len(value) == 0 {
+		delete(accountStorage, key)
+		return
+	}
+	accountStorage[key] = value
+}
+
+// checkpoint returns the position in the journal to which the transient storage can be reverted
+func (storage *transientStorage) checkpoint() int {
+	return len(storage.journal)
+}
+
+// revertToCheckpoint undoes, in reverse order, the changes journaled after the checkpoint
+func (storage *transientStorage) revertToCheckpoint(checkpoint int) {
+	for index := len(storage.journal) - 1; index >= checkpoint; index-- {
+		change := storage.journal[index]
+		storage.setValue(change.address, change.key, change.prevValue)
+	}
+	storage.journal = storage.journal[:checkpoint]
+}
+
+// clearJournal forgets the journaled changes, once no checkpoint can be reverted to anymore
+func (storage *transientStorage) clearJournal() {
+	storage.journal = storage.journal[:0]
+}
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/multiversx/mx-chain-vm-go/executor"
 	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/wasmmodule"
 )
 
 const allowedCharsInFunctionName = "abcdefghijklmnopqrstuvwxyz0123456789_"
@@ -34,6 +36,39 @@ func (validator *wasmValidator) verifyMemoryDeclaration(instance executor.Instan
 	return nil
 }
 
+func (validator *wasmValidator) verifyCodeLimits(code []byte, limits vmhost.CodeLimits) error {
+	if limits.MaxCodeSize > 0 && uint64(len(code)) > limits.MaxCodeSize {
+		return fmt.Errorf("%w: %d > %d", vmhost.ErrCodeSizeLimitExceeded, len(code), limits.MaxCodeSize)
+	}
+	if !limits.HasModuleLimits() {
+		return nil
+	}
+
+	module, err := wasmmodule.Parse(code)
+	if err != nil {
+		return fmt.Errorf("%w: %s", vmhost.ErrContractInvalid, err)
+	}
+
+	if limits.MaxFunctions > 0 && module.NumFunctions > limits.MaxFunctions {
+		return fmt.Errorf("%w: %d > %d", vmhost.ErrFunctionsLimitExceeded, module.NumFunctions, limits.MaxFunctions)
+	}
+	if limits.MaxImports > 0 && len(module.Imports) > int(limits.MaxImports) {
+		return fmt.Errorf("%w: %d > %d", vmhost.ErrImportsLimitExceeded, len(module.Imports), limits.MaxImports)
+	}
+	if limits.MaxTableSize > 0 {
+		for _, table := range module.Tables {
+			if table.Min > limits.MaxTableSize {
+				return fmt.Errorf("%w: %d > %d", vmhost.ErrTableSizeLimitExceeded, table.Min, limits.MaxTableSize)
+			}
+		}
+	}
+	if limits.MaxDataSegmentsSize > 0 && module.DataSegmentsSize > limits.MaxDataSegmentsSize {
+		return fmt.Errorf("%w: %d > %d", vmhost.ErrDataSegmentsSizeLimitExceeded, module.DataSegmentsSize, limits.MaxDataSegmentsSize)
+	}
+
+	return nil
+}
+
 func (validator *wasmValidator) verifyFunctions(instance executor.Instance) error {
 	for _, functionName := range instance.GetFunctionNames() {
 		err := validator.verifyValidFunctionName(functionName)
@@ -114,3 +149,76 @@ func validCharactersOnly(input string) bool {
 func isFirstCharacterNumeric(name string) bool {
 	return name[0] >= '0' && name[0] <= '9'
 }
+
+func (validator *wasmValidator) verifyCallbackName(
+	callbackName string,
+	isBuiltinFunctionName func(functionName string) bool,
+	hasFunction func(functionName string) bool,
+) error {
+	err := validator.verifyValidFunctionName(callbackName)
+	if err != nil {
+		return vmhost.ErrInvalidFunctionName
+	}
+	if callbackName == vmhost.InitFunctionName {
+		return vmhost.ErrInvalidFunctionName
+	}
+	if isBuiltinFunctionName(callbackName) {
+		return vmhost.ErrCannotUseBuiltinAsCallback
+	}
+	if !hasFunction(callbackName) {
+		return executor.ErrFuncNotFound
+	}
+
+	return nil
+}
+
+// CodeValidator verifies contract code outside of an execution, with the same rules as the runtime context
+type CodeValidator struct {
+	validator            *wasmValidator
+	builtInFuncContainer vmcommon.BuiltInFunctionContainer
+}
+
+// NewCodeValidator creates a new CodeValidator
+func NewCodeValidator(
+	scAPINames vmcommon.FunctionNames,
+	builtInFuncContainer vmcommon.BuiltInFunctionContainer,
+) (*CodeValidator, error) {
+	if check.IfNil(builtInFuncContainer) {
+		return nil, vmhost.ErrNilBuiltInFunctionsContainer
+	}
+
+	return &CodeValidator{
+		validator:            newWASMValidator(scAPINames, builtInFuncContainer),
+		builtInFuncContainer: builtInFuncContainer,
+	}, nil
+}
+
+// VerifyInstance verifies the memory declaration and the exported functions of the instance, as VerifyContractCode does
+func (codeValidator *CodeValidator) VerifyInstance(instance executor.Instance) error {
+	err := codeValidator.validator.verifyMemoryDeclaration(instance)
+	if err != nil {
+		return err
+	}
+
+	err = codeValidator.validator.verifyFunctions(instance)
+	if err != nil {
+		return err
+	}
+
+	return codeValidator.validator.verifyProtectedFunctions(instance)
+}
+
+// ValidateCallbackName verifies whether the function may be used as AsyncCall callback, as the runtime
+// context does, hasFunction telling whether the contract exports the function
+func (codeValidator *CodeValidator) ValidateCallbackName(callbackName string, hasFunction func(functionName string) bool) error {
+	return codeValidator.validator.verifyCallbackName(callbackName, codeValidator.isBuiltinFunctionName, hasFunction)
+}
+
+func (codeValidator *CodeValidator) isBuiltinFunctionName(functionName string) bool {
+	function, err := codeValidator.builtInFuncContainer.Get(functionName)
+	if err != nil {
+		return false
+	}
+
+	return function.IsActive()
+}
@@ -1,13 +1,16 @@
 package contexts
 
 import (
+	"os"
 	"strings"
 	"testing"
 
 	"github.com/multiversx/mx-chain-scenario-go/worldmock"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/multiversx/mx-chain-vm-common-go/builtInFunctions"
+	"github.com/multiversx/mx-chain-vm-go/executor"
 	contextmock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
 	"github.com/multiversx/mx-chain-vm-go/vmhost/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -70,3 +73,50 @@ func TestFunctionsProtected(t *testing.T) {
 	err := validator.verifyProtectedFunctions(instance)
 	require.NotNil(t, err)
 }
+
+func TestCodeValidator_ValidateCallbackName(t *testing.T) {
+	t.Parallel()
+
+	codeValidator, err := NewCodeValidator(testImportNames(), nil)
+	require.Nil(t, codeValidator)
+	require.Equal(t, vmhost.ErrNilBuiltInFunctionsContainer, err)
+
+	builtInFuncContainer := builtInFunctions.NewBuiltInFunctionContainer()
+	_ = builtInFuncContainer.Add("protocolFunctionFoo", &mock.BuiltInFunctionStub{})
+	codeValidator, err = NewCodeValidator(testImportNames(), builtInFuncContainer)
+	require.Nil(t, err)
+
+	exported := map[string]bool{"callBack": true, "init": true}
+	hasFunction := func(functionName string) bool {
+		return exported[functionName]
+	}
+
+	require.Nil(t, codeValidator.ValidateCallbackName("callBack", hasFunction))
+	require.Equal(t, vmhost.ErrInvalidFunctionName, codeValidator.ValidateCallbackName("init", hasFunction))
+	require.Equal(t, vmhost.ErrInvalidFunctionName, codeValidator.ValidateCallbackName("getArgument", hasFunction))
+	require.Equal(t, vmhost.ErrInvalidFunctionName, codeValidator.ValidateCallbackName("protocolFunctionFoo", hasFunction))
+	require.Equal(t, executor.ErrFuncNotFound, codeValidator.ValidateCallbackName("missing", hasFunction))
+}
+
+func TestWASMValidator_VerifyCodeLimits(t *testing.T) {
+	t.Parallel()
+
+	code, err := os.ReadFile("../../test/contracts/counter/output/counter.wasm")
+	require.Nil(t, err)
+	validator := newWASMValidator(testImportNames(), builtInFunctions.NewBuiltInFunctionContainer())
+
+	require.Nil(t, validator.verifyCodeLimits(code, vmhost.CodeLimits{}))
+	require.Nil(t, validator.verifyCodeLimits(code, vmhost.CodeLimits{
+		MaxCodeSize:         uint64(len(code)),
+		MaxFunctions:        5,
+		MaxImports:          3,
+		MaxTableSize:        1,
+		MaxDataSegmentsSize: 8,
+	}))
+
+	require.ErrorIs(t, validator.verifyCodeLimits(code, vmhost.CodeLimits{MaxCodeSize: uint64(len(code)) - 1}), vmhost.ErrCodeSizeLimitExceeded)
+	require.ErrorIs(t, validator.verifyCodeLimits(code, vmhost.CodeLimits{MaxFunctions: 4}), vmhost.ErrFunctionsLimitExceeded)
+	require.ErrorIs(t, validator.verifyCodeLimits(code, vmhost.CodeLimits{MaxImports: 2}), vmhost.ErrImportsLimitExceeded)
+	require.ErrorIs(t, validator.verifyCodeLimits([]byte("not wasm"), vmhost.CodeLimits{MaxImports: 2}), vmhost.ErrContractInvalid)
+	require.ErrorIs(t, validator.verifyCodeLimits(code, vmhost.CodeLimits{MaxDataSegmentsSize: 7}), vmhost.ErrDataSegmentsSizeLimitExceeded)
+}
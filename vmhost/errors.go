@@ -272,6 +272,9 @@ var ErrAsyncNoMultiLevel = errors.New("multi-level async calls are not allowed y
 // ErrAsyncNoCallbackForClosure signals that closure can't be obtained
 var ErrAsyncNoCallbackForClosure = errors.New("no callback for closure, cannot call callback directly")
 
+// ErrAsyncCallDeadlineExpired signals that the deadline of an async call passed before it was executed or sent
+var ErrAsyncCallDeadlineExpired = errors.New("async call deadline expired")
+
 // ErrVMIsClosing signals that vm is closing
 var ErrVMIsClosing = errors.New("vm is closing")
 
@@ -304,3 +307,54 @@ var ErrOpcodeIsNotAllowed = errors.New("opcode is not allowed")
 
 // ErrInvalidSignature signals that a signature verification failed
 var ErrInvalidSignature = errors.New("signature is invalid")
+
+// ErrCodeSizeLimitExceeded signals that the contract code is larger than allowed by the code validation policy
+var ErrCodeSizeLimitExceeded = errors.New("contract code size exceeds the limit")
+
+// ErrFunctionsLimitExceeded signals that the contract defines more functions than allowed by the code validation policy
+var ErrFunctionsLimitExceeded = errors.New("number of contract functions exceeds the limit")
+
+// ErrImportsLimitExceeded signals that the contract has more imports than allowed by the code validation policy
+var ErrImportsLimitExceeded = errors.New("number of contract imports exceeds the limit")
+
+// ErrTableSizeLimitExceeded signals that a table of the contract is larger than allowed by the code validation policy
+var ErrTableSizeLimitExceeded = errors.New("contract table size exceeds the limit")
+
+// ErrDataSegmentsSizeLimitExceeded signals that the data segments of the contract are larger than allowed by the code validation policy
+var ErrDataSegmentsSizeLimitExceeded = errors.New("contract data segments size exceeds the limit")
+
+// ErrDuplicateCodeLimitsEpoch signals that the code validation policy holds several limits for the same epoch
+var ErrDuplicateCodeLimitsEpoch = errors.New("duplicate epoch in code limits")
+
+// ErrUpgradeMissingCallbacks signals that the upgraded code no longer exports callbacks of pending async calls
+var ErrUpgradeMissingCallbacks = errors.New("upgraded code does not export the callbacks of pending async calls")
+
+// ErrNotEnoughStorageDeposit signals that a contract cannot pay the deposit for the storage it added
+var ErrNotEnoughStorageDeposit = errors.New("not enough balance for the storage deposit")
+
+// ErrTooManyLogs signals that a transaction writes more log entries than allowed by the log limits
+var ErrTooManyLogs = errors.New("too many log entries")
+
+// ErrTooManyLogTopics signals that a log entry has more topics than allowed by the log limits
+var ErrTooManyLogTopics = errors.New("too many topics in log entry")
+
+// ErrLogsSizeLimitExceeded signals that the logs of a transaction are larger than allowed by the log limits
+var ErrLogsSizeLimitExceeded = errors.New("log entries size exceeds the limit")
+
+// ErrNilQueryInput signals that a query was requested without input
+var ErrNilQueryInput = errors.New("nil query input")
+
+// ErrAsyncCallInQuery signals that a contract registered an async call while executing a query
+var ErrAsyncCallInQuery = errors.New("async calls are not allowed in queries")
+
+// ErrStorageIterationNotSupported signals that the BlockchainHook cannot iterate the storage of accounts
+var ErrStorageIterationNotSupported = errors.New("storage iteration is not supported by the blockchain hook")
+
+// ErrMissingESDTRole signals that the contract does not have the ESDT role required by an operation
+var ErrMissingESDTRole = errors.New("missing ESDT role")
+
+// ErrInvalidTokenNonce signals that an operation which requires an NFT or SFT was given a fungible token nonce
+var ErrInvalidTokenNonce = errors.New("invalid token nonce")
+
+// ErrInvalidRoyalties signals that the royalties are outside the accepted range
+var ErrInvalidRoyalties = errors.New("invalid royalties")
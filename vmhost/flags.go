@@ -11,4 +11,93 @@ const (
 
 	// UseGasBoundedShouldFailExecutionFlag defines the flag that activates failing of execution if gas bounded check fails
 	UseGasBoundedShouldFailExecutionFlag core.EnableEpochFlag = "UseGasBoundedShouldFailExecutionFlag"
+
+	// AsyncCallDeadlinesFlag defines the flag that activates the deadlines of async calls
+	AsyncCallDeadlinesFlag core.EnableEpochFlag = "AsyncCallDeadlinesFlag"
+
+	// UpgradeCompatibilityCheckFlag defines the flag that rejects upgrades dropping the callbacks of pending async calls
+	UpgradeCompatibilityCheckFlag core.EnableEpochFlag = "UpgradeCompatibilityCheckFlag"
+
+	// StorageIterationFlag defines the flag that activates the iteration of the contract storage by key prefix
+	StorageIterationFlag core.EnableEpochFlag = "StorageIterationFlag"
+
+	// StorageAccountingFlag defines the flag that activates the accounting of the storage used by contracts
+	StorageAccountingFlag core.EnableEpochFlag = "StorageAccountingFlag"
+
+	// StorageGasRefundFlag defines the flag that activates the capped gas refunds for releasing storage
+	StorageGasRefundFlag core.EnableEpochFlag = "StorageGasRefundFlag"
+
+	// TransientStorageFlag defines the flag that activates the per-transaction transient storage
+	TransientStorageFlag core.EnableEpochFlag = "TransientStorageFlag"
+
+	// LogLimitsFlag defines the flag that activates the log limits and the gas per log topic
+	LogLimitsFlag core.EnableEpochFlag = "LogLimitsFlag"
+
+	// ESDTMetadataUpdateFlag defines the flag that activates the managed hooks updating the metadata of NFTs
+	ESDTMetadataUpdateFlag core.EnableEpochFlag = "ESDTMetadataUpdateFlag"
+
+	// AccountQueriesFlag defines the flag that activates the managed hooks reading the code and nonce of other accounts
+	AccountQueriesFlag core.EnableEpochFlag = "AccountQueriesFlag"
 )
+
+// AllFlags must have all flags used by mx-chain-vm-go in the current version
+var AllFlags = []core.EnableEpochFlag{
+	CryptoOpcodesV2Flag,
+	MultiESDTNFTTransferAndExecuteByUserFlag,
+	UseGasBoundedShouldFailExecutionFlag,
+	AsyncCallDeadlinesFlag,
+	UpgradeCompatibilityCheckFlag,
+	StorageIterationFlag,
+	StorageAccountingFlag,
+	StorageGasRefundFlag,
+	TransientStorageFlag,
+	LogLimitsFlag,
+	ESDTMetadataUpdateFlag,
+	AccountQueriesFlag,
+}
+
+// VMHooksActivation lists the VM hooks which contracts may only import once the flag is active
+type VMHooksActivation struct {
+	Flag  core.EnableEpochFlag
+	Hooks []string
+}
+
+// VMHooksActivations holds all the VM hooks gated by an activation flag
+var VMHooksActivations = []VMHooksActivation{
+	{
+		Flag: CryptoOpcodesV2Flag,
+		Hooks: []string{
+			"managedVerifyBLSSignatureShare",
+			"managedVerifyBLSAggregatedSignature",
+			"managedVerifySecp256r1",
+			"managedGetOriginalCallerAddr",
+			"managedGetRelayerAddr",
+			"managedMultiTransferESDTNFTExecuteByUser",
+		},
+	},
+	{
+		Flag:  AsyncCallDeadlinesFlag,
+		Hooks: []string{"managedCreateAsyncCallWithDeadline"},
+	},
+	{
+		Flag:  StorageIterationFlag,
+		Hooks: []string{"mBufferStorageIterateKeys"},
+	},
+	{
+		Flag:  TransientStorageFlag,
+		Hooks: []string{"mBufferTransientStore", "mBufferTransientLoad"},
+	},
+	{
+		Flag:  ESDTMetadataUpdateFlag,
+		Hooks: []string{"managedUpdateNFTAttributes", "managedAddNFTURIs", "managedModifyNFTRoyalties"},
+	},
+	{
+		Flag: AccountQueriesFlag,
+		Hooks: []string{
+			"managedGetCodeHash",
+			"managedGetCodeMetadataFlags",
+			"managedGetAccountNonce",
+			"managedGetContractOwner",
+		},
+	},
+}
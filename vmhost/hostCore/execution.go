@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -15,6 +16,7 @@ import (
 	"github.com/multiversx/mx-chain-vm-go/math"
 	"github.com/multiversx/mx-chain-vm-go/vmhost"
 	"github.com/multiversx/mx-chain-vm-go/vmhost/contexts"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/upgradecheck"
 	"github.com/multiversx/mx-chain-vm-go/vmhost/vmhooks"
 )
 
@@ -76,7 +78,11 @@ func (host *vmHost) doRunSmartContractCreate(input *vmcommon.ContractCreateInput
 	return vmOutput
 }
 
-func (host *vmHost) performCodeDeployment(input vmhost.CodeDeployInput, initFunction func() error) (*vmcommon.VMOutput, error) {
+func (host *vmHost) performCodeDeployment(
+	input vmhost.CodeDeployInput,
+	checkCode func(input vmhost.CodeDeployInput) error,
+	initFunction func() error,
+) (*vmcommon.VMOutput, error) {
 	log.Trace("performCodeDeployment", "address", input.ContractAddress, "len(code)", len(input.ContractCode), "metadata", input.ContractCodeMetadata)
 
 	_, _, metering, output, runtime, _, _ := host.GetContexts()
@@ -87,6 +93,13 @@ func (host *vmHost) performCodeDeployment(input vmhost.CodeDeployInput, initFunc
 		return nil, err
 	}
 
+	if checkCode != nil {
+		err = checkCode(input)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	runtime.MustVerifyNextContractCode()
 
 	err = runtime.StartWasmerInstance(input.ContractCode, metering.GetGasForExecution(), true)
@@ -100,19 +113,25 @@ func (host *vmHost) performCodeDeployment(input vmhost.CodeDeployInput, initFunc
 		return nil, err
 	}
 
+	err = host.Storage().ApplyStorageAccounting()
+	if err != nil {
+		return nil, err
+	}
+
 	output.DeployCode(input)
 	output.RemoveNonUpdatedStorage()
 
 	vmOutput := output.GetVMOutput()
+	metering.ApplyGasRefund(vmOutput)
 	return vmOutput, nil
 }
 
 func (host *vmHost) performCodeDeploymentAtContractCreate(input vmhost.CodeDeployInput) (*vmcommon.VMOutput, error) {
-	return host.performCodeDeployment(input, host.callInitFunction)
+	return host.performCodeDeployment(input, nil, host.callInitFunction)
 }
 
 func (host *vmHost) performCodeDeploymentAtContractUpgrade(input vmhost.CodeDeployInput) (*vmcommon.VMOutput, error) {
-	return host.performCodeDeployment(input, host.callUpgradeFunction)
+	return host.performCodeDeployment(input, host.checkUpgradeCompatibility, host.callUpgradeFunction)
 }
 
 // doRunSmartContractUpgrade upgrades a contract directly
@@ -256,8 +275,16 @@ func (host *vmHost) doRunSmartContractCall(input *vmcommon.ContractCallInput) *v
 		return vmOutput
 	}
 
+	err = storage.ApplyStorageAccounting()
+	if err != nil {
+		log.Trace("doRunSmartContractCall storage accounting", "error", err)
+		vmOutput = output.CreateVMOutputInCaseOfError(err)
+		return vmOutput
+	}
+
 	output.RemoveNonUpdatedStorage()
 	vmOutput = output.GetVMOutput()
+	metering.ApplyGasRefund(vmOutput)
 	host.CompleteLogEntriesWithCallType(vmOutput, vmhost.DirectCallString)
 
 	log.Trace("doRunSmartContractCall finished",
@@ -292,6 +319,11 @@ func (host *vmHost) ExecuteOnDestContext(input *vmcommon.ContractCallInput) (vmO
 
 	scExecutionInput := input
 
+	host.asyncCallGraphTracer.BeginCall(input)
+	defer func() {
+		host.asyncCallGraphTracer.EndCall(vmOutput, err)
+	}()
+
 	blockchain := host.Blockchain()
 
 	blockchain.PushState()
@@ -781,6 +813,43 @@ func (host *vmHost) checkUpgradePermission(vmInput *vmcommon.ContractCallInput)
 	return vmhost.ErrUpgradeNotAllowed
 }
 
+// checkUpgradeCompatibility rejects the new code if it no longer exports the
+// callbacks of the async calls still pending for the upgraded contract, be they
+// in the current execution or persisted in the storage of the contract. Code
+// which cannot be parsed is left to the validation done at deployment.
+func (host *vmHost) checkUpgradeCompatibility(input vmhost.CodeDeployInput) error {
+	if !host.enableEpochsHandler.IsFlagEnabled(vmhost.UpgradeCompatibilityCheckFlag) {
+		return nil
+	}
+
+	async := host.Async()
+	pendingCallbacks := async.GetPendingCallbacks(input.ContractAddress)
+	persistedCallbacks, err := async.GetPersistedPendingCallbacks(input.ContractAddress)
+	if errors.Is(err, vmhost.ErrStorageIterationNotSupported) {
+		log.Debug("checkUpgradeCompatibility: persisted async contexts not checked", "address", input.ContractAddress, "error", err)
+		persistedCallbacks = nil
+		err = nil
+	}
+	if err != nil {
+		return err
+	}
+	pendingCallbacks = append(pendingCallbacks, persistedCallbacks...)
+	if len(pendingCallbacks) == 0 {
+		return nil
+	}
+
+	missingCallbacks, err := upgradecheck.MissingCallbacks(input.ContractCode, pendingCallbacks)
+	if err != nil {
+		log.Trace("checkUpgradeCompatibility", "error", err)
+		return nil
+	}
+	if len(missingCallbacks) > 0 {
+		return fmt.Errorf("%w: %s", vmhost.ErrUpgradeMissingCallbacks, strings.Join(missingCallbacks, ", "))
+	}
+
+	return nil
+}
+
 // executeUpgrade upgrades a contract indirectly (from another contract). This
 // function follows the convention of executeSmartContractCall().
 func (host *vmHost) executeUpgrade(input *vmcommon.ContractCallInput) error {
@@ -809,6 +878,11 @@ func (host *vmHost) executeUpgrade(input *vmcommon.ContractCallInput) error {
 		return err
 	}
 
+	err = host.checkUpgradeCompatibility(codeDeployInput)
+	if err != nil {
+		return err
+	}
+
 	runtime.MustVerifyNextContractCode()
 
 	err = runtime.StartWasmerInstance(codeDeployInput.ContractCode, metering.GetGasForExecution(), true)
@@ -37,13 +37,6 @@ var _ scenexec.VMInterface = (*vmHost)(nil)
 const minExecutionTimeout = time.Second
 const internalVMErrors = "internalVMErrors"
 
-// allFlags must have all flags used by mx-chain-vm-go in the current version
-var allFlags = []core.EnableEpochFlag{
-	vmhost.CryptoOpcodesV2Flag,
-	vmhost.MultiESDTNFTTransferAndExecuteByUserFlag,
-	vmhost.UseGasBoundedShouldFailExecutionFlag,
-}
-
 // vmHost implements HostContext interface.
 type vmHost struct {
 	cryptoHook       crypto.VMCrypto
@@ -61,6 +54,8 @@ type vmHost struct {
 	storageContext      vmhost.StorageContext
 	managedTypesContext vmhost.ManagedTypesContext
 
+	asyncCallGraphTracer vmhost.AsyncCallGraphTracer
+
 	gasSchedule          config.GasScheduleMap
 	builtInFuncContainer vmcommon.BuiltInFunctionContainer
 	esdtTransferParser   vmcommon.ESDTTransferParser
@@ -70,6 +65,7 @@ type vmHost struct {
 
 	transferLogIdentifiers    map[string]bool
 	mapOpcodeAddressIsAllowed map[string]map[string]struct{}
+	queryGasLimit             uint64
 }
 
 // NewVMHost creates a new VM vmHost
@@ -95,7 +91,7 @@ func NewVMHost(
 	if check.IfNil(hostParameters.EnableEpochsHandler) {
 		return nil, vmhost.ErrNilEnableEpochsHandler
 	}
-	err := core.CheckHandlerCompatibility(hostParameters.EnableEpochsHandler, allFlags)
+	err := core.CheckHandlerCompatibility(hostParameters.EnableEpochsHandler, vmhost.AllFlags)
 	if err != nil {
 		return nil, err
 	}
@@ -122,6 +118,7 @@ func NewVMHost(
 		blockchainContext:         nil,
 		storageContext:            nil,
 		managedTypesContext:       nil,
+		asyncCallGraphTracer:      contexts.NewDisabledAsyncCallGraphTracer(),
 		gasSchedule:               hostParameters.GasSchedule,
 		builtInFuncContainer:      hostParameters.BuiltInFuncContainer,
 		esdtTransferParser:        hostParameters.ESDTTransferParser,
@@ -129,6 +126,7 @@ func NewVMHost(
 		executionTimeout:          minExecutionTimeout,
 		enableEpochsHandler:       hostParameters.EnableEpochsHandler,
 		mapOpcodeAddressIsAllowed: hostParameters.MapOpcodeAddressIsAllowed,
+		queryGasLimit:             hostParameters.QueryGasLimit,
 	}
 	newExecutionTimeout := time.Duration(hostParameters.TimeOutForSCExecutionInMilliseconds) * time.Millisecond
 	if newExecutionTimeout > minExecutionTimeout {
@@ -186,6 +184,9 @@ func NewVMHost(
 	}
 
 	host.runtimeContext.SetMaxInstanceStackSize(MaximumRuntimeInstanceStackSize)
+	host.runtimeContext.SetCodeValidationPolicy(hostParameters.CodeValidationPolicy)
+	host.outputContext.SetLogLimits(hostParameters.LogLimits)
+	host.storageContext.SetStorageDepositAddress(hostParameters.StorageDepositAddress)
 
 	host.initContexts()
 	hostParameters.EpochNotifier.RegisterNotifyHandler(host)
@@ -375,6 +376,20 @@ func (host *vmHost) SetGasTracing(enableGasTracing bool) {
 	host.meteringContext.SetGasTracing(enableGasTracing)
 }
 
+// SetAsyncCallGraphTracer sets the tracer which builds the execution graph of
+// sync calls, async calls and callbacks; a nil tracer disables tracing
+func (host *vmHost) SetAsyncCallGraphTracer(tracer vmhost.AsyncCallGraphTracer) {
+	if check.IfNil(tracer) {
+		tracer = contexts.NewDisabledAsyncCallGraphTracer()
+	}
+	host.asyncCallGraphTracer = tracer
+}
+
+// AsyncCallGraphTracer returns the current execution graph tracer
+func (host *vmHost) AsyncCallGraphTracer() vmhost.AsyncCallGraphTracer {
+	return host.asyncCallGraphTracer
+}
+
 // RunSmartContractCreate executes the deployment of a new contract
 func (host *vmHost) RunSmartContractCreate(input *vmcommon.ContractCreateInput) (vmOutput *vmcommon.VMOutput, err error) {
 	err = validateVMInput(&input.VMInput)
@@ -479,6 +494,7 @@ func (host *vmHost) RunSmartContractCall(input *vmcommon.ContractCallInput) (vmO
 			close(done)
 		}()
 
+		host.asyncCallGraphTracer.BeginTransaction(input)
 		switch input.Function {
 		case vmhost.UpgradeFunctionName:
 			vmOutput = host.doRunSmartContractUpgrade(input)
@@ -492,6 +508,7 @@ func (host *vmHost) RunSmartContractCall(input *vmcommon.ContractCallInput) (vmO
 		if logsFromErrors != nil {
 			vmOutput.Logs = append(vmOutput.Logs, logsFromErrors)
 		}
+		host.asyncCallGraphTracer.EndTransaction(vmOutput)
 
 		log.Trace("RunSmartContractCall end",
 			"function", input.Function,
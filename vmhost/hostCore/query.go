@@ -0,0 +1,241 @@
+package hostCore
+
+import (
+	"context"
+	"errors"
+	"runtime/debug"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// ExecuteQuery calls a view function in read-only mode and returns only its
+// return data and the gas it used. Transfers, storage writes and async calls
+// fail the query. The queries reuse the contexts of the host, so they are
+// executed exclusively, one at a time and never during a transaction; several
+// hosts may serve queries in parallel from the same BlockchainHook snapshot.
+func (host *vmHost) ExecuteQuery(input *vmhost.QueryInput) (*vmhost.QueryOutput, error) {
+	host.mutExecution.Lock()
+	defer host.mutExecution.Unlock()
+
+	if host.closingInstance {
+		return nil, vmhost.ErrVMIsClosing
+	}
+
+	return host.runQuery(input)
+}
+
+// ExecuteQueries executes a batch of queries in a single session of the host,
+// one after another, so that the queries of the same contract reuse its warm
+// instance. A failed query does not stop the batch, each query having its own
+// result, in the order of the inputs.
+func (host *vmHost) ExecuteQueries(inputs []*vmhost.QueryInput) []*vmhost.QueryResult {
+	host.mutExecution.Lock()
+	defer host.mutExecution.Unlock()
+
+	results := make([]*vmhost.QueryResult, len(inputs))
+	for i, input := range inputs {
+		if host.closingInstance {
+			results[i] = &vmhost.QueryResult{Err: vmhost.ErrVMIsClosing}
+			continue
+		}
+
+		queryOutput, err := host.runQuery(input)
+		results[i] = &vmhost.QueryResult{
+			Output: queryOutput,
+			Err:    err,
+		}
+	}
+
+	return results
+}
+
+func (host *vmHost) runQuery(input *vmhost.QueryInput) (queryOutput *vmhost.QueryOutput, err error) {
+	if input == nil {
+		return nil, vmhost.ErrNilQueryInput
+	}
+	if len(input.Function) == 0 {
+		return nil, vmhost.ErrInvalidFunctionName
+	}
+
+	callInput := host.createQueryCallInput(input)
+	if callInput.GasProvided == 0 {
+		return nil, vmhost.ErrInvalidGasProvided
+	}
+	err = validateVMInput(&callInput.VMInput)
+	if err != nil {
+		return nil, err
+	}
+
+	host.setGasTracerEnabledIfLogIsTrace()
+	ctx, cancel := context.WithTimeout(context.Background(), host.executionTimeout)
+	defer cancel()
+
+	log.Trace("ExecuteQuery begin",
+		"contract", input.ContractAddr,
+		"function", input.Function,
+		"gasProvided", callInput.GasProvided)
+
+	var vmOutput *vmcommon.VMOutput
+	done := make(chan struct{})
+	go func() {
+		defer func() {
+			r := recover()
+			if r != nil {
+				log.Error("VM query panicked", "error", r, "stack", "\n"+string(debug.Stack()))
+				err = vmhost.ErrExecutionPanicked
+				host.Runtime().CleanInstance()
+			} else {
+				host.Runtime().EndExecution()
+			}
+
+			close(done)
+		}()
+
+		vmOutput, err = host.doRunQuery(callInput)
+
+		log.Trace("ExecuteQuery end",
+			"function", input.Function,
+			"error", err)
+		host.logFromGasTracer(input.Function)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		host.Runtime().FailExecution(vmhost.ErrExecutionFailedWithTimeout)
+		<-done
+		err = vmhost.ErrExecutionFailedWithTimeout
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &vmhost.QueryOutput{
+		ReturnData: vmOutput.ReturnData,
+		GasUsed:    callInput.GasProvided - vmOutput.GasRemaining,
+	}, nil
+}
+
+func (host *vmHost) createQueryCallInput(input *vmhost.QueryInput) *vmcommon.ContractCallInput {
+	callerAddr := input.CallerAddr
+	if len(callerAddr) == 0 {
+		callerAddr = input.ContractAddr
+	}
+
+	gasLimit := input.GasLimit
+	if host.queryGasLimit > 0 && (gasLimit == 0 || gasLimit > host.queryGasLimit) {
+		gasLimit = host.queryGasLimit
+	}
+
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  callerAddr,
+			Arguments:   input.Arguments,
+			CallValue:   vmhost.Zero,
+			CallType:    vm.DirectCall,
+			GasPrice:    0,
+			GasProvided: gasLimit,
+		},
+		RecipientAddr: input.ContractAddr,
+		Function:      input.Function,
+	}
+}
+
+func (host *vmHost) doRunQuery(input *vmcommon.ContractCallInput) (vmOutput *vmcommon.VMOutput, err error) {
+	host.InitState()
+
+	_, _, metering, output, runtime, async, storage := host.GetContexts()
+
+	defer func() {
+		if err != nil {
+			host.Runtime().CleanInstance()
+		}
+	}()
+
+	runtime.InitStateFromContractCallInput(input)
+	runtime.SetReadOnly(true)
+
+	err = async.InitStateFromInput(input)
+	if err != nil {
+		return nil, err
+	}
+	metering.InitStateFromContractCallInput(&input.VMInput)
+	storage.SetAddress(runtime.GetContextAddress())
+
+	err = host.checkGasForGetCode(input, metering)
+	if err != nil {
+		return nil, vmhost.ErrNotEnoughGas
+	}
+
+	contract, err := runtime.GetSCCode()
+	if err != nil {
+		return nil, vmhost.ErrContractNotFound
+	}
+
+	err = metering.DeductInitialGasForExecution(contract)
+	if err != nil {
+		return nil, vmhost.ErrNotEnoughGas
+	}
+
+	err = runtime.StartWasmerInstance(contract, metering.GetGasForExecution(), false)
+	if err != nil {
+		return nil, vmhost.ErrContractInvalid
+	}
+
+	err = host.callQueryFunction()
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput = output.GetVMOutput()
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, host.queryError(errors.New(vmOutput.ReturnMessage))
+	}
+
+	return vmOutput, nil
+}
+
+// callQueryFunction calls the view function like callFunctionAndExecuteAsync
+// does, but fails instead of executing the async calls it registered
+func (host *vmHost) callQueryFunction() error {
+	runtime := host.Runtime()
+
+	err := host.verifyAllowedFunctionCall()
+	if err != nil {
+		return err
+	}
+
+	functionName, err := runtime.FunctionNameChecked()
+	if err != nil {
+		return err
+	}
+
+	err = runtime.CallSCFunction(functionName)
+	if err != nil {
+		err = host.handleBreakpointIfAny(err)
+	}
+	if err == nil {
+		err = host.checkFinalGasAfterExit()
+	}
+	if err != nil {
+		return host.queryError(err)
+	}
+
+	if host.Async().HasPendingCallGroups() {
+		return vmhost.ErrAsyncCallInQuery
+	}
+
+	return nil
+}
+
+// queryError prefers the errors collected by the runtime, which hold the
+// cause of the failure, such as ErrCannotWriteOnReadOnly or the user error
+func (host *vmHost) queryError(err error) error {
+	runtimeErrors := host.GetRuntimeErrors()
+	if runtimeErrors != nil {
+		return runtimeErrors
+	}
+	return err
+}
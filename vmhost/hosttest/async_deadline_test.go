@@ -0,0 +1,114 @@
+package hostCoretest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	mock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	test "github.com/multiversx/mx-chain-vm-go/testcommon"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	deadlineChildKey    = []byte("childExecuted")
+	deadlineCallbackKey = []byte("callbackReturnCode")
+)
+
+const deadlineRound = 5
+
+func deadlineParentMock(instanceMock *mock.InstanceMock, _ interface{}) {
+	instanceMock.AddMockMethod("performAsyncCall", func() *mock.InstanceMock {
+		host := instanceMock.Host
+		instance := mock.GetMockInstance(host)
+		asyncCall := &vmhost.AsyncCall{
+			Status:          vmhost.AsyncCallPending,
+			Destination:     test.ChildAddress,
+			Data:            []byte("childMethod"),
+			ValueBytes:      big.NewInt(0).Bytes(),
+			GasLimit:        100_000,
+			GasLocked:       100_000,
+			SuccessCallback: "callBack",
+			ErrorCallback:   "callBack",
+			DeadlineRound:   deadlineRound,
+		}
+		err := host.Async().RegisterAsyncCall("group", asyncCall)
+		require.Nil(instance.T, err)
+		return instance
+	})
+	instanceMock.AddMockMethod("callBack", func() *mock.InstanceMock {
+		host := instanceMock.Host
+		instance := mock.GetMockInstance(host)
+		arguments := host.Runtime().Arguments()
+		_, _ = host.Storage().SetStorage(deadlineCallbackKey, arguments[0])
+		return instance
+	})
+}
+
+func deadlineChildMock(instanceMock *mock.InstanceMock, _ interface{}) {
+	instanceMock.AddMockMethod("childMethod", func() *mock.InstanceMock {
+		host := instanceMock.Host
+		instance := mock.GetMockInstance(host)
+		_, _ = host.Storage().SetStorage(deadlineChildKey, []byte{1})
+		return instance
+	})
+}
+
+func runAsyncCallWithDeadline(t *testing.T, round uint64) *vmcommon.VMOutput {
+	vmOutput, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(deadlineParentMock),
+			test.CreateMockContract(test.ChildAddress).
+				WithBalance(1000).
+				WithMethods(deadlineChildMock),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithCallerAddr(test.UserAddress).
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(1_000_000).
+			WithFunction("performAsyncCall").
+			Build()).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			if world.CurrentBlockInfo == nil {
+				world.CurrentBlockInfo = &worldmock.BlockInfo{}
+			}
+			world.CurrentBlockInfo.BlockRound = round
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok()
+		})
+	require.Nil(t, err)
+	return vmOutput
+}
+
+func storageUpdateValue(vmOutput *vmcommon.VMOutput, address []byte, key []byte) []byte {
+	outputAccount, exists := vmOutput.OutputAccounts[string(address)]
+	if !exists {
+		return nil
+	}
+	storageUpdate, exists := outputAccount.StorageUpdates[string(key)]
+	if !exists {
+		return nil
+	}
+	return storageUpdate.Data
+}
+
+func TestAsyncCallDeadline_BeforeDeadline(t *testing.T) {
+	vmOutput := runAsyncCallWithDeadline(t, deadlineRound)
+
+	require.Equal(t, []byte{1}, storageUpdateValue(vmOutput, test.ChildAddress, deadlineChildKey))
+	require.Equal(t, []byte{byte(vmcommon.Ok)}, storageUpdateValue(vmOutput, test.ParentAddress, deadlineCallbackKey))
+}
+
+func TestAsyncCallDeadline_Expired(t *testing.T) {
+	vmOutput := runAsyncCallWithDeadline(t, deadlineRound+1)
+
+	require.Nil(t, storageUpdateValue(vmOutput, test.ChildAddress, deadlineChildKey))
+	require.Equal(t,
+		[]byte{byte(vmhost.AsyncCallTimeoutReturnCode)},
+		storageUpdateValue(vmOutput, test.ParentAddress, deadlineCallbackKey))
+}
@@ -0,0 +1,116 @@
+package hostCoretest
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	mock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	test "github.com/multiversx/mx-chain-vm-go/testcommon"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/vmhooks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var logTopics = [][]byte{[]byte("topic1"), []byte("topic2"), []byte("topic3")}
+var logData = []byte("logData")
+
+func TestLogLimits_ChargesPerTopic(t *testing.T) {
+	writeLogWithLimits(t, worldmock.EnableEpochsHandlerStubAllFlags(), vmhost.LogLimits{}, true)
+}
+
+func TestLogLimits_DisabledByDefault(t *testing.T) {
+	writeLogWithLimits(t, nil, vmhost.LogLimits{MaxTopicsPerLog: 1}, false)
+}
+
+func TestLogLimits_TooManyTopics(t *testing.T) {
+	testConfig := makeTestConfig()
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(0).
+				WithConfig(nil).
+				WithMethods(writeLogMockContract)).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("writeLog").
+			Build()).
+		WithEnableEpochsHandler(worldmock.EnableEpochsHandlerStubAllFlags()).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			setZeroCodeCosts(host)
+			host.Output().SetLogLimits(vmhost.LogLimits{MaxTopicsPerLog: uint32(len(logTopics) - 1)})
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.
+				ExecutionFailed().
+				ReturnMessage(vmhost.ErrTooManyLogTopics.Error())
+		})
+	assert.Nil(t, err)
+}
+
+func writeLogWithLimits(
+	t *testing.T,
+	enableEpochsHandler vmhost.EnableEpochsHandler,
+	limits vmhost.LogLimits,
+	expectTopicsCharged bool,
+) {
+	testConfig := makeTestConfig()
+
+	logGas := uint64(10)
+	logPerTopicGas := uint64(5)
+
+	gasUsed := logGas
+	if expectTopicsCharged {
+		gasUsed += logPerTopicGas * uint64(len(logTopics))
+	}
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(0).
+				WithConfig(nil).
+				WithMethods(writeLogMockContract)).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("writeLog").
+			Build()).
+		WithEnableEpochsHandler(enableEpochsHandler).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			setZeroCodeCosts(host)
+			host.Metering().GasSchedule().BaseOpsAPICost.Log = logGas
+			host.Metering().GasSchedule().BaseOpsAPICost.LogPerTopic = logPerTopicGas
+			host.Output().SetLogLimits(limits)
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.
+				Ok().
+				GasUsed(test.ParentAddress, gasUsed).
+				GasRemaining(testConfig.GasProvided - gasUsed)
+			require.Len(t, verify.VmOutput.Logs, 1)
+			assert.Equal(t, logTopics, verify.VmOutput.Logs[0].Topics)
+		})
+	assert.Nil(t, err)
+}
+
+func writeLogMockContract(instanceMock *mock.InstanceMock, _ interface{}) {
+	instanceMock.AddMockMethod("writeLog", func() *mock.InstanceMock {
+		host := instanceMock.Host
+		managedTypes := host.ManagedTypes()
+
+		topicsHandle := managedTypes.NewManagedBuffer()
+		err := managedTypes.WriteManagedVecOfManagedBuffers(logTopics, topicsHandle)
+		if err != nil {
+			host.Runtime().FailExecution(err)
+			return instanceMock
+		}
+		dataHandle := managedTypes.NewManagedBufferFromBytes(logData)
+
+		vmHooksImpl := vmhooks.NewVMHooksImpl(host)
+		vmHooksImpl.ManagedWriteLog(topicsHandle, dataHandle)
+
+		return instanceMock
+	})
+}
@@ -1514,6 +1514,77 @@ func Test_ManagedGetCodeMetadata(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_ManagedAccountQueries(t *testing.T) {
+	testConfig := baseTestConfig
+
+	codeHash := []byte("child code hash")
+	metadata := []byte{vmcommon.MetadataUpgradeable | vmcommon.MetadataReadable, vmcommon.MetadataPayable}
+	expectedFlags := int64(vmhooks.CodeMetadataUpgradeable | vmhooks.CodeMetadataReadable | vmhooks.CodeMetadataPayable)
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(testConfig.ParentBalance).
+				WithConfig(testConfig).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+
+						managedTypes := host.ManagedTypes()
+						addressHandle := managedTypes.NewManagedBufferFromBytes(test.ChildAddress)
+						unknownAddressHandle := managedTypes.NewManagedBufferFromBytes(test.UserAddress2)
+						resultHandle := managedTypes.NewManagedBuffer()
+
+						vmhooks.ManagedGetCodeHashWithHost(host, addressHandle, resultHandle)
+						result, _ := managedTypes.GetBytes(resultHandle)
+						if !bytes.Equal(codeHash, result) {
+							host.Runtime().SignalUserError("assert failed: code hash")
+							return parentInstance
+						}
+
+						vmhooks.ManagedGetContractOwnerWithHost(host, addressHandle, resultHandle)
+						result, _ = managedTypes.GetBytes(resultHandle)
+						if !bytes.Equal(test.ParentAddress, result) {
+							host.Runtime().SignalUserError("assert failed: owner")
+							return parentInstance
+						}
+
+						flags := vmhooks.ManagedGetCodeMetadataFlagsWithHost(host, addressHandle)
+						if flags != expectedFlags {
+							host.Runtime().SignalUserError("assert failed: code metadata flags")
+							return parentInstance
+						}
+
+						flags = vmhooks.ManagedGetCodeMetadataFlagsWithHost(host, unknownAddressHandle)
+						nonce := vmhooks.ManagedGetAccountNonceWithHost(host, unknownAddressHandle)
+						if flags != 0 || nonce != 0 {
+							host.Runtime().SignalUserError("assert failed: unknown account")
+							return parentInstance
+						}
+
+						return parentInstance
+					})
+				}),
+			test.CreateMockContract(test.ChildAddress).
+				WithBalance(testConfig.ChildBalance).
+				WithConfig(testConfig).
+				WithCodeHash(codeHash).
+				WithCodeMetadata(metadata).
+				WithOwnerAddress(test.ParentAddress).
+				WithMethods(func(childInstance *mock.InstanceMock, config interface{}) {}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.
+				Ok()
+		})
+	assert.Nil(t, err)
+}
+
 func Test_ManagedIsBuiltinFunction(t *testing.T) {
 	testConfig := baseTestConfig
 
@@ -1566,6 +1637,53 @@ func Test_ManagedIsBuiltinFunction(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_ManagedModifyNFTRoyalties_MissingRole(t *testing.T) {
+	testConfig := makeTestConfig()
+	testConfig.GasProvided = 100_000
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(testConfig.ParentBalance).
+				WithConfig(testConfig).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+
+						managedTypes := host.ManagedTypes()
+						tokenIDHandle := managedTypes.NewManagedBufferFromBytes(test.ESDTTestTokenName)
+
+						vmhooks.UpdateESDTMetadataWithTypedArgs(
+							host,
+							"managedModifyNFTRoyalties",
+							core.ESDTModifyRoyalties,
+							vmhooks.RoleModifyRoyalties,
+							tokenIDHandle,
+							1,
+							[][]byte{big.NewInt(500).Bytes()})
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("testFunction").
+			Build()).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			createMockBuiltinFunctions(t, host, world)
+			parentAccount := world.AcctMap.GetAccount(test.ParentAddress)
+			_ = parentAccount.SetTokenRolesAsStrings(test.ESDTTestTokenName, []string{core.ESDTRoleNFTAddURI})
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.
+				ExecutionFailed().
+				ReturnMessageContains(vmhost.ErrMissingESDTRole.Error())
+		})
+	assert.Nil(t, err)
+}
+
 func Test_Direct_ManagedGetBackTransfers(t *testing.T) {
 	testConfig := makeTestConfig()
 	egldTransfer := big.NewInt(2)
@@ -0,0 +1,159 @@
+package hostCoretest
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	test "github.com/multiversx/mx-chain-vm-go/testcommon"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+func newQueryTestHost(t *testing.T) vmhost.VMHost {
+	code := test.GetTestSCCode("counter", "../../")
+	blockchainHook := test.BlockchainHookStubForCall(code, big.NewInt(0))
+	blockchainHook.GetStorageDataCalled = func(address []byte, key []byte) ([]byte, uint32, error) {
+		if bytes.Equal(address, test.ParentAddress) && bytes.Equal(key, counterKey) {
+			return big.NewInt(1001).Bytes(), 0, nil
+		}
+		return nil, 0, nil
+	}
+
+	return test.NewTestHostBuilder(t).
+		WithBlockchainHook(blockchainHook).
+		Build()
+}
+
+func TestExecuteQuery_ViewFunction(t *testing.T) {
+	host := newQueryTestHost(t)
+	defer host.Reset()
+
+	queryOutput, err := host.ExecuteQuery(&vmhost.QueryInput{
+		ContractAddr: test.ParentAddress,
+		Function:     get,
+		GasLimit:     1000000,
+	})
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{big.NewInt(1001).Bytes()}, queryOutput.ReturnData)
+	require.Greater(t, queryOutput.GasUsed, uint64(0))
+	require.Less(t, queryOutput.GasUsed, uint64(1000000))
+}
+
+func TestExecuteQuery_StorageWriteRejected(t *testing.T) {
+	host := newQueryTestHost(t)
+	defer host.Reset()
+
+	queryOutput, err := host.ExecuteQuery(&vmhost.QueryInput{
+		ContractAddr: test.ParentAddress,
+		Function:     increment,
+		GasLimit:     1000000,
+	})
+	require.Nil(t, queryOutput)
+	require.True(t, errors.Is(err, vmhost.ErrCannotWriteOnReadOnly))
+}
+
+func TestExecuteQuery_InvalidInput(t *testing.T) {
+	host := newQueryTestHost(t)
+	defer host.Reset()
+
+	_, err := host.ExecuteQuery(nil)
+	require.Equal(t, vmhost.ErrNilQueryInput, err)
+
+	_, err = host.ExecuteQuery(&vmhost.QueryInput{ContractAddr: test.ParentAddress})
+	require.Equal(t, vmhost.ErrInvalidFunctionName, err)
+
+	// without a QueryGasLimit on the host, the gas limit of the query is mandatory
+	_, err = host.ExecuteQuery(&vmhost.QueryInput{ContractAddr: test.ParentAddress, Function: get})
+	require.Equal(t, vmhost.ErrInvalidGasProvided, err)
+}
+
+func TestExecuteQuery_Concurrent(t *testing.T) {
+	host := newQueryTestHost(t)
+	defer host.Reset()
+
+	numQueries := 10
+	wg := sync.WaitGroup{}
+	wg.Add(numQueries)
+	for i := 0; i < numQueries; i++ {
+		go func() {
+			defer wg.Done()
+
+			queryOutput, err := host.ExecuteQuery(&vmhost.QueryInput{
+				ContractAddr: test.ParentAddress,
+				Function:     get,
+				GasLimit:     1000000,
+			})
+			require.Nil(t, err)
+			require.Equal(t, [][]byte{big.NewInt(1001).Bytes()}, queryOutput.ReturnData)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestExecuteQuery_ConcurrentWithCall(t *testing.T) {
+	host := newQueryTestHost(t)
+	defer host.Reset()
+
+	numRuns := 10
+	wg := sync.WaitGroup{}
+	wg.Add(2 * numRuns)
+	for i := 0; i < numRuns; i++ {
+		go func() {
+			defer wg.Done()
+
+			queryOutput, err := host.ExecuteQuery(&vmhost.QueryInput{
+				ContractAddr: test.ParentAddress,
+				Function:     get,
+				GasLimit:     1000000,
+			})
+			require.Nil(t, err)
+			require.Equal(t, [][]byte{big.NewInt(1001).Bytes()}, queryOutput.ReturnData)
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			input := test.DefaultTestContractCallInput()
+			input.Function = increment
+			input.GasProvided = 1000000
+			vmOutput, err := host.RunSmartContractCall(input)
+			require.Nil(t, err)
+			require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+
+			storedCounter := vmOutput.OutputAccounts[string(test.ParentAddress)].StorageUpdates[string(counterKey)]
+			require.Equal(t, big.NewInt(1002).Bytes(), storedCounter.Data)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestExecuteQueries_FailedQueryDoesNotAbortBatch(t *testing.T) {
+	host := newQueryTestHost(t)
+	defer host.Reset()
+
+	results := host.ExecuteQueries([]*vmhost.QueryInput{
+		{ContractAddr: test.ParentAddress, Function: get, GasLimit: 1000000},
+		{ContractAddr: test.ParentAddress, Function: increment, GasLimit: 1000000},
+		nil,
+		{ContractAddr: test.ParentAddress, Function: get, GasLimit: 1000000},
+	})
+	require.Len(t, results, 4)
+
+	require.Nil(t, results[0].Err)
+	require.Equal(t, [][]byte{big.NewInt(1001).Bytes()}, results[0].Output.ReturnData)
+
+	require.Nil(t, results[1].Output)
+	require.True(t, errors.Is(results[1].Err, vmhost.ErrCannotWriteOnReadOnly))
+
+	require.Equal(t, vmhost.ErrNilQueryInput, results[2].Err)
+
+	require.Nil(t, results[3].Err)
+	require.Equal(t, results[0].Output.ReturnData, results[3].Output.ReturnData)
+
+	err := host.Runtime().ValidateInstances()
+	require.Nil(t, err)
+}
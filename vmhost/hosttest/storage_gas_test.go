@@ -3,6 +3,7 @@ package hostCoretest
 import (
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-scenario-go/worldmock"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/multiversx/mx-chain-vm-go/mock/contracts"
@@ -180,6 +181,111 @@ func setStorage(t *testing.T, key []byte) {
 	assert.Nil(t, err)
 }
 
+func TestStorageAccounting_ChargesDeposit(t *testing.T) {
+	storageAccounting(t, worldmock.EnableEpochsHandlerStubAllFlags(), true)
+}
+
+func TestStorageAccounting_DisabledByDefault(t *testing.T) {
+	storageAccounting(t, nil, false)
+}
+
+func storageAccounting(t *testing.T, enableEpochsHandler vmhost.EnableEpochsHandler, expectDeposit bool) {
+	testConfig := makeTestConfig()
+	storageDepositPerByte := uint64(2)
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithConfig(nil).
+				WithMethods(contracts.SetStore)).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("setStore").
+			WithArguments(smallKey, []byte("testValue")).
+			Build()).
+		WithEnableEpochsHandler(enableEpochsHandler).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			setZeroCodeCosts(host)
+			host.Metering().GasSchedule().BaseOperationCost.StorageDepositPerByte = storageDepositPerByte
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok()
+
+			parentAccount := verify.VmOutput.OutputAccounts[string(test.ParentAddress)]
+			expectedDeposit := int64(0)
+			if expectDeposit {
+				expectedDeposit = int64(parentAccount.BytesAddedToStorage * storageDepositPerByte)
+			}
+			assert.NotZero(t, parentAccount.BytesAddedToStorage)
+			verify.BalanceDelta(test.ParentAddress, -expectedDeposit)
+			if expectDeposit {
+				verify.BalanceDelta(core.SystemAccountAddress, expectedDeposit)
+			} else {
+				assert.NotContains(t, verify.VmOutput.OutputAccounts, string(core.SystemAccountAddress))
+			}
+		})
+	assert.Nil(t, err)
+}
+
+func TestStorageGasRefund_DeleteStorage(t *testing.T) {
+	deleteStorageWithRefund(t, worldmock.EnableEpochsHandlerStubAllFlags(), true)
+}
+
+func TestStorageGasRefund_DisabledByDefault(t *testing.T) {
+	deleteStorageWithRefund(t, nil, false)
+}
+
+func deleteStorageWithRefund(t *testing.T, enableEpochsHandler vmhost.EnableEpochsHandler, expectRefund bool) {
+	testConfig := makeTestConfig()
+	value := []byte("testValue")
+
+	storageStoreGas := uint64(10)
+	releasePerByte := uint64(1)
+	clearStorageRefund := uint64(100)
+	maxGasRefundQuotient := uint64(2)
+
+	gasUsed := 2 * storageStoreGas
+	gasRefund := releasePerByte * uint64(len(value))
+	if expectRefund {
+		// the refund exceeds the cap, so only half of the gas used is refunded
+		gasUsed -= gasUsed / maxGasRefundQuotient
+		gasRefund = 0
+	}
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(0).
+				WithConfig(nil).
+				WithMethods(contracts.SetStore)).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("setStore").
+			WithArguments(smallKey, []byte{}).
+			Build()).
+		WithEnableEpochsHandler(enableEpochsHandler).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			setZeroCodeCosts(host)
+			host.Metering().GasSchedule().BaseOpsAPICost.StorageStore = storageStoreGas
+			host.Metering().GasSchedule().BaseOperationCost.ReleasePerByte = releasePerByte
+			host.Metering().GasSchedule().BaseOperationCost.ClearStorageRefund = clearStorageRefund
+			host.Metering().GasSchedule().BaseOperationCost.MaxGasRefundQuotient = maxGasRefundQuotient
+
+			world.AcctMap[string(test.ParentAddress)].Storage[string(smallKey)] = value
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.
+				Ok().
+				GasUsed(test.ParentAddress, gasUsed).
+				GasRemaining(testConfig.GasProvided - gasUsed)
+			assert.Equal(t, gasRefund, verify.VmOutput.GasRefund.Uint64())
+		})
+	assert.Nil(t, err)
+}
+
 var expectedAddByParent = len(contracts.TestStorageValue1) +
 	len(contracts.TestStorageValue2)
 
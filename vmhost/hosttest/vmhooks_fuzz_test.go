@@ -0,0 +1,413 @@
+package hostCoretest
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	"github.com/multiversx/mx-chain-vm-go/config"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	mock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	test "github.com/multiversx/mx-chain-vm-go/testcommon"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/vmhooks"
+	"github.com/stretchr/testify/require"
+)
+
+// Native Go fuzz targets calling VMHooksImpl directly from a mock contract.
+// Run one of them with e.g.:
+//
+//	go test ./vmhost/hosttest -run ^$ -fuzz FuzzVMHooks_ManagedBuffers -fuzztime 1m
+
+const (
+	fuzzFunction          = "fuzzHook"
+	fuzzGasProvided       = uint64(100_000_000)
+	fuzzMaxDataLength     = 64
+	fuzzSafeMemoryMargin  = 256
+	fuzzSeedSmallHandle   = int32(0)
+	fuzzSeedInvalidHandle = int32(-100)
+)
+
+type hookFuzzArgs struct {
+	handle1 int32
+	handle2 int32
+	handle3 int32
+	offset  executor.MemPtr
+	length  executor.MemLength
+}
+
+type hookFuzzCase struct {
+	name string
+	// memoryFirst marks the hooks that access the memory before touching any
+	// handle, thus failing with ErrMemoryBadBounds on every bad offset
+	memoryFirst bool
+	call        func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs)
+}
+
+func addHookFuzzSeeds(f *testing.F, numCases int) {
+	data := []byte("fuzzing the vm hooks")
+	for op := 0; op < numCases; op++ {
+		f.Add(uint8(op), fuzzSeedSmallHandle, int32(1), int32(2), int32(0), int32(len(data)), data)
+		f.Add(uint8(op), int32(1), fuzzSeedSmallHandle, fuzzSeedInvalidHandle, int32(-1), int32(8), data)
+		f.Add(uint8(op), fuzzSeedInvalidHandle, int32(1), fuzzSeedSmallHandle, int32(1<<20), int32(-1), []byte{})
+	}
+}
+
+func fuzzHooks(f *testing.F, cases []hookFuzzCase) {
+	addHookFuzzSeeds(f, len(cases))
+	f.Fuzz(func(t *testing.T, op uint8, handle1, handle2, handle3, offset, length int32, data []byte) {
+		if len(data) > fuzzMaxDataLength {
+			data = data[:fuzzMaxDataLength]
+		}
+
+		hookCase := cases[int(op)%len(cases)]
+		args := &hookFuzzArgs{
+			handle1: handle1,
+			handle2: handle2,
+			handle3: handle3,
+			offset:  executor.MemPtr(offset),
+			length:  length,
+		}
+		runHookFuzzCase(t, hookCase, args, data)
+	})
+}
+
+func runHookFuzzCase(t *testing.T, hookCase hookFuzzCase, args *hookFuzzArgs, data []byte) {
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(instanceMock *mock.InstanceMock, _ interface{}) {
+					instanceMock.AddMockMethod(fuzzFunction, func() *mock.InstanceMock {
+						host := instanceMock.Host
+						instance := mock.GetMockInstance(host)
+						prepareHookFuzzState(t, host, instance, data)
+						callHookAndCheckInvariants(t, host, instance, hookCase, args)
+						return instance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithCallerAddr(test.UserAddress).
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(fuzzGasProvided).
+			WithFunction(fuzzFunction).
+			Build()).
+		AndAssertResults(func(_ *worldmock.MockWorld, _ *test.VMOutputVerifier) {})
+	require.Nil(t, err)
+}
+
+// prepareHookFuzzState creates a few values of each managed type, so that
+// small handles generated by the fuzzer point to existing values
+func prepareHookFuzzState(t *testing.T, host vmhost.VMHost, instance *mock.InstanceMock, data []byte) {
+	// the managed map costs are not read from the gas schedule, they need to be
+	// set explicitly for the gas invariant to be observable
+	host.Metering().GasSchedule().ManagedMapAPICost = config.ManagedMapAPICost{
+		ManagedMapNew:      config.GasValueForTests,
+		ManagedMapPut:      config.GasValueForTests,
+		ManagedMapGet:      config.GasValueForTests,
+		ManagedMapRemove:   config.GasValueForTests,
+		ManagedMapContains: config.GasValueForTests,
+	}
+
+	managedTypes := host.ManagedTypes()
+	managedTypes.NewManagedBufferFromBytes(data)
+	managedTypes.NewManagedBufferFromBytes(data[:len(data)/2])
+	managedTypes.NewManagedBuffer()
+
+	managedTypes.NewBigInt(big.NewInt(0).SetBytes(data))
+	managedTypes.NewBigIntFromInt64(int64(len(data)) - 10)
+	managedTypes.NewBigIntFromInt64(0)
+
+	_, err := managedTypes.PutBigFloat(big.NewFloat(float64(len(data)) / 3))
+	require.Nil(t, err)
+	_, err = managedTypes.PutBigFloat(big.NewFloat(-2))
+	require.Nil(t, err)
+
+	managedTypes.NewManagedMap()
+
+	require.Nil(t, instance.MemStore(0, data))
+}
+
+func callHookAndCheckInvariants(
+	t *testing.T,
+	host vmhost.VMHost,
+	instance *mock.InstanceMock,
+	hookCase hookFuzzCase,
+	args *hookFuzzArgs,
+) {
+	metering := host.Metering()
+	gasLeftBefore := metering.GasLeft()
+	memLength := int64(instance.MemLength())
+
+	func() {
+		defer func() {
+			r := recover()
+			if r != nil {
+				t.Fatalf("hook %s panicked: %v", hookCase.name, r)
+			}
+		}()
+		hookCase.call(vmhooks.NewVMHooksImpl(host), args)
+	}()
+
+	require.Less(t, metering.GasLeft(), gasLeftBefore, "hook %s did not charge gas", hookCase.name)
+	require.Equal(t, memLength, int64(instance.MemLength()), "hook %s resized the memory", hookCase.name)
+
+	allErrors := host.Runtime().GetAllErrors()
+	offset := int64(args.offset)
+	length := int64(args.length)
+
+	isAccessInBounds := offset >= 0 && length >= 0 && length <= fuzzSafeMemoryMargin &&
+		offset+fuzzSafeMemoryMargin <= memLength
+	if isAccessInBounds {
+		require.False(t, containsError(allErrors, executor.ErrMemoryBadBounds),
+			"hook %s failed with bad bounds for offset %d and length %d", hookCase.name, offset, length)
+	}
+
+	isOffsetOutOfBounds := offset < 0 || offset > memLength
+	if hookCase.memoryFirst && isOffsetOutOfBounds && length > 0 && !containsError(allErrors, vmhost.ErrNotEnoughGas) {
+		require.True(t, containsError(allErrors, executor.ErrMemoryBadBounds),
+			"hook %s accepted offset %d", hookCase.name, offset)
+	}
+}
+
+func containsError(allErrors error, target error) bool {
+	wrappedErrors, ok := allErrors.(vmhost.WrappableError)
+	if !ok {
+		return errors.Is(allErrors, target)
+	}
+	for _, err := range wrappedErrors.GetAllErrors() {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+var manBufOpsFuzzCases = []hookFuzzCase{
+	{name: "MBufferNewFromBytes", memoryFirst: true, call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.MBufferNewFromBytes(args.offset, args.length)
+	}},
+	{name: "MBufferGetLength", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.MBufferGetLength(args.handle1)
+	}},
+	{name: "MBufferGetBytes", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.MBufferGetBytes(args.handle1, args.offset)
+	}},
+	{name: "MBufferGetByteSlice", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.MBufferGetByteSlice(args.handle1, args.handle2, args.length, args.offset)
+	}},
+	{name: "MBufferCopyByteSlice", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.MBufferCopyByteSlice(args.handle1, args.handle2, args.length, args.handle3)
+	}},
+	{name: "MBufferEq", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.MBufferEq(args.handle1, args.handle2)
+	}},
+	{name: "MBufferSetBytes", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.MBufferSetBytes(args.handle1, args.offset, args.length)
+	}},
+	{name: "MBufferSetByteSlice", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.MBufferSetByteSlice(args.handle1, args.handle2, args.length, args.offset)
+	}},
+	{name: "MBufferAppend", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.MBufferAppend(args.handle1, args.handle2)
+	}},
+	{name: "MBufferAppendBytes", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.MBufferAppendBytes(args.handle1, args.offset, args.length)
+	}},
+	{name: "MBufferToBigIntSigned", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.MBufferToBigIntSigned(args.handle1, args.handle2)
+	}},
+	{name: "MBufferFromBigIntUnsigned", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.MBufferFromBigIntUnsigned(args.handle1, args.handle2)
+	}},
+	{name: "MBufferToBigFloat", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.MBufferToBigFloat(args.handle1, args.handle2)
+	}},
+	{name: "MBufferFromBigFloat", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.MBufferFromBigFloat(args.handle1, args.handle2)
+	}},
+	{name: "MBufferStorageStore", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.MBufferStorageStore(args.handle1, args.handle2)
+	}},
+	{name: "MBufferStorageLoad", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.MBufferStorageLoad(args.handle1, args.handle2)
+	}},
+	{name: "MBufferStorageIterateKeys", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.MBufferStorageIterateKeys(args.handle1, args.handle2, args.length, args.handle3)
+	}},
+	{name: "MBufferTransientStore", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.MBufferTransientStore(args.handle1, args.handle2)
+	}},
+	{name: "MBufferTransientLoad", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.MBufferTransientLoad(args.handle1, args.handle2)
+	}},
+	{name: "MBufferFinish", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.MBufferFinish(args.handle1)
+	}},
+	{name: "MBufferSetRandom", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.MBufferSetRandom(args.handle1, args.length)
+	}},
+}
+
+var bigIntOpsFuzzCases = []hookFuzzCase{
+	{name: "BigIntSetUnsignedBytes", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigIntSetUnsignedBytes(args.handle1, args.offset, args.length)
+	}},
+	{name: "BigIntSetSignedBytes", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigIntSetSignedBytes(args.handle1, args.offset, args.length)
+	}},
+	{name: "BigIntGetUnsignedBytes", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigIntGetUnsignedBytes(args.handle1, args.offset)
+	}},
+	{name: "BigIntGetSignedBytes", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigIntGetSignedBytes(args.handle1, args.offset)
+	}},
+	{name: "BigIntGetInt64", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigIntGetInt64(args.handle1)
+	}},
+	{name: "BigIntAdd", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigIntAdd(args.handle1, args.handle2, args.handle3)
+	}},
+	{name: "BigIntMul", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigIntMul(args.handle1, args.handle2, args.handle3)
+	}},
+	{name: "BigIntTDiv", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigIntTDiv(args.handle1, args.handle2, args.handle3)
+	}},
+	{name: "BigIntEMod", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigIntEMod(args.handle1, args.handle2, args.handle3)
+	}},
+	{name: "BigIntSqrt", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigIntSqrt(args.handle1, args.handle2)
+	}},
+	{name: "BigIntPow", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigIntPow(args.handle1, args.handle2, args.handle3)
+	}},
+	{name: "BigIntLog2", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigIntLog2(args.handle1)
+	}},
+	{name: "BigIntNot", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigIntNot(args.handle1, args.handle2)
+	}},
+	{name: "BigIntAnd", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigIntAnd(args.handle1, args.handle2, args.handle3)
+	}},
+	{name: "BigIntShr", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigIntShr(args.handle1, args.handle2, args.length)
+	}},
+	{name: "BigIntShl", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigIntShl(args.handle1, args.handle2, args.length)
+	}},
+	{name: "BigIntToString", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigIntToString(args.handle1, args.handle2)
+	}},
+	{name: "BigIntStorageStoreUnsigned", memoryFirst: true, call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigIntStorageStoreUnsigned(args.offset, args.length, args.handle1)
+	}},
+}
+
+var bigFloatOpsFuzzCases = []hookFuzzCase{
+	{name: "BigFloatNewFromParts", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigFloatNewFromParts(args.handle1, args.handle2, args.handle3)
+	}},
+	{name: "BigFloatNewFromFrac", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigFloatNewFromFrac(int64(args.handle1), int64(args.handle2))
+	}},
+	{name: "BigFloatNewFromSci", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigFloatNewFromSci(int64(args.handle1), int64(args.length))
+	}},
+	{name: "BigFloatAdd", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigFloatAdd(args.handle1, args.handle2, args.handle3)
+	}},
+	{name: "BigFloatMul", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigFloatMul(args.handle1, args.handle2, args.handle3)
+	}},
+	{name: "BigFloatDiv", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigFloatDiv(args.handle1, args.handle2, args.handle3)
+	}},
+	{name: "BigFloatSqrt", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigFloatSqrt(args.handle1, args.handle2)
+	}},
+	{name: "BigFloatPow", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigFloatPow(args.handle1, args.handle2, args.length)
+	}},
+	{name: "BigFloatFloor", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigFloatFloor(args.handle1, args.handle2)
+	}},
+	{name: "BigFloatTruncate", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigFloatTruncate(args.handle1, args.handle2)
+	}},
+	{name: "BigFloatCmp", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigFloatCmp(args.handle1, args.handle2)
+	}},
+	{name: "BigFloatSetBigInt", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigFloatSetBigInt(args.handle1, args.handle2)
+	}},
+	{name: "BigFloatGetConstPi", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.BigFloatGetConstPi(args.handle1)
+	}},
+}
+
+var manMapOpsFuzzCases = []hookFuzzCase{
+	{name: "ManagedMapNew", call: func(hooks *vmhooks.VMHooksImpl, _ *hookFuzzArgs) {
+		hooks.ManagedMapNew()
+	}},
+	{name: "ManagedMapPut", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.ManagedMapPut(args.handle1, args.handle2, args.handle3)
+	}},
+	{name: "ManagedMapGet", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.ManagedMapGet(args.handle1, args.handle2, args.handle3)
+	}},
+	{name: "ManagedMapRemove", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.ManagedMapRemove(args.handle1, args.handle2, args.handle3)
+	}},
+	{name: "ManagedMapContains", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.ManagedMapContains(args.handle1, args.handle2)
+	}},
+}
+
+var cryptoeiFuzzCases = []hookFuzzCase{
+	{name: "Sha256", memoryFirst: true, call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.Sha256(args.offset, args.length, args.offset)
+	}},
+	{name: "Keccak256", memoryFirst: true, call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.Keccak256(args.offset, args.length, args.offset)
+	}},
+	{name: "Ripemd160", memoryFirst: true, call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.Ripemd160(args.offset, args.length, args.offset)
+	}},
+	{name: "ManagedSha256", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.ManagedSha256(args.handle1, args.handle2)
+	}},
+	{name: "ManagedKeccak256", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.ManagedKeccak256(args.handle1, args.handle2)
+	}},
+	{name: "VerifyEd25519", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.VerifyEd25519(args.offset, args.offset, args.length, args.offset)
+	}},
+	{name: "ManagedVerifyEd25519", call: func(hooks *vmhooks.VMHooksImpl, args *hookFuzzArgs) {
+		hooks.ManagedVerifyEd25519(args.handle1, args.handle2, args.handle3)
+	}},
+}
+
+func FuzzVMHooks_ManagedBuffers(f *testing.F) {
+	fuzzHooks(f, manBufOpsFuzzCases)
+}
+
+func FuzzVMHooks_BigInts(f *testing.F) {
+	fuzzHooks(f, bigIntOpsFuzzCases)
+}
+
+func FuzzVMHooks_BigFloats(f *testing.F) {
+	fuzzHooks(f, bigFloatOpsFuzzCases)
+}
+
+func FuzzVMHooks_ManagedMaps(f *testing.F) {
+	fuzzHooks(f, manMapOpsFuzzCases)
+}
+
+func FuzzVMHooks_Crypto(f *testing.F) {
+	fuzzHooks(f, cryptoeiFuzzCases)
+}
@@ -0,0 +1,108 @@
+package hostCoretest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	test "github.com/multiversx/mx-chain-vm-go/testcommon"
+	"github.com/multiversx/mx-chain-vm-go/testcommon/testexecutor"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/wasmer"
+	"github.com/multiversx/mx-chain-vm-go/wasmer2"
+	"github.com/stretchr/testify/require"
+)
+
+const wasmModuleFuzzGasProvided = uint64(1_000_000)
+
+type wasmModuleFuzzHost struct {
+	name string
+	host vmhost.VMHost
+}
+
+// FuzzWasmModule deploys mutations of the test contracts, which go through
+// StartWasmerInstance and VerifyContractCode. The only acceptable outcomes are
+// a clean rejection of the code or a normal execution of its init function.
+// Both executors must agree on accepting or rejecting the code; since Wasmer 1
+// cannot be instantiated next to the default Wasmer 2 executor, the comparison
+// only happens when running with VMEXECUTOR=wasmer1.
+//
+// Failing inputs are minimized by the Go toolchain and saved under
+// testdata/fuzz/FuzzWasmModule, where they become part of the seed corpus:
+//
+//	VMEXECUTOR=wasmer1 go test ./vmhost/hosttest -run ^$ -fuzz FuzzWasmModule -fuzztime 10m
+func FuzzWasmModule(f *testing.F) {
+	addWasmModuleFuzzSeeds(f)
+
+	hosts := []*wasmModuleFuzzHost{
+		newWasmModuleFuzzHost(f, "wasmer2", wasmer2.ExecutorFactory()),
+	}
+	if testexecutor.IsWasmer1Allowed() {
+		hosts = append(hosts, newWasmModuleFuzzHost(f, "wasmer1", wasmer.ExecutorFactory()))
+	}
+
+	f.Fuzz(func(t *testing.T, code []byte) {
+		accepted := make(map[string]bool, len(hosts))
+		for _, fuzzHost := range hosts {
+			accepted[fuzzHost.name] = deployFuzzedModule(t, fuzzHost, code)
+		}
+
+		for _, fuzzHost := range hosts[1:] {
+			require.Equal(t, accepted[hosts[0].name], accepted[fuzzHost.name],
+				"%s and %s disagree on accepting the code", hosts[0].name, fuzzHost.name)
+		}
+	})
+}
+
+func addWasmModuleFuzzSeeds(f *testing.F) {
+	paths, err := filepath.Glob("../../test/contracts/*/output/*.wasm")
+	require.Nil(f, err)
+	require.NotEmpty(f, paths)
+
+	for _, path := range paths {
+		code, err := os.ReadFile(path)
+		require.Nil(f, err)
+		f.Add(code)
+	}
+}
+
+func newWasmModuleFuzzHost(f *testing.F, name string, executorFactory executor.ExecutorAbstractFactory) *wasmModuleFuzzHost {
+	world := worldmock.NewMockWorld()
+	world.NewAddressMocks = append(world.NewAddressMocks, &worldmock.NewAddressMock{
+		CreatorAddress: test.UserAddress,
+		CreatorNonce:   0,
+		NewAddress:     test.ParentAddress,
+	})
+	world.AcctMap.CreateAccount(test.UserAddress, world)
+
+	host := test.NewTestHostBuilder(f).
+		WithExecutorFactory(executorFactory).
+		WithBlockchainHook(world).
+		WithBuiltinFunctions().
+		Build()
+	f.Cleanup(host.Reset)
+
+	return &wasmModuleFuzzHost{
+		name: name,
+		host: host,
+	}
+}
+
+// deployFuzzedModule returns whether the code passed validation; the output of
+// the deployment is never committed, so every deployment starts from the same state
+func deployFuzzedModule(t *testing.T, fuzzHost *wasmModuleFuzzHost, code []byte) bool {
+	input := test.CreateTestContractCreateInputBuilder().
+		WithGasProvided(wasmModuleFuzzGasProvided).
+		WithContractCode(code).
+		WithCallerAddr(test.UserAddress).
+		Build()
+
+	vmOutput, err := fuzzHost.host.RunSmartContractCreate(input)
+	require.Nil(t, err, "%s: deployment did not end cleanly", fuzzHost.name)
+	require.NotNil(t, vmOutput, "%s: no output", fuzzHost.name)
+
+	return vmOutput.ReturnCode != vmcommon.ContractInvalid
+}
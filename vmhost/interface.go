@@ -63,6 +63,11 @@ type VMHost interface {
 	Reset()
 	SetGasTracing(enableGasTracing bool)
 	GetGasTrace() map[string]map[string][]uint64
+	SetAsyncCallGraphTracer(tracer AsyncCallGraphTracer)
+	AsyncCallGraphTracer() AsyncCallGraphTracer
+
+	ExecuteQuery(input *QueryInput) (*QueryOutput, error)
+	ExecuteQueries(inputs []*QueryInput) []*QueryResult
 }
 
 // BlockchainContext defines the functionality needed for interacting with the blockchain context
@@ -141,7 +146,8 @@ type RuntimeContext interface {
 	StartWasmerInstance(contract []byte, gasLimit uint64, newCode bool) error
 	ClearWarmInstanceCache()
 	SetMaxInstanceStackSize(uint64)
-	VerifyContractCode() error
+	SetCodeValidationPolicy(policy *CodeValidationPolicy)
+	VerifyContractCode(code []byte) error
 	GetInstance() executor.Instance
 	GetInstanceTracker() InstanceTracker
 	FunctionNameChecked() (string, error)
@@ -241,6 +247,8 @@ type OutputContext interface {
 	DeleteOutputAccount(address []byte)
 	WriteLog(address []byte, topics [][]byte, data [][]byte)
 	WriteLogWithIdentifier(address []byte, topics [][]byte, data [][]byte, identifier []byte)
+	SetLogLimits(limits LogLimits)
+	CheckLogLimits(topics [][]byte, data [][]byte) error
 	TransferValueOnly(destination []byte, sender []byte, value *big.Int, checkPayable bool) error
 	Transfer(destination []byte, sender []byte, gasLimit uint64, gasLocked uint64, value *big.Int, asyncData []byte, input []byte, callType vm.CallType) error
 	TransferESDT(transfersArgs *ESDTTransfersArgs, callInput *vmcommon.ContractCallInput) (uint64, error)
@@ -258,6 +266,8 @@ type OutputContext interface {
 	DeleteFirstReturnData()
 	GetVMOutput() *vmcommon.VMOutput
 	RemoveNonUpdatedStorage()
+	GetTransientStorage(address []byte, key []byte) []byte
+	SetTransientStorage(address []byte, key []byte, value []byte)
 	AddTxValueToAccount(address []byte, value *big.Int)
 	DeployCode(input CodeDeployInput)
 	CreateVMOutputInCaseOfError(err error) *vmcommon.VMOutput
@@ -296,6 +306,7 @@ type MeteringContext interface {
 	GetGasLocked() uint64
 	UpdateGasStateOnSuccess(vmOutput *vmcommon.VMOutput) error
 	UpdateGasStateOnFailure(vmOutput *vmcommon.VMOutput)
+	ApplyGasRefund(vmOutput *vmcommon.VMOutput)
 	TrackGasUsedByOutOfVMFunction(builtinInput *vmcommon.ContractCallInput, builtinOutput *vmcommon.VMOutput, postBuiltinInput *vmcommon.ContractCallInput)
 	DisableRestoreGas()
 	EnableRestoreGas()
@@ -337,6 +348,19 @@ type StorageContext interface {
 	SetProtectedStorageToAddressUnmetered(address []byte, key []byte, value []byte) (StorageStatus, error)
 	UseGasForStorageLoad(tracedFunctionName string, trieDepth int64, blockchainLoadCost uint64, usedCache bool) error
 	GetVmProtectedPrefix(prefix string) []byte
+	GetStorageKeysWithPrefix(tracedFunctionName string, prefix []byte, cursor []byte, maxKeys uint32) ([][]byte, error)
+	GetVMProtectedStorageKeysFromAddress(tracedFunctionName string, address []byte, prefix string, cursor []byte, maxKeys uint32) ([][]byte, error)
+	GetTransientStorage(key []byte) []byte
+	SetTransientStorage(key []byte, value []byte) error
+	SetStorageDepositAddress(address []byte)
+	ApplyStorageAccounting() error
+}
+
+// StorageIteratorHook defines the functionality of a BlockchainHook able to iterate the storage of an account
+type StorageIteratorHook interface {
+	// GetStorageKeysWithPrefix returns, in ascending order, at most maxKeys keys of the account
+	// which start with the prefix and come after the cursor, an empty cursor meaning the first key
+	GetStorageKeysWithPrefix(address []byte, prefix []byte, cursor []byte, maxKeys uint32) ([][]byte, error)
 }
 
 // AsyncCallInfoHandler defines the functionality for working with AsyncCallInfo
@@ -402,6 +426,8 @@ type AsyncContext interface {
 
 	GetAsyncCallByCallID(callID []byte) AsyncCallLocation
 	LoadParentContextFromStackOrStorage() (AsyncContext, error)
+	GetPendingCallbacks(address []byte) []string
+	GetPersistedPendingCallbacks(address []byte) ([]string, error)
 	ExecuteSyncCallbackAndFinishOutput(
 		asyncCall *AsyncCall,
 		vmOutput *vmcommon.VMOutput,
@@ -433,6 +459,21 @@ type GasTracing interface {
 	IsInterfaceNil() bool
 }
 
+// AsyncCallGraphTracer defines the functionality needed for building the
+// execution graph of sync calls, async calls and callbacks
+type AsyncCallGraphTracer interface {
+	BeginTransaction(input *vmcommon.ContractCallInput)
+	EndTransaction(vmOutput *vmcommon.VMOutput)
+	BeginCall(input *vmcommon.ContractCallInput)
+	EndCall(vmOutput *vmcommon.VMOutput, err error)
+	TraceRegisterAsyncCall(groupID string, asyncCall *AsyncCall)
+	TraceAsyncCallExecution(asyncCall *AsyncCall)
+	TraceCallbackExecution(asyncCall *AsyncCall)
+	TraceChildComplete(callID []byte, gasAccumulated uint64)
+	GetExecutionGraph() *AsyncExecutionGraph
+	IsInterfaceNil() bool
+}
+
 // HashComputer provides hash computation
 type HashComputer interface {
 	Compute(string) []byte
@@ -0,0 +1,8 @@
+package vmhost
+
+// LogLimits holds the limits enforced on the logs written by contracts during a transaction, zero meaning no limit
+type LogLimits struct {
+	MaxLogsPerTransaction     uint32
+	MaxTopicsPerLog           uint32
+	MaxLogBytesPerTransaction uint64
+}
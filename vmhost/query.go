@@ -0,0 +1,24 @@
+package vmhost
+
+// QueryInput holds the arguments of a read-only call of a view function. An
+// empty CallerAddr means the contract calls itself, while the GasLimit is capped
+// by the QueryGasLimit of the host, which is also used when GasLimit is zero.
+type QueryInput struct {
+	CallerAddr   []byte
+	ContractAddr []byte
+	Function     string
+	Arguments    [][]byte
+	GasLimit     uint64
+}
+
+// QueryOutput holds the results of a read-only call of a view function
+type QueryOutput struct {
+	ReturnData [][]byte
+	GasUsed    uint64
+}
+
+// QueryResult holds the output of a query of a batch, or the error which failed it
+type QueryResult struct {
+	Output *QueryOutput
+	Err    error
+}
@@ -0,0 +1,79 @@
+// Package upgradecheck compares the code of a contract with the code it is upgraded to,
+// reporting the changes that break its callers or its pending async calls.
+package upgradecheck
+
+import (
+	"sort"
+
+	"github.com/multiversx/mx-chain-vm-go/vmhost/wasmmodule"
+)
+
+// Report holds the differences between the endpoints of the old and the new code
+type Report struct {
+	RemovedEndpoints []string `json:"removedEndpoints"`
+	AddedEndpoints   []string `json:"addedEndpoints"`
+	MissingCallbacks []string `json:"missingCallbacks"`
+}
+
+// Check compares the functions exported by the old and the new code, and looks
+// for the pending callbacks which the new code no longer exports
+func Check(oldCode []byte, newCode []byte, pendingCallbacks []string) (*Report, error) {
+	oldModule, err := wasmmodule.Parse(oldCode)
+	if err != nil {
+		return nil, err
+	}
+	newModule, err := wasmmodule.Parse(newCode)
+	if err != nil {
+		return nil, err
+	}
+
+	oldEndpoints := toSet(oldModule.ExportedFunctions)
+	newEndpoints := toSet(newModule.ExportedFunctions)
+
+	return &Report{
+		RemovedEndpoints: difference(oldEndpoints, newEndpoints),
+		AddedEndpoints:   difference(newEndpoints, oldEndpoints),
+		MissingCallbacks: difference(toSet(pendingCallbacks), newEndpoints),
+	}, nil
+}
+
+// MissingCallbacks returns the sorted pending callbacks which the new code no longer exports
+func MissingCallbacks(newCode []byte, pendingCallbacks []string) ([]string, error) {
+	newModule, err := wasmmodule.Parse(newCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return difference(toSet(pendingCallbacks), toSet(newModule.ExportedFunctions)), nil
+}
+
+// IsBreaking returns true if the new code removes endpoints or pending callbacks
+func (report *Report) IsBreaking() bool {
+	return len(report.RemovedEndpoints) > 0 || report.HasMissingCallbacks()
+}
+
+// HasMissingCallbacks returns true if pending async calls would find no callback after the upgrade
+func (report *Report) HasMissingCallbacks() bool {
+	return len(report.MissingCallbacks) > 0
+}
+
+func toSet(names []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		set[name] = struct{}{}
+	}
+	return set
+}
+
+// difference returns the sorted names of the first set missing from the second
+func difference(first map[string]struct{}, second map[string]struct{}) []string {
+	names := make([]string, 0)
+	for name := range first {
+		_, found := second[name]
+		if !found {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
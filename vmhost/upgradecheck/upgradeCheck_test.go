@@ -0,0 +1,84 @@
+package upgradecheck
+
+import (
+	"os"
+	"testing"
+
+	"github.com/multiversx/mx-chain-vm-go/vmhost/wasmmodule"
+	"github.com/stretchr/testify/require"
+)
+
+func readTestContract(t *testing.T, name string) []byte {
+	code, err := os.ReadFile("../../test/contracts/" + name + "/output/" + name + ".wasm")
+	require.Nil(t, err)
+	return code
+}
+
+func TestCheck_SameCode(t *testing.T) {
+	t.Parallel()
+
+	code := readTestContract(t, "async-call-parent")
+	report, err := Check(code, code, []string{"callBack"})
+	require.Nil(t, err)
+	require.Empty(t, report.RemovedEndpoints)
+	require.Empty(t, report.AddedEndpoints)
+	require.Empty(t, report.MissingCallbacks)
+	require.False(t, report.IsBreaking())
+}
+
+func TestCheck_MissingCallback(t *testing.T) {
+	t.Parallel()
+
+	oldCode := readTestContract(t, "async-call-parent")
+	newCode := readTestContract(t, "async-promises-parent")
+
+	report, err := Check(oldCode, newCode, []string{"callBack", "callBack"})
+	require.Nil(t, err)
+	require.Equal(t, []string{"callBack"}, report.RemovedEndpoints)
+	require.Equal(t, []string{"callBackErr", "callBackSucc"}, report.AddedEndpoints)
+	require.Equal(t, []string{"callBack"}, report.MissingCallbacks)
+	require.True(t, report.HasMissingCallbacks())
+	require.True(t, report.IsBreaking())
+}
+
+func TestCheck_RemovedEndpointWithoutPendingCallbacks(t *testing.T) {
+	t.Parallel()
+
+	oldCode := readTestContract(t, "async-promises-parent")
+	newCode := readTestContract(t, "async-call-parent")
+
+	report, err := Check(oldCode, newCode, nil)
+	require.Nil(t, err)
+	require.Equal(t, []string{"callBackErr", "callBackSucc"}, report.RemovedEndpoints)
+	require.Empty(t, report.MissingCallbacks)
+	require.False(t, report.HasMissingCallbacks())
+	require.True(t, report.IsBreaking())
+}
+
+func TestCheck_InvalidCode(t *testing.T) {
+	t.Parallel()
+
+	code := readTestContract(t, "async-call-parent")
+
+	report, err := Check(code, []byte("not wasm"), nil)
+	require.Nil(t, report)
+	require.Equal(t, wasmmodule.ErrInvalidWasmHeader, err)
+
+	report, err = Check([]byte("not wasm"), code, nil)
+	require.Nil(t, report)
+	require.Equal(t, wasmmodule.ErrInvalidWasmHeader, err)
+}
+
+func TestMissingCallbacks(t *testing.T) {
+	t.Parallel()
+
+	code := readTestContract(t, "async-promises-parent")
+
+	missingCallbacks, err := MissingCallbacks(code, []string{"callBack", "callBackSucc", "callBack"})
+	require.Nil(t, err)
+	require.Equal(t, []string{"callBack"}, missingCallbacks)
+
+	missingCallbacks, err = MissingCallbacks([]byte("not wasm"), []string{"callBack"})
+	require.Nil(t, missingCallbacks)
+	require.Equal(t, wasmmodule.ErrInvalidWasmHeader, err)
+}
@@ -1322,6 +1322,31 @@ func CreateAsyncCallWithTypedArgs(host vmhost.VMHost,
 	gas int64,
 	extraGasForCallback int64,
 	callbackClosure []byte) int32 {
+	return CreateAsyncCallWithDeadline(host,
+		calledSCAddress,
+		value,
+		data,
+		successFunc,
+		errorFunc,
+		gas,
+		extraGasForCallback,
+		callbackClosure,
+		0,
+		0)
+}
+
+// CreateAsyncCallWithDeadline - createAsyncCall with arguments already read from memory and an optional deadline
+func CreateAsyncCallWithDeadline(host vmhost.VMHost,
+	calledSCAddress []byte,
+	value []byte,
+	data []byte,
+	successFunc []byte,
+	errorFunc []byte,
+	gas int64,
+	extraGasForCallback int64,
+	callbackClosure []byte,
+	deadlineRound uint64,
+	deadlineTimestamp uint64) int32 {
 
 	metering := host.Metering()
 	runtime := host.Runtime()
@@ -1345,6 +1370,9 @@ func CreateAsyncCallWithTypedArgs(host vmhost.VMHost,
 		ErrorCallback:   string(errorFunc),
 		GasLocked:       uint64(extraGasForCallback),
 		CallbackClosure: callbackClosure,
+
+		DeadlineRound:     deadlineRound,
+		DeadlineTimestamp: deadlineTimestamp,
 	}
 
 	if asyncCall.HasDefinedAnyCallback() {
@@ -2523,6 +2551,7 @@ func (context *VMHooksImpl) WriteLog(
 		context.WithFault(err, runtime.BaseOpsErrorShouldFailExecution())
 		return
 	}
+	gasToUse = math.AddUint64(gasToUse, context.gasForLogTopics(int(numTopics)))
 
 	err := metering.UseGasBoundedAndAddTracedGas(writeLogName, gasToUse)
 	if context.WithFault(err, runtime.BaseOpsErrorShouldFailExecution()) {
@@ -2542,9 +2571,24 @@ func (context *VMHooksImpl) WriteLog(
 		}
 	}
 
+	err = output.CheckLogLimits(topics, [][]byte{log})
+	if context.WithFault(err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return
+	}
+
 	output.WriteLog(runtime.GetContextAddress(), topics, [][]byte{log})
 }
 
+// gasForLogTopics returns the gas charged for the topics of a log entry, once LogLimitsFlag is active
+func (context *VMHooksImpl) gasForLogTopics(numTopics int) uint64 {
+	if !context.GetVMHost().EnableEpochsHandler().IsFlagEnabled(vmhost.LogLimitsFlag) {
+		return 0
+	}
+
+	metering := context.GetMeteringContext()
+	return math.MulUint64(metering.GasSchedule().BaseOpsAPICost.LogPerTopic, uint64(numTopics))
+}
+
 // WriteEventLog VMHooks implementation.
 // @autogenerate(VMHooks)
 func (context *VMHooksImpl) WriteEventLog(
@@ -2580,11 +2624,17 @@ func (context *VMHooksImpl) WriteEventLog(
 		metering.GasSchedule().BaseOperationCost.DataCopyPerByte,
 		uint64(topicDataTotalLen+dataLength))
 	gasToUse = math.AddUint64(gasToUse, gasForData)
+	gasToUse = math.AddUint64(gasToUse, context.gasForLogTopics(len(topics)))
 	err = metering.UseGasBoundedAndAddTracedGas(writeEventLogName, gasToUse)
 	if context.WithFault(err, runtime.BaseOpsErrorShouldFailExecution()) {
 		return
 	}
 
+	err = output.CheckLogLimits(topics, [][]byte{data})
+	if context.WithFault(err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return
+	}
+
 	output.WriteLog(runtime.GetContextAddress(), topics, [][]byte{data})
 }
 
@@ -1,5 +1,7 @@
 package vmhooks
 
+import vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+
 const esdtRoleLocalMint = "ESDTRoleLocalMint"
 const esdtRoleLocalBurn = "ESDTRoleLocalBurn"
 const esdtRoleNFTCreate = "ESDTRoleNFTCreate"
@@ -33,6 +35,33 @@ const (
 	RoleSetNewURI
 )
 
+// constants defining code metadata flags values
+const (
+	CodeMetadataUpgradeable = 1 << iota
+	CodeMetadataReadable
+	CodeMetadataPayable
+	CodeMetadataPayableBySC
+)
+
+func getCodeMetadataFlags(codeMetadataBytes []byte) int64 {
+	codeMetadata := vmcommon.CodeMetadataFromBytes(codeMetadataBytes)
+
+	result := int64(0)
+	if codeMetadata.Upgradeable {
+		result |= CodeMetadataUpgradeable
+	}
+	if codeMetadata.Readable {
+		result |= CodeMetadataReadable
+	}
+	if codeMetadata.Payable {
+		result |= CodeMetadataPayable
+	}
+	if codeMetadata.PayableBySC {
+		result |= CodeMetadataPayableBySC
+	}
+	return result
+}
+
 func roleFromByteArray(bytes []byte) int64 {
 	stringValue := string(bytes)
 	switch stringValue {
@@ -81,6 +110,19 @@ func roleFromByteArrayV2(bytes []byte) int64 {
 	}
 }
 
+func esdtRoleName(role int64) string {
+	switch role {
+	case RoleNFTUpdateAttributes:
+		return esdtRoleNFTUpdateAttributes
+	case RoleNFTAddURI:
+		return esdtRoleNFTAddURI
+	case RoleModifyRoyalties:
+		return esdtRoleModifyRoyalties
+	default:
+		return ""
+	}
+}
+
 func getESDTRoles(dataBuffer []byte, cryptoOpcodesV2Enabled bool) int64 {
 	result := int64(0)
 	currentIndex := 0
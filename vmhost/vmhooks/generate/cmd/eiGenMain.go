@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
 	eapigen "github.com/multiversx/mx-chain-vm-go/vmhost/vmhooks/generate"
 )
 
@@ -41,6 +42,10 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
+	err = eapigen.ReadGasCostFields(fset, pathToApiPackage, eiMetadata)
+	if err != nil {
+		panic(err)
+	}
 
 	writeVMHooks(eiMetadata)
 	writeVMHooksWrapper(eiMetadata)
@@ -49,6 +54,7 @@ func main() {
 	writeWasmer2Names(eiMetadata)
 
 	writeNamesForMockExecutor(eiMetadata)
+	writeAPIDescription(eiMetadata)
 
 	tryCreateRustOutputDirectory()
 
@@ -109,6 +115,19 @@ func writeNamesForMockExecutor(eiMetadata *eapigen.EIMetadata) {
 	eapigen.WriteNames(out, "mock", eiMetadata)
 }
 
+func writeAPIDescription(eiMetadata *eapigen.EIMetadata) {
+	activationFlags := make(map[string]string)
+	for _, activation := range vmhost.VMHooksActivations {
+		for _, hook := range activation.Hooks {
+			activationFlags[hook] = string(activation.Flag)
+		}
+	}
+
+	out := eapigen.NewEIGenWriter(pathToApiPackage, "../../executor/vmHooksAPI.json")
+	defer out.Close()
+	eapigen.WriteAPIDescription(out, eiMetadata, activationFlags)
+}
+
 func tryCreateRustOutputDirectory() {
 	outputDirPath := filepath.Join(pathToApiPackage, "generate/cmd/output")
 	if _, err := os.Stat(outputDirPath); errors.Is(err, os.ErrNotExist) {
@@ -0,0 +1,115 @@
+package vmhooksgenerate
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ReadGasCostFields fills in, for each EI function, the gas schedule fields
+// used by its implementation, following the calls to other functions of the package.
+func ReadGasCostFields(fset *token.FileSet, pathToSources string, eiMetadata *EIMetadata) error {
+	sourcePaths, err := filepath.Glob(filepath.Join(pathToSources, "*.go"))
+	if err != nil {
+		return err
+	}
+
+	funcDecls := make(map[string][]*ast.FuncDecl)
+	for _, sourcePath := range sourcePaths {
+		if strings.HasSuffix(sourcePath, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, sourcePath, nil, 0)
+		if err != nil {
+			return err
+		}
+		for _, decl := range f.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if ok && funcDecl.Body != nil {
+				funcDecls[funcDecl.Name.Name] = append(funcDecls[funcDecl.Name.Name], funcDecl)
+			}
+		}
+	}
+
+	for _, funcMetadata := range eiMetadata.AllFunctions {
+		fields := make(map[string]struct{})
+		collectGasCostFields(funcDecls, funcMetadata.Name, fields, make(map[string]bool))
+		funcMetadata.GasCostFields = sortedKeys(fields)
+	}
+	return nil
+}
+
+func collectGasCostFields(funcDecls map[string][]*ast.FuncDecl, funcName string, fields map[string]struct{}, visited map[string]bool) {
+	if visited[funcName] {
+		return
+	}
+	visited[funcName] = true
+
+	for _, funcDecl := range funcDecls[funcName] {
+		ast.Inspect(funcDecl.Body, func(node ast.Node) bool {
+			switch expr := node.(type) {
+			case *ast.SelectorExpr:
+				field, ok := gasCostField(expr)
+				if ok {
+					fields[field] = struct{}{}
+				}
+			case *ast.CallExpr:
+				calledName, ok := packageFunctionName(expr)
+				if ok {
+					collectGasCostFields(funcDecls, calledName, fields, visited)
+				}
+			}
+			return true
+		})
+	}
+}
+
+// gasCostField recognizes "metering.GasSchedule().Group.Field" and "gasSchedule.Group.Field"
+func gasCostField(expr *ast.SelectorExpr) (string, bool) {
+	group, ok := expr.X.(*ast.SelectorExpr)
+	if !ok {
+		return "", false
+	}
+
+	switch schedule := group.X.(type) {
+	case *ast.Ident:
+		if schedule.Name != "gasSchedule" {
+			return "", false
+		}
+	case *ast.CallExpr:
+		getter, ok := schedule.Fun.(*ast.SelectorExpr)
+		if !ok || getter.Sel.Name != "GasSchedule" {
+			return "", false
+		}
+	default:
+		return "", false
+	}
+
+	return group.Sel.Name + "." + expr.Sel.Name, true
+}
+
+// packageFunctionName returns the name of a called function or of a method called on the hooks context
+func packageFunctionName(call *ast.CallExpr) (string, bool) {
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		return fun.Name, true
+	case *ast.SelectorExpr:
+		receiver, ok := fun.X.(*ast.Ident)
+		if ok && receiver.Name == "context" {
+			return fun.Sel.Name, true
+		}
+	}
+	return "", false
+}
+
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -23,9 +23,10 @@ type EIFunctionResult struct {
 
 // EIFunction holds data about one function in the VM EI.
 type EIFunction struct {
-	Name      string
-	Arguments []*EIFunctionArg
-	Result    *EIFunctionResult
+	Name          string
+	Arguments     []*EIFunctionArg
+	Result        *EIFunctionResult
+	GasCostFields []string
 }
 
 // EIGroup groups EI functions into bundles.
@@ -0,0 +1,89 @@
+package vmhooksgenerate
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+type apiDescription struct {
+	Hooks []*apiHookDescription `json:"hooks"`
+}
+
+type apiHookDescription struct {
+	Name           string                     `json:"name"`
+	Group          string                     `json:"group"`
+	Parameters     []*apiParameterDescription `json:"parameters"`
+	Returns        string                     `json:"returns,omitempty"`
+	GasCostFields  []string                   `json:"gasCostFields"`
+	ActivationFlag string                     `json:"activationFlag,omitempty"`
+}
+
+type apiParameterDescription struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+}
+
+// WriteAPIDescription writes a JSON description of all the VM hooks, as
+// imported by contracts, for the tools and SDKs generated from it.
+// The activation flags map the names of the gated hooks to their flags.
+func WriteAPIDescription(
+	out *eiGenWriter,
+	eiMetadata *EIMetadata,
+	activationFlags map[string]string,
+) {
+	description := &apiDescription{
+		Hooks: make([]*apiHookDescription, 0, len(eiMetadata.AllFunctions)),
+	}
+
+	for _, group := range eiMetadata.Groups {
+		for _, funcMetadata := range group.Functions {
+			name := lowerInitial(funcMetadata.Name)
+			hook := &apiHookDescription{
+				Name:           name,
+				Group:          group.Name,
+				Parameters:     make([]*apiParameterDescription, 0, len(funcMetadata.Arguments)),
+				GasCostFields:  funcMetadata.GasCostFields,
+				ActivationFlag: activationFlags[name],
+			}
+			if hook.GasCostFields == nil {
+				hook.GasCostFields = make([]string, 0)
+			}
+			for _, arg := range funcMetadata.Arguments {
+				hook.Parameters = append(hook.Parameters, &apiParameterDescription{
+					Name: arg.Name,
+					Kind: apiKind(arg.Name, arg.Type),
+				})
+			}
+			if funcMetadata.Result != nil {
+				hook.Returns = apiKind("", funcMetadata.Result.Type)
+			}
+			description.Hooks = append(description.Hooks, hook)
+		}
+	}
+
+	serialized, err := json.MarshalIndent(description, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	out.WriteString(string(serialized))
+	out.WriteString("\n")
+}
+
+// handles are passed as plain int32 values, recognized by their name
+func apiKind(name string, eiType EIType) string {
+	switch eiType {
+	case EITypeMemPtr:
+		return "memPtr"
+	case EITypeMemLength:
+		return "memLength"
+	case EITypeInt32:
+		if strings.HasSuffix(strings.ToLower(name), "handle") {
+			return "handle"
+		}
+		return "i32"
+	case EITypeInt64:
+		return "i64"
+	default:
+		panic("invalid EI type")
+	}
+}
@@ -50,6 +50,7 @@ type WrapperVMHooks struct {
 		writeCommaSeparatedArgumentNames(out, funcMetadata.Arguments)
 		out.WriteString(")")
 		out.WriteString("\n\tw.logger.LogVMHookCallAfter(callInfo)")
+		writeRecordCall(out, funcMetadata)
 		if funcMetadata.Result != nil {
 			out.WriteString("\n\treturn result")
 		}
@@ -74,6 +75,25 @@ func writeCallInfo(out *eiGenWriter, funcMetadata *EIFunction) {
 	}
 }
 
+func writeRecordCall(out *eiGenWriter, funcMetadata *EIFunction) {
+	if funcMetadata.Result != nil {
+		out.WriteString(fmt.Sprintf("\n\tw.recordCallWithResult(\"%s\", []int64{", lowerInitial(funcMetadata.Name)))
+	} else {
+		out.WriteString(fmt.Sprintf("\n\tw.recordCall(\"%s\", []int64{", lowerInitial(funcMetadata.Name)))
+	}
+	for argIndex, arg := range funcMetadata.Arguments {
+		if argIndex > 0 {
+			out.WriteString(", ")
+		}
+		out.WriteString(fmt.Sprintf("int64(%s)", arg.Name))
+	}
+	out.WriteString("}")
+	if funcMetadata.Result != nil {
+		out.WriteString(", int64(result)")
+	}
+	out.WriteString(")")
+}
+
 func writeCommaSeparatedArgumentNames(out *eiGenWriter, arguments []*EIFunctionArg) {
 	for argIndex, arg := range arguments {
 		if argIndex > 0 {
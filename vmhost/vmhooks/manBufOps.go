@@ -32,6 +32,9 @@ const (
 	mBufferSetRandomName          = "mBufferSetRandom"
 	mBufferToBigFloatName         = "mBufferToBigFloat"
 	mBufferFromBigFloatName       = "mBufferFromBigFloat"
+	mBufferStorageIterateKeysName = "mBufferStorageIterateKeys"
+	mBufferTransientStoreName     = "mBufferTransientStore"
+	mBufferTransientLoadName      = "mBufferTransientLoad"
 )
 
 // MBufferNew VMHooks implementation.
@@ -656,6 +659,131 @@ func (context *VMHooksImpl) MBufferStorageLoad(keyHandle int32, destinationHandl
 	return 0
 }
 
+// MBufferStorageIterateKeys VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) MBufferStorageIterateKeys(prefixHandle int32, cursorHandle int32, maxKeys int32, keysHandle int32) int32 {
+	managedType := context.GetManagedTypesContext()
+	runtime := context.GetRuntimeContext()
+	storage := context.GetStorageContext()
+	metering := context.GetMeteringContext()
+
+	gasToUse := metering.GasSchedule().ManagedBufferAPICost.MBufferStorageIterate
+	err := metering.UseGasBoundedAndAddTracedGas(mBufferStorageIterateKeysName, gasToUse)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	if maxKeys < 0 {
+		_ = context.WithFault(vmhost.ErrArgOutOfRange, runtime.ManagedBufferAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	// the keys are only read if all of them can be paid for
+	gasPerKey := metering.GasSchedule().ManagedBufferAPICost.MBufferStorageIterateKey
+	if metering.GasLeft() < math.MulUint64(gasPerKey, uint64(maxKeys)) {
+		_ = context.WithFault(vmhost.ErrNotEnoughGas, runtime.ManagedBufferAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	prefix, err := managedType.GetBytes(prefixHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	cursor, err := managedType.GetBytes(cursorHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	keys, err := storage.GetStorageKeysWithPrefix(mBufferStorageIterateKeysName, prefix, cursor, uint32(maxKeys))
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	keysLength := 0
+	for _, key := range keys {
+		keysLength += len(key)
+	}
+	err = managedType.ConsumeGasForThisIntNumberOfBytes(keysLength)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	err = managedType.WriteManagedVecOfManagedBuffers(keys, keysHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	return int32(len(keys))
+}
+
+// MBufferTransientStore VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) MBufferTransientStore(keyHandle int32, sourceHandle int32) int32 {
+	managedType := context.GetManagedTypesContext()
+	runtime := context.GetRuntimeContext()
+	storage := context.GetStorageContext()
+	metering := context.GetMeteringContext()
+
+	gasToUse := metering.GasSchedule().ManagedBufferAPICost.MBufferTransientStore
+	err := metering.UseGasBoundedAndAddTracedGas(mBufferTransientStoreName, gasToUse)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	key, err := managedType.GetBytes(keyHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	sourceBytes, err := managedType.GetBytes(sourceHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	err = managedType.ConsumeGasForThisIntNumberOfBytes(len(key) + len(sourceBytes))
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	err = storage.SetTransientStorage(key, sourceBytes)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	return 0
+}
+
+// MBufferTransientLoad VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) MBufferTransientLoad(keyHandle int32, destinationHandle int32) int32 {
+	managedType := context.GetManagedTypesContext()
+	runtime := context.GetRuntimeContext()
+	storage := context.GetStorageContext()
+	metering := context.GetMeteringContext()
+
+	gasToUse := metering.GasSchedule().ManagedBufferAPICost.MBufferTransientLoad
+	err := metering.UseGasBoundedAndAddTracedGas(mBufferTransientLoadName, gasToUse)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	key, err := managedType.GetBytes(keyHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	value := storage.GetTransientStorage(key)
+	err = managedType.ConsumeGasForBytes(value)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	managedType.SetBytes(destinationHandle, value)
+
+	return 0
+}
+
 // MBufferStorageLoadFromAddress VMHooks implementation.
 // @autogenerate(VMHooks)
 func (context *VMHooksImpl) MBufferStorageLoadFromAddress(addressHandle, keyHandle, destinationHandle int32) {
@@ -3,7 +3,10 @@ package vmhooks
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
+	"math/big"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-vm-common-go/builtInFunctions"
 
@@ -30,6 +33,7 @@ const (
 	managedUpgradeFromSourceContractName     = "managedUpgradeFromSourceContract"
 	managedAsyncCallName                     = "managedAsyncCall"
 	managedCreateAsyncCallName               = "managedCreateAsyncCall"
+	managedCreateAsyncCallWithDeadlineName   = "managedCreateAsyncCallWithDeadline"
 	managedGetCallbackClosure                = "managedGetCallbackClosure"
 	managedGetMultiESDTCallValueName         = "managedGetMultiESDTCallValue"
 	managedGetESDTBalanceName                = "managedGetESDTBalance"
@@ -46,6 +50,13 @@ const (
 	managedGetCodeMetadataName               = "managedGetCodeMetadata"
 	managedIsBuiltinFunction                 = "managedIsBuiltinFunction"
 	managedMultiTransferESDTNFTExecuteByUser = "managedMultiTransferESDTNFTExecuteByUser"
+	managedUpdateNFTAttributesName           = "managedUpdateNFTAttributes"
+	managedAddNFTURIsName                    = "managedAddNFTURIs"
+	managedModifyNFTRoyaltiesName            = "managedModifyNFTRoyalties"
+	managedGetCodeHashName                   = "managedGetCodeHash"
+	managedGetCodeMetadataFlagsName          = "managedGetCodeMetadataFlags"
+	managedGetAccountNonceName               = "managedGetAccountNonce"
+	managedGetContractOwnerName              = "managedGetContractOwner"
 )
 
 // ManagedSCAddress VMHooks implementation.
@@ -206,11 +217,17 @@ func (context *VMHooksImpl) ManagedWriteLog(
 		metering.GasSchedule().BaseOperationCost.DataCopyPerByte,
 		sumOfTopicByteLengths+dataByteLen)
 	gasToUse = math.AddUint64(gasToUse, gasForData)
+	gasToUse = math.AddUint64(gasToUse, context.gasForLogTopics(len(topics)))
 	err = metering.UseGasBounded(gasToUse)
 	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
 		return
 	}
 
+	err = output.CheckLogLimits(topics, [][]byte{dataBytes})
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return
+	}
+
 	output.WriteLog(runtime.GetContextAddress(), topics, [][]byte{dataBytes})
 }
 
@@ -550,7 +567,76 @@ func (context *VMHooksImpl) ManagedCreateAsyncCall(
 	extraGasForCallback int64,
 	callbackClosureHandle int32,
 ) int32 {
+	return context.managedCreateAsyncCall(
+		destHandle,
+		valueHandle,
+		functionHandle,
+		argumentsHandle,
+		successOffset,
+		successLength,
+		errorOffset,
+		errorLength,
+		gas,
+		extraGasForCallback,
+		callbackClosureHandle,
+		0,
+		0)
+}
+
+// ManagedCreateAsyncCallWithDeadline VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) ManagedCreateAsyncCallWithDeadline(
+	destHandle int32,
+	valueHandle int32,
+	functionHandle int32,
+	argumentsHandle int32,
+	successOffset executor.MemPtr,
+	successLength executor.MemLength,
+	errorOffset executor.MemPtr,
+	errorLength executor.MemLength,
+	gas int64,
+	extraGasForCallback int64,
+	callbackClosureHandle int32,
+	deadlineRound int64,
+	deadlineTimestamp int64,
+) int32 {
+	host := context.GetVMHost()
+	if deadlineRound < 0 || deadlineTimestamp < 0 {
+		_ = context.WithFault(vmhost.ErrArgOutOfRange, host.Runtime().BaseOpsErrorShouldFailExecution())
+		return 1
+	}
+
+	return context.managedCreateAsyncCall(
+		destHandle,
+		valueHandle,
+		functionHandle,
+		argumentsHandle,
+		successOffset,
+		successLength,
+		errorOffset,
+		errorLength,
+		gas,
+		extraGasForCallback,
+		callbackClosureHandle,
+		uint64(deadlineRound),
+		uint64(deadlineTimestamp))
+}
 
+func (context *VMHooksImpl) managedCreateAsyncCall(
+	destHandle int32,
+	valueHandle int32,
+	functionHandle int32,
+	argumentsHandle int32,
+	successOffset executor.MemPtr,
+	successLength executor.MemLength,
+	errorOffset executor.MemPtr,
+	errorLength executor.MemLength,
+	gas int64,
+	extraGasForCallback int64,
+	callbackClosureHandle int32,
+	deadlineRound uint64,
+	deadlineTimestamp uint64,
+) int32 {
 	host := context.GetVMHost()
 	runtime := host.Runtime()
 	managedType := host.ManagedTypes()
@@ -583,7 +669,7 @@ func (context *VMHooksImpl) ManagedCreateAsyncCall(
 		return 1
 	}
 
-	return CreateAsyncCallWithTypedArgs(host,
+	return CreateAsyncCallWithDeadline(host,
 		vmInput.destination,
 		value.Bytes(),
 		[]byte(data),
@@ -591,7 +677,9 @@ func (context *VMHooksImpl) ManagedCreateAsyncCall(
 		errorFunc,
 		gas,
 		extraGasForCallback,
-		callbackClosure)
+		callbackClosure,
+		deadlineRound,
+		deadlineTimestamp)
 }
 
 // ManagedGetCallbackClosure VMHooks implementation.
@@ -1350,3 +1438,319 @@ func ManagedIsBuiltinFunctionWithHost(host vmhost.VMHost, functionNameHandle int
 
 	return 0
 }
+
+// ManagedUpdateNFTAttributes VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) ManagedUpdateNFTAttributes(tokenIDHandle int32, nonce int64, attributesHandle int32) {
+	host := context.GetVMHost()
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(managedUpdateNFTAttributesName)
+
+	attributes, err := managedType.GetBytes(attributesHandle)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return
+	}
+
+	err = managedType.ConsumeGasForBytes(attributes)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return
+	}
+
+	UpdateESDTMetadataWithTypedArgs(
+		host,
+		managedUpdateNFTAttributesName,
+		core.BuiltInFunctionESDTNFTUpdateAttributes,
+		RoleNFTUpdateAttributes,
+		tokenIDHandle,
+		nonce,
+		[][]byte{attributes},
+	)
+}
+
+// ManagedAddNFTURIs VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) ManagedAddNFTURIs(tokenIDHandle int32, nonce int64, urisHandle int32) {
+	host := context.GetVMHost()
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(managedAddNFTURIsName)
+
+	uris, _, err := managedType.ReadManagedVecOfManagedBuffers(urisHandle)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return
+	}
+
+	if len(uris) == 0 {
+		_ = WithFaultAndHost(host, vmhost.ErrInvalidArgument, runtime.BaseOpsErrorShouldFailExecution())
+		return
+	}
+
+	UpdateESDTMetadataWithTypedArgs(
+		host,
+		managedAddNFTURIsName,
+		core.BuiltInFunctionESDTNFTAddURI,
+		RoleNFTAddURI,
+		tokenIDHandle,
+		nonce,
+		uris,
+	)
+}
+
+// ManagedModifyNFTRoyalties VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) ManagedModifyNFTRoyalties(tokenIDHandle int32, nonce int64, royalties int64) {
+	host := context.GetVMHost()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	metering.StartGasTracing(managedModifyNFTRoyaltiesName)
+
+	if royalties < 0 || royalties > int64(core.MaxRoyalty) {
+		_ = WithFaultAndHost(host, vmhost.ErrInvalidRoyalties, runtime.BaseOpsErrorShouldFailExecution())
+		return
+	}
+
+	UpdateESDTMetadataWithTypedArgs(
+		host,
+		managedModifyNFTRoyaltiesName,
+		core.ESDTModifyRoyalties,
+		RoleModifyRoyalties,
+		tokenIDHandle,
+		nonce,
+		[][]byte{big.NewInt(royalties).Bytes()},
+	)
+}
+
+// UpdateESDTMetadataWithTypedArgs calls an ESDT metadata built-in function on
+// the token held by the current contract, once the contract is verified to have
+// the role required by the function. The gas used by the built-in function is
+// tracked by ExecuteOnDestContext, as for any other built-in function call.
+func UpdateESDTMetadataWithTypedArgs(
+	host vmhost.VMHost,
+	hookName string,
+	function string,
+	role int64,
+	tokenIDHandle int32,
+	nonce int64,
+	args [][]byte,
+) {
+	managedType := host.ManagedTypes()
+	runtime := host.Runtime()
+	metering := host.Metering()
+
+	gasToUse := metering.GasSchedule().BaseOpsAPICost.ExecuteOnDestContext
+	err := metering.UseGasBoundedAndAddTracedGas(hookName, gasToUse)
+	if WithFaultAndHost(host, err, runtime.UseGasBoundedShouldFailExecution()) {
+		return
+	}
+
+	tokenID, err := managedType.GetBytes(tokenIDHandle)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return
+	}
+
+	if nonce <= 0 {
+		_ = WithFaultAndHost(host, vmhost.ErrInvalidTokenNonce, runtime.BaseOpsErrorShouldFailExecution())
+		return
+	}
+
+	err = checkESDTRole(host, hookName, tokenID, role)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return
+	}
+
+	scAddress := runtime.GetContextAddress()
+	builtinArgs := append([][]byte{tokenID, big.NewInt(nonce).Bytes()}, args...)
+	contractCallInput, err := prepareIndirectContractCallInput(
+		host,
+		scAddress,
+		big.NewInt(0),
+		int64(metering.GasLeft()),
+		scAddress,
+		[]byte(function),
+		builtinArgs,
+		gasToUse,
+		true,
+	)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return
+	}
+
+	_, err = executeOnDestContextFromAPI(host, contractCallInput)
+	_ = WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution())
+}
+
+// checkESDTRole returns ErrMissingESDTRole if the current contract does not have the role for the token
+func checkESDTRole(host vmhost.VMHost, hookName string, tokenID []byte, role int64) error {
+	storage := host.Storage()
+	metering := host.Metering()
+
+	key := []byte(core.ProtectedKeyPrefix + core.ESDTRoleIdentifier + core.ESDTKeyIdentifier + string(tokenID))
+	data, trieDepth, usedCache, err := storage.GetStorage(key)
+	if err != nil {
+		return err
+	}
+
+	err = storage.UseGasForStorageLoad(
+		hookName,
+		int64(trieDepth),
+		metering.GasSchedule().BaseOpsAPICost.StorageLoad,
+		usedCache)
+	if err != nil {
+		return err
+	}
+
+	if getESDTRoles(data, true)&role == 0 {
+		return fmt.Errorf("%w %s for token %s", vmhost.ErrMissingESDTRole, esdtRoleName(role), tokenID)
+	}
+
+	return nil
+}
+
+// ManagedGetCodeHash VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) ManagedGetCodeHash(addressHandle int32, resultHandle int32) {
+	host := context.GetVMHost()
+	ManagedGetCodeHashWithHost(host, addressHandle, resultHandle)
+}
+
+// ManagedGetCodeHashWithHost sets the code hash of the account to the result buffer, left empty for accounts without code
+func ManagedGetCodeHashWithHost(host vmhost.VMHost, addressHandle int32, resultHandle int32) {
+	runtime := host.Runtime()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+
+	gasToUse := metering.GasSchedule().BaseOpsAPICost.GetCodeHash
+	err := metering.UseGasBoundedAndAddTracedGas(managedGetCodeHashName, gasToUse)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return
+	}
+
+	gasToUse = metering.GasSchedule().ManagedBufferAPICost.MBufferSetBytes
+	err = metering.UseGasBoundedAndAddTracedGas(managedGetCodeHashName, gasToUse)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return
+	}
+
+	address, err := managedType.GetBytes(addressHandle)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return
+	}
+
+	codeHash := host.Blockchain().GetCodeHash(address)
+
+	managedType.SetBytes(resultHandle, codeHash)
+}
+
+// ManagedGetCodeMetadataFlags VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) ManagedGetCodeMetadataFlags(addressHandle int32) int64 {
+	host := context.GetVMHost()
+	return ManagedGetCodeMetadataFlagsWithHost(host, addressHandle)
+}
+
+// ManagedGetCodeMetadataFlagsWithHost returns the code metadata of the account
+// as CodeMetadataUpgradeable, CodeMetadataReadable, CodeMetadataPayable and
+// CodeMetadataPayableBySC flags, or 0 if the account does not exist
+func ManagedGetCodeMetadataFlagsWithHost(host vmhost.VMHost, addressHandle int32) int64 {
+	runtime := host.Runtime()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+
+	gasToUse := metering.GasSchedule().BaseOpsAPICost.GetCodeMetadata
+	err := metering.UseGasBoundedAndAddTracedGas(managedGetCodeMetadataFlagsName, gasToUse)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return -1
+	}
+
+	address, err := managedType.GetBytes(addressHandle)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return -1
+	}
+
+	account, err := host.Blockchain().GetUserAccount(address)
+	if err != nil || check.IfNil(account) {
+		return 0
+	}
+
+	return getCodeMetadataFlags(account.GetCodeMetadata())
+}
+
+// ManagedGetAccountNonce VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) ManagedGetAccountNonce(addressHandle int32) int64 {
+	host := context.GetVMHost()
+	return ManagedGetAccountNonceWithHost(host, addressHandle)
+}
+
+// ManagedGetAccountNonceWithHost returns the nonce of the account, or 0 if the account does not exist
+func ManagedGetAccountNonceWithHost(host vmhost.VMHost, addressHandle int32) int64 {
+	runtime := host.Runtime()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+	blockchain := host.Blockchain()
+
+	gasToUse := metering.GasSchedule().BaseOpsAPICost.GetAccountNonce
+	err := metering.UseGasBoundedAndAddTracedGas(managedGetAccountNonceName, gasToUse)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return -1
+	}
+
+	address, err := managedType.GetBytes(addressHandle)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return -1
+	}
+
+	if !blockchain.AccountExists(address) {
+		return 0
+	}
+
+	nonce, err := blockchain.GetNonce(address)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return -1
+	}
+
+	return int64(nonce)
+}
+
+// ManagedGetContractOwner VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) ManagedGetContractOwner(addressHandle int32, resultHandle int32) {
+	host := context.GetVMHost()
+	ManagedGetContractOwnerWithHost(host, addressHandle, resultHandle)
+}
+
+// ManagedGetContractOwnerWithHost sets the owner of the account, who receives
+// its developer rewards, to the result buffer, left empty for accounts without owner
+func ManagedGetContractOwnerWithHost(host vmhost.VMHost, addressHandle int32, resultHandle int32) {
+	runtime := host.Runtime()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+
+	gasToUse := metering.GasSchedule().BaseOpsAPICost.GetContractOwner
+	err := metering.UseGasBoundedAndAddTracedGas(managedGetContractOwnerName, gasToUse)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return
+	}
+
+	gasToUse = metering.GasSchedule().ManagedBufferAPICost.MBufferSetBytes
+	err = metering.UseGasBoundedAndAddTracedGas(managedGetContractOwnerName, gasToUse)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return
+	}
+
+	address, err := managedType.GetBytes(addressHandle)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return
+	}
+
+	owner := make([]byte, 0)
+	account, err := host.Blockchain().GetUserAccount(address)
+	if err == nil && !check.IfNil(account) {
+		owner = account.GetOwnerAddress()
+	}
+
+	managedType.SetBytes(resultHandle, owner)
+}
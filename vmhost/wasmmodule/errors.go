@@ -0,0 +1,15 @@
+package wasmmodule
+
+import "errors"
+
+// ErrInvalidWasmHeader signals that the code does not start with the WASM magic number and version 1
+var ErrInvalidWasmHeader = errors.New("invalid WASM header")
+
+// ErrUnexpectedEndOfCode signals that the code ended in the middle of a section or instruction
+var ErrUnexpectedEndOfCode = errors.New("unexpected end of WASM code")
+
+// ErrLEB128Overflow signals that an encoded integer exceeds its size
+var ErrLEB128Overflow = errors.New("LEB128 integer overflow")
+
+// ErrInvalidConstantExpression signals that an initializer holds an instruction not allowed in constant expressions
+var ErrInvalidConstantExpression = errors.New("invalid constant expression")
@@ -0,0 +1,313 @@
+// Package wasmmodule statically decodes the sections of a WASM module, independently of any executor.
+package wasmmodule
+
+import (
+	"bytes"
+	"fmt"
+)
+
+const (
+	sectionImport   = 2
+	sectionFunction = 3
+	sectionTable    = 4
+	sectionMemory   = 5
+	sectionExport   = 7
+	sectionCode     = 10
+	sectionData     = 11
+)
+
+// External kinds of imports and exports
+const (
+	ExternalKindFunction = 0x00
+	ExternalKindTable    = 0x01
+	ExternalKindMemory   = 0x02
+	ExternalKindGlobal   = 0x03
+)
+
+// Header is the WASM magic number followed by version 1
+var Header = []byte{0x00, 0x61, 0x73, 0x6D, 0x01, 0x00, 0x00, 0x00}
+
+var externalKindNames = map[byte]string{
+	ExternalKindFunction: "function",
+	ExternalKindTable:    "table",
+	ExternalKindMemory:   "memory",
+	ExternalKindGlobal:   "global",
+}
+
+// Import is an entry of the import section
+type Import struct {
+	Module string `json:"module"`
+	Name   string `json:"name"`
+	Kind   string `json:"kind"`
+}
+
+// Limits holds the initial and optional maximum size of a memory, in 64KiB pages, or of a table, in elements
+type Limits struct {
+	Min uint32  `json:"min"`
+	Max *uint32 `json:"max,omitempty"`
+}
+
+// Module holds the contents of the sections relevant to validation and reporting
+type Module struct {
+	Imports           []*Import
+	NumFunctions      uint32
+	Tables            []*Limits
+	Memories          []*Limits
+	ImportedMemory    bool
+	ExportedFunctions []string
+	FunctionBodies    [][]byte
+	DataSegmentsSize  uint64
+}
+
+// Parse decodes the sections of the code, without decoding the function bodies
+func Parse(code []byte) (*Module, error) {
+	if !bytes.HasPrefix(code, Header) {
+		return nil, ErrInvalidWasmHeader
+	}
+
+	module := &Module{}
+	reader := NewReader(code[len(Header):])
+	for !reader.IsAtEnd() {
+		sectionID, err := reader.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		sectionSize, err := reader.ReadU32()
+		if err != nil {
+			return nil, err
+		}
+		sectionData, err := reader.ReadBytes(sectionSize)
+		if err != nil {
+			return nil, err
+		}
+
+		err = module.parseSection(sectionID, NewReader(sectionData))
+		if err != nil {
+			return nil, fmt.Errorf("section %d: %w", sectionID, err)
+		}
+	}
+
+	return module, nil
+}
+
+// ImportedFunctions returns the imports of functions, i.e. the VM hooks for contracts
+func (module *Module) ImportedFunctions() []*Import {
+	functions := make([]*Import, 0, len(module.Imports))
+	for _, imp := range module.Imports {
+		if imp.Kind == externalKindNames[ExternalKindFunction] {
+			functions = append(functions, imp)
+		}
+	}
+	return functions
+}
+
+func (module *Module) parseSection(sectionID byte, reader *Reader) error {
+	switch sectionID {
+	case sectionImport:
+		return module.parseImports(reader)
+	case sectionFunction:
+		return module.parseFunctions(reader)
+	case sectionTable:
+		return module.parseTables(reader)
+	case sectionMemory:
+		return module.parseMemories(reader)
+	case sectionExport:
+		return module.parseExports(reader)
+	case sectionCode:
+		return module.parseCode(reader)
+	case sectionData:
+		return module.parseData(reader)
+	default:
+		return nil
+	}
+}
+
+func (module *Module) parseImports(reader *Reader) error {
+	numImports, err := reader.ReadU32()
+	if err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < numImports; i++ {
+		imp := &Import{}
+		imp.Module, err = reader.ReadName()
+		if err != nil {
+			return err
+		}
+		imp.Name, err = reader.ReadName()
+		if err != nil {
+			return err
+		}
+		kind, err := reader.ReadByte()
+		if err != nil {
+			return err
+		}
+		imp.Kind = externalKindNames[kind]
+
+		switch kind {
+		case ExternalKindFunction:
+			_, err = reader.ReadU32()
+		case ExternalKindTable:
+			_, err = reader.ReadByte()
+			if err == nil {
+				var limits *Limits
+				limits, err = reader.readLimits()
+				module.Tables = append(module.Tables, limits)
+			}
+		case ExternalKindMemory:
+			var limits *Limits
+			limits, err = reader.readLimits()
+			module.Memories = append(module.Memories, limits)
+			module.ImportedMemory = true
+		case ExternalKindGlobal:
+			err = reader.Skip(2)
+		default:
+			return fmt.Errorf("unknown import kind 0x%02X", kind)
+		}
+		if err != nil {
+			return err
+		}
+		module.Imports = append(module.Imports, imp)
+	}
+
+	return nil
+}
+
+func (module *Module) parseFunctions(reader *Reader) error {
+	numFunctions, err := reader.ReadU32()
+	if err != nil {
+		return err
+	}
+
+	module.NumFunctions = numFunctions
+	return nil
+}
+
+func (module *Module) parseTables(reader *Reader) error {
+	numTables, err := reader.ReadU32()
+	if err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < numTables; i++ {
+		_, err = reader.ReadByte()
+		if err != nil {
+			return err
+		}
+		limits, err := reader.readLimits()
+		if err != nil {
+			return err
+		}
+		module.Tables = append(module.Tables, limits)
+	}
+
+	return nil
+}
+
+func (module *Module) parseMemories(reader *Reader) error {
+	numMemories, err := reader.ReadU32()
+	if err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < numMemories; i++ {
+		limits, err := reader.readLimits()
+		if err != nil {
+			return err
+		}
+		module.Memories = append(module.Memories, limits)
+	}
+
+	return nil
+}
+
+func (module *Module) parseExports(reader *Reader) error {
+	numExports, err := reader.ReadU32()
+	if err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < numExports; i++ {
+		name, err := reader.ReadName()
+		if err != nil {
+			return err
+		}
+		kind, err := reader.ReadByte()
+		if err != nil {
+			return err
+		}
+		_, err = reader.ReadU32()
+		if err != nil {
+			return err
+		}
+
+		if kind == ExternalKindFunction {
+			module.ExportedFunctions = append(module.ExportedFunctions, name)
+		}
+	}
+
+	return nil
+}
+
+func (module *Module) parseCode(reader *Reader) error {
+	numBodies, err := reader.ReadU32()
+	if err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < numBodies; i++ {
+		bodySize, err := reader.ReadU32()
+		if err != nil {
+			return err
+		}
+		body, err := reader.ReadBytes(bodySize)
+		if err != nil {
+			return err
+		}
+		module.FunctionBodies = append(module.FunctionBodies, body)
+	}
+
+	return nil
+}
+
+func (module *Module) parseData(reader *Reader) error {
+	numSegments, err := reader.ReadU32()
+	if err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < numSegments; i++ {
+		mode, err := reader.ReadU32()
+		if err != nil {
+			return err
+		}
+
+		switch mode {
+		case 0:
+			err = reader.skipConstantExpression()
+		case 1:
+		case 2:
+			_, err = reader.ReadU32()
+			if err == nil {
+				err = reader.skipConstantExpression()
+			}
+		default:
+			return fmt.Errorf("unknown data segment mode %d", mode)
+		}
+		if err != nil {
+			return err
+		}
+
+		size, err := reader.ReadU32()
+		if err != nil {
+			return err
+		}
+		err = reader.Skip(size)
+		if err != nil {
+			return err
+		}
+		module.DataSegmentsSize += uint64(size)
+	}
+
+	return nil
+}
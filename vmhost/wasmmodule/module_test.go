@@ -0,0 +1,59 @@
+package wasmmodule
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readTestContract(t *testing.T, path string) []byte {
+	code, err := os.ReadFile("../../test/contracts/" + path)
+	require.Nil(t, err)
+	return code
+}
+
+func TestParse_Counter(t *testing.T) {
+	t.Parallel()
+
+	module, err := Parse(readTestContract(t, "counter/output/counter.wasm"))
+	require.Nil(t, err)
+
+	require.Equal(t, []*Import{
+		{Module: "env", Name: "int64storageStore", Kind: "function"},
+		{Module: "env", Name: "int64storageLoad", Kind: "function"},
+		{Module: "env", Name: "int64finish", Kind: "function"},
+	}, module.Imports)
+	require.Len(t, module.ImportedFunctions(), 3)
+	require.Equal(t, uint32(5), module.NumFunctions)
+	require.Len(t, module.FunctionBodies, 5)
+	require.Equal(t, []string{"init", "upgrade", "increment", "decrement", "get"}, module.ExportedFunctions)
+
+	tableMax := uint32(1)
+	require.Equal(t, []*Limits{{Min: 1, Max: &tableMax}}, module.Tables)
+	require.Equal(t, []*Limits{{Min: 2}}, module.Memories)
+	require.False(t, module.ImportedMemory)
+	require.Equal(t, uint64(len("COUNTER\x00")), module.DataSegmentsSize)
+}
+
+func TestParse_PassiveDataSegment(t *testing.T) {
+	t.Parallel()
+
+	module, err := Parse(readTestContract(t, "forbidden-opcodes/data-drop/output/data-drop.wasm"))
+	require.Nil(t, err)
+	require.Equal(t, uint64(len("ok")), module.DataSegmentsSize)
+	require.Equal(t, []string{"main"}, module.ExportedFunctions)
+}
+
+func TestParse_InvalidCode(t *testing.T) {
+	t.Parallel()
+
+	module, err := Parse([]byte("not wasm"))
+	require.Nil(t, module)
+	require.Equal(t, ErrInvalidWasmHeader, err)
+
+	code := readTestContract(t, "counter/output/counter.wasm")
+	module, err = Parse(code[:len(code)-1])
+	require.Nil(t, module)
+	require.ErrorIs(t, err, ErrUnexpectedEndOfCode)
+}
@@ -0,0 +1,154 @@
+package wasmmodule
+
+// Reader decodes the primitive values of the WASM binary format
+type Reader struct {
+	data   []byte
+	offset int
+}
+
+// NewReader creates a Reader positioned at the start of the data
+func NewReader(data []byte) *Reader {
+	return &Reader{data: data}
+}
+
+// IsAtEnd returns true if all the data was read
+func (reader *Reader) IsAtEnd() bool {
+	return reader.offset >= len(reader.data)
+}
+
+// PeekByte returns the next byte without advancing
+func (reader *Reader) PeekByte() (byte, error) {
+	if reader.IsAtEnd() {
+		return 0, ErrUnexpectedEndOfCode
+	}
+	return reader.data[reader.offset], nil
+}
+
+// ReadByte reads one byte
+func (reader *Reader) ReadByte() (byte, error) {
+	b, err := reader.PeekByte()
+	if err != nil {
+		return 0, err
+	}
+	reader.offset++
+	return b, nil
+}
+
+// ReadBytes reads the given number of bytes
+func (reader *Reader) ReadBytes(length uint32) ([]byte, error) {
+	if uint64(reader.offset)+uint64(length) > uint64(len(reader.data)) {
+		return nil, ErrUnexpectedEndOfCode
+	}
+	bytes := reader.data[reader.offset : reader.offset+int(length)]
+	reader.offset += int(length)
+	return bytes, nil
+}
+
+// Skip advances over the given number of bytes
+func (reader *Reader) Skip(length uint32) error {
+	_, err := reader.ReadBytes(length)
+	return err
+}
+
+// ReadU32 reads an unsigned LEB128 integer of at most 32 bits
+func (reader *Reader) ReadU32() (uint32, error) {
+	var result uint64
+	for shift := uint(0); ; shift += 7 {
+		if shift >= 35 {
+			return 0, ErrLEB128Overflow
+		}
+		b, err := reader.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		result |= uint64(b&0x7f) << shift
+		if b&0x80 == 0 {
+			break
+		}
+	}
+	if result > 0xffffffff {
+		return 0, ErrLEB128Overflow
+	}
+	return uint32(result), nil
+}
+
+// SkipSigned skips a signed LEB128 integer of at most maxBits bits
+func (reader *Reader) SkipSigned(maxBits uint) error {
+	maxBytes := (maxBits + 6) / 7
+	for i := uint(0); i < maxBytes; i++ {
+		b, err := reader.ReadByte()
+		if err != nil {
+			return err
+		}
+		if b&0x80 == 0 {
+			return nil
+		}
+	}
+	return ErrLEB128Overflow
+}
+
+// ReadName reads a length-prefixed UTF-8 name
+func (reader *Reader) ReadName() (string, error) {
+	length, err := reader.ReadU32()
+	if err != nil {
+		return "", err
+	}
+	bytes, err := reader.ReadBytes(length)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
+
+func (reader *Reader) readLimits() (*Limits, error) {
+	flags, err := reader.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	min, err := reader.ReadU32()
+	if err != nil {
+		return nil, err
+	}
+
+	limits := &Limits{Min: min}
+	if flags&0x01 != 0 {
+		max, errMax := reader.ReadU32()
+		if errMax != nil {
+			return nil, errMax
+		}
+		limits.Max = &max
+	}
+	return limits, nil
+}
+
+// skipConstantExpression skips an initializer expression, up to its end opcode
+func (reader *Reader) skipConstantExpression() error {
+	for {
+		opcode, err := reader.ReadByte()
+		if err != nil {
+			return err
+		}
+
+		switch opcode {
+		case 0x0B:
+			return nil
+		case 0x23, 0xD2:
+			_, err = reader.ReadU32()
+		case 0x41:
+			err = reader.SkipSigned(32)
+		case 0x42:
+			err = reader.SkipSigned(64)
+		case 0x43:
+			err = reader.Skip(4)
+		case 0x44:
+			err = reader.Skip(8)
+		case 0xD0:
+			_, err = reader.ReadByte()
+		default:
+			return ErrInvalidConstantExpression
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -110,6 +110,7 @@ package wasmer
 // extern void      v1_5_managedGetESDTTokenData(void* context, int32_t addressHandle, int32_t tokenIDHandle, long long nonce, int32_t valueHandle, int32_t propertiesHandle, int32_t hashHandle, int32_t nameHandle, int32_t attributesHandle, int32_t creatorHandle, int32_t royaltiesHandle, int32_t urisHandle);
 // extern void      v1_5_managedAsyncCall(void* context, int32_t destHandle, int32_t valueHandle, int32_t functionHandle, int32_t argumentsHandle);
 // extern int32_t   v1_5_managedCreateAsyncCall(void* context, int32_t destHandle, int32_t valueHandle, int32_t functionHandle, int32_t argumentsHandle, int32_t successOffset, int32_t successLength, int32_t errorOffset, int32_t errorLength, long long gas, long long extraGasForCallback, int32_t callbackClosureHandle);
+// extern int32_t   v1_5_managedCreateAsyncCallWithDeadline(void* context, int32_t destHandle, int32_t valueHandle, int32_t functionHandle, int32_t argumentsHandle, int32_t successOffset, int32_t successLength, int32_t errorOffset, int32_t errorLength, long long gas, long long extraGasForCallback, int32_t callbackClosureHandle, long long deadlineRound, long long deadlineTimestamp);
 // extern void      v1_5_managedGetCallbackClosure(void* context, int32_t callbackClosureHandle);
 // extern void      v1_5_managedUpgradeFromSourceContract(void* context, int32_t destHandle, long long gas, int32_t valueHandle, int32_t addressHandle, int32_t codeMetadataHandle, int32_t argumentsHandle, int32_t resultHandle);
 // extern void      v1_5_managedUpgradeContract(void* context, int32_t destHandle, long long gas, int32_t valueHandle, int32_t codeHandle, int32_t codeMetadataHandle, int32_t argumentsHandle, int32_t resultHandle);
@@ -128,6 +129,13 @@ package wasmer
 // extern void      v1_5_managedBufferToHex(void* context, int32_t sourceHandle, int32_t destHandle);
 // extern void      v1_5_managedGetCodeMetadata(void* context, int32_t addressHandle, int32_t responseHandle);
 // extern int32_t   v1_5_managedIsBuiltinFunction(void* context, int32_t functionNameHandle);
+// extern void      v1_5_managedUpdateNFTAttributes(void* context, int32_t tokenIDHandle, long long nonce, int32_t attributesHandle);
+// extern void      v1_5_managedAddNFTURIs(void* context, int32_t tokenIDHandle, long long nonce, int32_t urisHandle);
+// extern void      v1_5_managedModifyNFTRoyalties(void* context, int32_t tokenIDHandle, long long nonce, long long royalties);
+// extern void      v1_5_managedGetCodeHash(void* context, int32_t addressHandle, int32_t resultHandle);
+// extern long long v1_5_managedGetCodeMetadataFlags(void* context, int32_t addressHandle);
+// extern long long v1_5_managedGetAccountNonce(void* context, int32_t addressHandle);
+// extern void      v1_5_managedGetContractOwner(void* context, int32_t addressHandle, int32_t resultHandle);
 // extern int32_t   v1_5_bigFloatNewFromParts(void* context, int32_t integralPart, int32_t fractionalPart, int32_t exponent);
 // extern int32_t   v1_5_bigFloatNewFromFrac(void* context, long long numerator, long long denominator);
 // extern int32_t   v1_5_bigFloatNewFromSci(void* context, long long significand, long long exponent);
@@ -211,6 +219,9 @@ package wasmer
 // extern int32_t   v1_5_mBufferFromBigFloat(void* context, int32_t mBufferHandle, int32_t bigFloatHandle);
 // extern int32_t   v1_5_mBufferStorageStore(void* context, int32_t keyHandle, int32_t sourceHandle);
 // extern int32_t   v1_5_mBufferStorageLoad(void* context, int32_t keyHandle, int32_t destinationHandle);
+// extern int32_t   v1_5_mBufferStorageIterateKeys(void* context, int32_t prefixHandle, int32_t cursorHandle, int32_t maxKeys, int32_t keysHandle);
+// extern int32_t   v1_5_mBufferTransientStore(void* context, int32_t keyHandle, int32_t sourceHandle);
+// extern int32_t   v1_5_mBufferTransientLoad(void* context, int32_t keyHandle, int32_t destinationHandle);
 // extern void      v1_5_mBufferStorageLoadFromAddress(void* context, int32_t addressHandle, int32_t keyHandle, int32_t destinationHandle);
 // extern int32_t   v1_5_mBufferGetArgument(void* context, int32_t id, int32_t destinationHandle);
 // extern int32_t   v1_5_mBufferFinish(void* context, int32_t sourceHandle);
@@ -779,6 +790,11 @@ func populateWasmerImports(imports *wasmerImports) error {
 		return err
 	}
 
+	err = imports.append("managedCreateAsyncCallWithDeadline", v1_5_managedCreateAsyncCallWithDeadline, C.v1_5_managedCreateAsyncCallWithDeadline)
+	if err != nil {
+		return err
+	}
+
 	err = imports.append("managedGetCallbackClosure", v1_5_managedGetCallbackClosure, C.v1_5_managedGetCallbackClosure)
 	if err != nil {
 		return err
@@ -869,6 +885,41 @@ func populateWasmerImports(imports *wasmerImports) error {
 		return err
 	}
 
+	err = imports.append("managedUpdateNFTAttributes", v1_5_managedUpdateNFTAttributes, C.v1_5_managedUpdateNFTAttributes)
+	if err != nil {
+		return err
+	}
+
+	err = imports.append("managedAddNFTURIs", v1_5_managedAddNFTURIs, C.v1_5_managedAddNFTURIs)
+	if err != nil {
+		return err
+	}
+
+	err = imports.append("managedModifyNFTRoyalties", v1_5_managedModifyNFTRoyalties, C.v1_5_managedModifyNFTRoyalties)
+	if err != nil {
+		return err
+	}
+
+	err = imports.append("managedGetCodeHash", v1_5_managedGetCodeHash, C.v1_5_managedGetCodeHash)
+	if err != nil {
+		return err
+	}
+
+	err = imports.append("managedGetCodeMetadataFlags", v1_5_managedGetCodeMetadataFlags, C.v1_5_managedGetCodeMetadataFlags)
+	if err != nil {
+		return err
+	}
+
+	err = imports.append("managedGetAccountNonce", v1_5_managedGetAccountNonce, C.v1_5_managedGetAccountNonce)
+	if err != nil {
+		return err
+	}
+
+	err = imports.append("managedGetContractOwner", v1_5_managedGetContractOwner, C.v1_5_managedGetContractOwner)
+	if err != nil {
+		return err
+	}
+
 	err = imports.append("bigFloatNewFromParts", v1_5_bigFloatNewFromParts, C.v1_5_bigFloatNewFromParts)
 	if err != nil {
 		return err
@@ -1284,6 +1335,21 @@ func populateWasmerImports(imports *wasmerImports) error {
 		return err
 	}
 
+	err = imports.append("mBufferStorageIterateKeys", v1_5_mBufferStorageIterateKeys, C.v1_5_mBufferStorageIterateKeys)
+	if err != nil {
+		return err
+	}
+
+	err = imports.append("mBufferTransientStore", v1_5_mBufferTransientStore, C.v1_5_mBufferTransientStore)
+	if err != nil {
+		return err
+	}
+
+	err = imports.append("mBufferTransientLoad", v1_5_mBufferTransientLoad, C.v1_5_mBufferTransientLoad)
+	if err != nil {
+		return err
+	}
+
 	err = imports.append("mBufferStorageLoadFromAddress", v1_5_mBufferStorageLoadFromAddress, C.v1_5_mBufferStorageLoadFromAddress)
 	if err != nil {
 		return err
@@ -2191,6 +2257,12 @@ func v1_5_managedCreateAsyncCall(context unsafe.Pointer, destHandle int32, value
 	return vmHooks.ManagedCreateAsyncCall(destHandle, valueHandle, functionHandle, argumentsHandle, executor.MemPtr(successOffset), successLength, executor.MemPtr(errorOffset), errorLength, gas, extraGasForCallback, callbackClosureHandle)
 }
 
+//export v1_5_managedCreateAsyncCallWithDeadline
+func v1_5_managedCreateAsyncCallWithDeadline(context unsafe.Pointer, destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, successOffset int32, successLength int32, errorOffset int32, errorLength int32, gas int64, extraGasForCallback int64, callbackClosureHandle int32, deadlineRound int64, deadlineTimestamp int64) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedCreateAsyncCallWithDeadline(destHandle, valueHandle, functionHandle, argumentsHandle, executor.MemPtr(successOffset), successLength, executor.MemPtr(errorOffset), errorLength, gas, extraGasForCallback, callbackClosureHandle, deadlineRound, deadlineTimestamp)
+}
+
 //export v1_5_managedGetCallbackClosure
 func v1_5_managedGetCallbackClosure(context unsafe.Pointer, callbackClosureHandle int32) {
 	vmHooks := getVMHooksFromContextRawPtr(context)
@@ -2299,6 +2371,48 @@ func v1_5_managedIsBuiltinFunction(context unsafe.Pointer, functionNameHandle in
 	return vmHooks.ManagedIsBuiltinFunction(functionNameHandle)
 }
 
+//export v1_5_managedUpdateNFTAttributes
+func v1_5_managedUpdateNFTAttributes(context unsafe.Pointer, tokenIDHandle int32, nonce int64, attributesHandle int32) {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	vmHooks.ManagedUpdateNFTAttributes(tokenIDHandle, nonce, attributesHandle)
+}
+
+//export v1_5_managedAddNFTURIs
+func v1_5_managedAddNFTURIs(context unsafe.Pointer, tokenIDHandle int32, nonce int64, urisHandle int32) {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	vmHooks.ManagedAddNFTURIs(tokenIDHandle, nonce, urisHandle)
+}
+
+//export v1_5_managedModifyNFTRoyalties
+func v1_5_managedModifyNFTRoyalties(context unsafe.Pointer, tokenIDHandle int32, nonce int64, royalties int64) {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	vmHooks.ManagedModifyNFTRoyalties(tokenIDHandle, nonce, royalties)
+}
+
+//export v1_5_managedGetCodeHash
+func v1_5_managedGetCodeHash(context unsafe.Pointer, addressHandle int32, resultHandle int32) {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	vmHooks.ManagedGetCodeHash(addressHandle, resultHandle)
+}
+
+//export v1_5_managedGetCodeMetadataFlags
+func v1_5_managedGetCodeMetadataFlags(context unsafe.Pointer, addressHandle int32) int64 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedGetCodeMetadataFlags(addressHandle)
+}
+
+//export v1_5_managedGetAccountNonce
+func v1_5_managedGetAccountNonce(context unsafe.Pointer, addressHandle int32) int64 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedGetAccountNonce(addressHandle)
+}
+
+//export v1_5_managedGetContractOwner
+func v1_5_managedGetContractOwner(context unsafe.Pointer, addressHandle int32, resultHandle int32) {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	vmHooks.ManagedGetContractOwner(addressHandle, resultHandle)
+}
+
 //export v1_5_bigFloatNewFromParts
 func v1_5_bigFloatNewFromParts(context unsafe.Pointer, integralPart int32, fractionalPart int32, exponent int32) int32 {
 	vmHooks := getVMHooksFromContextRawPtr(context)
@@ -2797,6 +2911,24 @@ func v1_5_mBufferStorageLoad(context unsafe.Pointer, keyHandle int32, destinatio
 	return vmHooks.MBufferStorageLoad(keyHandle, destinationHandle)
 }
 
+//export v1_5_mBufferStorageIterateKeys
+func v1_5_mBufferStorageIterateKeys(context unsafe.Pointer, prefixHandle int32, cursorHandle int32, maxKeys int32, keysHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MBufferStorageIterateKeys(prefixHandle, cursorHandle, maxKeys, keysHandle)
+}
+
+//export v1_5_mBufferTransientStore
+func v1_5_mBufferTransientStore(context unsafe.Pointer, keyHandle int32, sourceHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MBufferTransientStore(keyHandle, sourceHandle)
+}
+
+//export v1_5_mBufferTransientLoad
+func v1_5_mBufferTransientLoad(context unsafe.Pointer, keyHandle int32, destinationHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MBufferTransientLoad(keyHandle, destinationHandle)
+}
+
 //export v1_5_mBufferStorageLoadFromAddress
 func v1_5_mBufferStorageLoadFromAddress(context unsafe.Pointer, addressHandle int32, keyHandle int32, destinationHandle int32) {
 	vmHooks := getVMHooksFromContextRawPtr(context)
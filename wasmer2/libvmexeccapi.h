@@ -300,6 +300,17 @@ typedef struct {
   int32_t (*managed_verify_secp256r1_func_ptr)(void *context, int32_t key_handle, int32_t message_handle, int32_t sig_handle);
   int32_t (*managed_verify_blssignature_share_func_ptr)(void *context, int32_t key_handle, int32_t message_handle, int32_t sig_handle);
   int32_t (*managed_verify_blsaggregated_signature_func_ptr)(void *context, int32_t key_handle, int32_t message_handle, int32_t sig_handle);
+  int32_t (*managed_create_async_call_with_deadline_func_ptr)(void *context, int32_t dest_handle, int32_t value_handle, int32_t function_handle, int32_t arguments_handle, int32_t success_offset, int32_t success_length, int32_t error_offset, int32_t error_length, int64_t gas, int64_t extra_gas_for_callback, int32_t callback_closure_handle, int64_t deadline_round, int64_t deadline_timestamp);
+  int32_t (*mbuffer_storage_iterate_keys_func_ptr)(void *context, int32_t prefix_handle, int32_t cursor_handle, int32_t max_keys, int32_t keys_handle);
+  int32_t (*mbuffer_transient_store_func_ptr)(void *context, int32_t key_handle, int32_t source_handle);
+  int32_t (*mbuffer_transient_load_func_ptr)(void *context, int32_t key_handle, int32_t destination_handle);
+  void (*managed_update_nft_attributes_func_ptr)(void *context, int32_t token_id_handle, int64_t nonce, int32_t attributes_handle);
+  void (*managed_add_nft_uris_func_ptr)(void *context, int32_t token_id_handle, int64_t nonce, int32_t uris_handle);
+  void (*managed_modify_nft_royalties_func_ptr)(void *context, int32_t token_id_handle, int64_t nonce, int64_t royalties);
+  void (*managed_get_code_hash_func_ptr)(void *context, int32_t address_handle, int32_t result_handle);
+  int64_t (*managed_get_code_metadata_flags_func_ptr)(void *context, int32_t address_handle);
+  int64_t (*managed_get_account_nonce_func_ptr)(void *context, int32_t address_handle);
+  void (*managed_get_contract_owner_func_ptr)(void *context, int32_t address_handle, int32_t result_handle);
 } vm_exec_vm_hook_c_func_pointers;
 
 typedef struct {
@@ -110,6 +110,7 @@ package wasmer2
 // extern void      w2_managedGetESDTTokenData(void* context, int32_t addressHandle, int32_t tokenIDHandle, long long nonce, int32_t valueHandle, int32_t propertiesHandle, int32_t hashHandle, int32_t nameHandle, int32_t attributesHandle, int32_t creatorHandle, int32_t royaltiesHandle, int32_t urisHandle);
 // extern void      w2_managedAsyncCall(void* context, int32_t destHandle, int32_t valueHandle, int32_t functionHandle, int32_t argumentsHandle);
 // extern int32_t   w2_managedCreateAsyncCall(void* context, int32_t destHandle, int32_t valueHandle, int32_t functionHandle, int32_t argumentsHandle, int32_t successOffset, int32_t successLength, int32_t errorOffset, int32_t errorLength, long long gas, long long extraGasForCallback, int32_t callbackClosureHandle);
+// extern int32_t   w2_managedCreateAsyncCallWithDeadline(void* context, int32_t destHandle, int32_t valueHandle, int32_t functionHandle, int32_t argumentsHandle, int32_t successOffset, int32_t successLength, int32_t errorOffset, int32_t errorLength, long long gas, long long extraGasForCallback, int32_t callbackClosureHandle, long long deadlineRound, long long deadlineTimestamp);
 // extern void      w2_managedGetCallbackClosure(void* context, int32_t callbackClosureHandle);
 // extern void      w2_managedUpgradeFromSourceContract(void* context, int32_t destHandle, long long gas, int32_t valueHandle, int32_t addressHandle, int32_t codeMetadataHandle, int32_t argumentsHandle, int32_t resultHandle);
 // extern void      w2_managedUpgradeContract(void* context, int32_t destHandle, long long gas, int32_t valueHandle, int32_t codeHandle, int32_t codeMetadataHandle, int32_t argumentsHandle, int32_t resultHandle);
@@ -128,6 +129,13 @@ package wasmer2
 // extern void      w2_managedBufferToHex(void* context, int32_t sourceHandle, int32_t destHandle);
 // extern void      w2_managedGetCodeMetadata(void* context, int32_t addressHandle, int32_t responseHandle);
 // extern int32_t   w2_managedIsBuiltinFunction(void* context, int32_t functionNameHandle);
+// extern void      w2_managedUpdateNFTAttributes(void* context, int32_t tokenIDHandle, long long nonce, int32_t attributesHandle);
+// extern void      w2_managedAddNFTURIs(void* context, int32_t tokenIDHandle, long long nonce, int32_t urisHandle);
+// extern void      w2_managedModifyNFTRoyalties(void* context, int32_t tokenIDHandle, long long nonce, long long royalties);
+// extern void      w2_managedGetCodeHash(void* context, int32_t addressHandle, int32_t resultHandle);
+// extern long long w2_managedGetCodeMetadataFlags(void* context, int32_t addressHandle);
+// extern long long w2_managedGetAccountNonce(void* context, int32_t addressHandle);
+// extern void      w2_managedGetContractOwner(void* context, int32_t addressHandle, int32_t resultHandle);
 // extern int32_t   w2_bigFloatNewFromParts(void* context, int32_t integralPart, int32_t fractionalPart, int32_t exponent);
 // extern int32_t   w2_bigFloatNewFromFrac(void* context, long long numerator, long long denominator);
 // extern int32_t   w2_bigFloatNewFromSci(void* context, long long significand, long long exponent);
@@ -211,6 +219,9 @@ package wasmer2
 // extern int32_t   w2_mBufferFromBigFloat(void* context, int32_t mBufferHandle, int32_t bigFloatHandle);
 // extern int32_t   w2_mBufferStorageStore(void* context, int32_t keyHandle, int32_t sourceHandle);
 // extern int32_t   w2_mBufferStorageLoad(void* context, int32_t keyHandle, int32_t destinationHandle);
+// extern int32_t   w2_mBufferStorageIterateKeys(void* context, int32_t prefixHandle, int32_t cursorHandle, int32_t maxKeys, int32_t keysHandle);
+// extern int32_t   w2_mBufferTransientStore(void* context, int32_t keyHandle, int32_t sourceHandle);
+// extern int32_t   w2_mBufferTransientLoad(void* context, int32_t keyHandle, int32_t destinationHandle);
 // extern void      w2_mBufferStorageLoadFromAddress(void* context, int32_t addressHandle, int32_t keyHandle, int32_t destinationHandle);
 // extern int32_t   w2_mBufferGetArgument(void* context, int32_t id, int32_t destinationHandle);
 // extern int32_t   w2_mBufferFinish(void* context, int32_t sourceHandle);
@@ -383,6 +394,7 @@ func populateCgoFunctionPointers() *cWasmerVmHookPointers {
 		managed_get_esdt_token_data_func_ptr:                     funcPointer(C.w2_managedGetESDTTokenData),
 		managed_async_call_func_ptr:                              funcPointer(C.w2_managedAsyncCall),
 		managed_create_async_call_func_ptr:                       funcPointer(C.w2_managedCreateAsyncCall),
+		managed_create_async_call_with_deadline_func_ptr:         funcPointer(C.w2_managedCreateAsyncCallWithDeadline),
 		managed_get_callback_closure_func_ptr:                    funcPointer(C.w2_managedGetCallbackClosure),
 		managed_upgrade_from_source_contract_func_ptr:            funcPointer(C.w2_managedUpgradeFromSourceContract),
 		managed_upgrade_contract_func_ptr:                        funcPointer(C.w2_managedUpgradeContract),
@@ -401,6 +413,13 @@ func populateCgoFunctionPointers() *cWasmerVmHookPointers {
 		managed_buffer_to_hex_func_ptr:                           funcPointer(C.w2_managedBufferToHex),
 		managed_get_code_metadata_func_ptr:                       funcPointer(C.w2_managedGetCodeMetadata),
 		managed_is_builtin_function_func_ptr:                     funcPointer(C.w2_managedIsBuiltinFunction),
+		managed_update_nft_attributes_func_ptr:                   funcPointer(C.w2_managedUpdateNFTAttributes),
+		managed_add_nft_uris_func_ptr:                            funcPointer(C.w2_managedAddNFTURIs),
+		managed_modify_nft_royalties_func_ptr:                    funcPointer(C.w2_managedModifyNFTRoyalties),
+		managed_get_code_hash_func_ptr:                           funcPointer(C.w2_managedGetCodeHash),
+		managed_get_code_metadata_flags_func_ptr:                 funcPointer(C.w2_managedGetCodeMetadataFlags),
+		managed_get_account_nonce_func_ptr:                       funcPointer(C.w2_managedGetAccountNonce),
+		managed_get_contract_owner_func_ptr:                      funcPointer(C.w2_managedGetContractOwner),
 		big_float_new_from_parts_func_ptr:                        funcPointer(C.w2_bigFloatNewFromParts),
 		big_float_new_from_frac_func_ptr:                         funcPointer(C.w2_bigFloatNewFromFrac),
 		big_float_new_from_sci_func_ptr:                          funcPointer(C.w2_bigFloatNewFromSci),
@@ -484,6 +503,9 @@ func populateCgoFunctionPointers() *cWasmerVmHookPointers {
 		mbuffer_from_big_float_func_ptr:                          funcPointer(C.w2_mBufferFromBigFloat),
 		mbuffer_storage_store_func_ptr:                           funcPointer(C.w2_mBufferStorageStore),
 		mbuffer_storage_load_func_ptr:                            funcPointer(C.w2_mBufferStorageLoad),
+		mbuffer_storage_iterate_keys_func_ptr:                    funcPointer(C.w2_mBufferStorageIterateKeys),
+		mbuffer_transient_store_func_ptr:                         funcPointer(C.w2_mBufferTransientStore),
+		mbuffer_transient_load_func_ptr:                          funcPointer(C.w2_mBufferTransientLoad),
 		mbuffer_storage_load_from_address_func_ptr:               funcPointer(C.w2_mBufferStorageLoadFromAddress),
 		mbuffer_get_argument_func_ptr:                            funcPointer(C.w2_mBufferGetArgument),
 		mbuffer_finish_func_ptr:                                  funcPointer(C.w2_mBufferFinish),
@@ -1143,6 +1165,12 @@ func w2_managedCreateAsyncCall(context unsafe.Pointer, destHandle int32, valueHa
 	return vmHooks.ManagedCreateAsyncCall(destHandle, valueHandle, functionHandle, argumentsHandle, executor.MemPtr(successOffset), successLength, executor.MemPtr(errorOffset), errorLength, gas, extraGasForCallback, callbackClosureHandle)
 }
 
+//export w2_managedCreateAsyncCallWithDeadline
+func w2_managedCreateAsyncCallWithDeadline(context unsafe.Pointer, destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, successOffset int32, successLength int32, errorOffset int32, errorLength int32, gas int64, extraGasForCallback int64, callbackClosureHandle int32, deadlineRound int64, deadlineTimestamp int64) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedCreateAsyncCallWithDeadline(destHandle, valueHandle, functionHandle, argumentsHandle, executor.MemPtr(successOffset), successLength, executor.MemPtr(errorOffset), errorLength, gas, extraGasForCallback, callbackClosureHandle, deadlineRound, deadlineTimestamp)
+}
+
 //export w2_managedGetCallbackClosure
 func w2_managedGetCallbackClosure(context unsafe.Pointer, callbackClosureHandle int32) {
 	vmHooks := getVMHooksFromContextRawPtr(context)
@@ -1251,6 +1279,48 @@ func w2_managedIsBuiltinFunction(context unsafe.Pointer, functionNameHandle int3
 	return vmHooks.ManagedIsBuiltinFunction(functionNameHandle)
 }
 
+//export w2_managedUpdateNFTAttributes
+func w2_managedUpdateNFTAttributes(context unsafe.Pointer, tokenIDHandle int32, nonce int64, attributesHandle int32) {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	vmHooks.ManagedUpdateNFTAttributes(tokenIDHandle, nonce, attributesHandle)
+}
+
+//export w2_managedAddNFTURIs
+func w2_managedAddNFTURIs(context unsafe.Pointer, tokenIDHandle int32, nonce int64, urisHandle int32) {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	vmHooks.ManagedAddNFTURIs(tokenIDHandle, nonce, urisHandle)
+}
+
+//export w2_managedModifyNFTRoyalties
+func w2_managedModifyNFTRoyalties(context unsafe.Pointer, tokenIDHandle int32, nonce int64, royalties int64) {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	vmHooks.ManagedModifyNFTRoyalties(tokenIDHandle, nonce, royalties)
+}
+
+//export w2_managedGetCodeHash
+func w2_managedGetCodeHash(context unsafe.Pointer, addressHandle int32, resultHandle int32) {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	vmHooks.ManagedGetCodeHash(addressHandle, resultHandle)
+}
+
+//export w2_managedGetCodeMetadataFlags
+func w2_managedGetCodeMetadataFlags(context unsafe.Pointer, addressHandle int32) int64 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedGetCodeMetadataFlags(addressHandle)
+}
+
+//export w2_managedGetAccountNonce
+func w2_managedGetAccountNonce(context unsafe.Pointer, addressHandle int32) int64 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedGetAccountNonce(addressHandle)
+}
+
+//export w2_managedGetContractOwner
+func w2_managedGetContractOwner(context unsafe.Pointer, addressHandle int32, resultHandle int32) {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	vmHooks.ManagedGetContractOwner(addressHandle, resultHandle)
+}
+
 //export w2_bigFloatNewFromParts
 func w2_bigFloatNewFromParts(context unsafe.Pointer, integralPart int32, fractionalPart int32, exponent int32) int32 {
 	vmHooks := getVMHooksFromContextRawPtr(context)
@@ -1749,6 +1819,24 @@ func w2_mBufferStorageLoad(context unsafe.Pointer, keyHandle int32, destinationH
 	return vmHooks.MBufferStorageLoad(keyHandle, destinationHandle)
 }
 
+//export w2_mBufferStorageIterateKeys
+func w2_mBufferStorageIterateKeys(context unsafe.Pointer, prefixHandle int32, cursorHandle int32, maxKeys int32, keysHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MBufferStorageIterateKeys(prefixHandle, cursorHandle, maxKeys, keysHandle)
+}
+
+//export w2_mBufferTransientStore
+func w2_mBufferTransientStore(context unsafe.Pointer, keyHandle int32, sourceHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MBufferTransientStore(keyHandle, sourceHandle)
+}
+
+//export w2_mBufferTransientLoad
+func w2_mBufferTransientLoad(context unsafe.Pointer, keyHandle int32, destinationHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MBufferTransientLoad(keyHandle, destinationHandle)
+}
+
 //export w2_mBufferStorageLoadFromAddress
 func w2_mBufferStorageLoadFromAddress(context unsafe.Pointer, addressHandle int32, keyHandle int32, destinationHandle int32) {
 	vmHooks := getVMHooksFromContextRawPtr(context)
@@ -108,6 +108,7 @@ var functionNames = map[string]struct{}{
 	"managedGetESDTTokenData":                  empty,
 	"managedAsyncCall":                         empty,
 	"managedCreateAsyncCall":                   empty,
+	"managedCreateAsyncCallWithDeadline":       empty,
 	"managedGetCallbackClosure":                empty,
 	"managedUpgradeFromSourceContract":         empty,
 	"managedUpgradeContract":                   empty,
@@ -126,6 +127,13 @@ var functionNames = map[string]struct{}{
 	"managedBufferToHex":                       empty,
 	"managedGetCodeMetadata":                   empty,
 	"managedIsBuiltinFunction":                 empty,
+	"managedUpdateNFTAttributes":               empty,
+	"managedAddNFTURIs":                        empty,
+	"managedModifyNFTRoyalties":                empty,
+	"managedGetCodeHash":                       empty,
+	"managedGetCodeMetadataFlags":              empty,
+	"managedGetAccountNonce":                   empty,
+	"managedGetContractOwner":                  empty,
 	"bigFloatNewFromParts":                     empty,
 	"bigFloatNewFromFrac":                      empty,
 	"bigFloatNewFromSci":                       empty,
@@ -209,6 +217,9 @@ var functionNames = map[string]struct{}{
 	"mBufferFromBigFloat":                      empty,
 	"mBufferStorageStore":                      empty,
 	"mBufferStorageLoad":                       empty,
+	"mBufferStorageIterateKeys":                empty,
+	"mBufferTransientStore":                    empty,
+	"mBufferTransientLoad":                     empty,
 	"mBufferStorageLoadFromAddress":            empty,
 	"mBufferGetArgument":                       empty,
 	"mBufferFinish":                            empty,
@@ -0,0 +1,12 @@
+package wasmreport
+
+import "errors"
+
+// ErrNilExecutor signals that a nil executor was provided
+var ErrNilExecutor = errors.New("nil executor")
+
+// ErrNilCodeValidator signals that a nil code validator was provided
+var ErrNilCodeValidator = errors.New("nil code validator")
+
+// ErrUnknownOpcode signals that a function body contains an opcode unknown to the decoder
+var ErrUnknownOpcode = errors.New("unknown opcode")
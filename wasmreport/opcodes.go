@@ -0,0 +1,275 @@
+package wasmreport
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-vm-go/vmhost/wasmmodule"
+)
+
+// Categories of the opcodes rejected by the VM, or allowed only within limits
+const (
+	CategoryFloatingPoint  = "floatingPoint"
+	CategoryBulkMemory     = "bulkMemory"
+	CategoryReferenceTypes = "referenceTypes"
+	CategorySIMD           = "simd"
+	CategoryMemoryGrow     = "memoryGrow"
+)
+
+const (
+	opcodeMemoryGrow   = 0x40
+	opcodeMiscPrefix   = 0xFC
+	opcodeVectorPrefix = 0xFD
+)
+
+type opcodeInfo struct {
+	name      string
+	category  string
+	forbidden bool
+}
+
+var floatUnaryAndBinaryOps = []string{"abs", "neg", "ceil", "floor", "trunc", "nearest", "sqrt", "add", "sub", "mul", "div", "min", "max", "copysign"}
+var floatComparisonOps = []string{"eq", "ne", "lt", "gt", "le", "ge"}
+
+// singleByteOpcodes holds the reported opcodes without prefix, all others being plain integer or control opcodes
+var singleByteOpcodes = makeSingleByteOpcodes()
+
+// miscOpcodes holds the opcodes with the 0xFC prefix, by their sub-opcode
+var miscOpcodes = map[uint32]*opcodeInfo{
+	0:  forbiddenOpcode("i32.trunc_sat_f32_s", CategoryFloatingPoint),
+	1:  forbiddenOpcode("i32.trunc_sat_f32_u", CategoryFloatingPoint),
+	2:  forbiddenOpcode("i32.trunc_sat_f64_s", CategoryFloatingPoint),
+	3:  forbiddenOpcode("i32.trunc_sat_f64_u", CategoryFloatingPoint),
+	4:  forbiddenOpcode("i64.trunc_sat_f32_s", CategoryFloatingPoint),
+	5:  forbiddenOpcode("i64.trunc_sat_f32_u", CategoryFloatingPoint),
+	6:  forbiddenOpcode("i64.trunc_sat_f64_s", CategoryFloatingPoint),
+	7:  forbiddenOpcode("i64.trunc_sat_f64_u", CategoryFloatingPoint),
+	8:  forbiddenOpcode("memory.init", CategoryBulkMemory),
+	9:  forbiddenOpcode("data.drop", CategoryBulkMemory),
+	10: forbiddenOpcode("memory.copy", CategoryBulkMemory),
+	11: forbiddenOpcode("memory.fill", CategoryBulkMemory),
+	12: forbiddenOpcode("table.init", CategoryBulkMemory),
+	13: forbiddenOpcode("elem.drop", CategoryBulkMemory),
+	14: forbiddenOpcode("table.copy", CategoryBulkMemory),
+	15: forbiddenOpcode("table.grow", CategoryReferenceTypes),
+	16: forbiddenOpcode("table.size", CategoryReferenceTypes),
+	17: forbiddenOpcode("table.fill", CategoryReferenceTypes),
+}
+
+var simdOpcode = forbiddenOpcode("v128.*", CategorySIMD)
+
+func forbiddenOpcode(name string, category string) *opcodeInfo {
+	return &opcodeInfo{name: name, category: category, forbidden: true}
+}
+
+func makeSingleByteOpcodes() map[byte]*opcodeInfo {
+	opcodes := map[byte]*opcodeInfo{
+		0x1C: forbiddenOpcode("select_t", CategoryReferenceTypes),
+		0x25: forbiddenOpcode("table.get", CategoryReferenceTypes),
+		0x26: forbiddenOpcode("table.set", CategoryReferenceTypes),
+		0xD0: forbiddenOpcode("ref.null", CategoryReferenceTypes),
+		0xD1: forbiddenOpcode("ref.is_null", CategoryReferenceTypes),
+		0xD2: forbiddenOpcode("ref.func", CategoryReferenceTypes),
+
+		opcodeMemoryGrow: {name: "memory.grow", category: CategoryMemoryGrow},
+	}
+
+	floatOpcodes := map[byte]string{
+		0x2A: "f32.load",
+		0x2B: "f64.load",
+		0x38: "f32.store",
+		0x39: "f64.store",
+		0x43: "f32.const",
+		0x44: "f64.const",
+		0xA8: "i32.trunc_f32_s",
+		0xA9: "i32.trunc_f32_u",
+		0xAA: "i32.trunc_f64_s",
+		0xAB: "i32.trunc_f64_u",
+		0xAE: "i64.trunc_f32_s",
+		0xAF: "i64.trunc_f32_u",
+		0xB0: "i64.trunc_f64_s",
+		0xB1: "i64.trunc_f64_u",
+		0xB2: "f32.convert_i32_s",
+		0xB3: "f32.convert_i32_u",
+		0xB4: "f32.convert_i64_s",
+		0xB5: "f32.convert_i64_u",
+		0xB6: "f32.demote_f64",
+		0xB7: "f64.convert_i32_s",
+		0xB8: "f64.convert_i32_u",
+		0xB9: "f64.convert_i64_s",
+		0xBA: "f64.convert_i64_u",
+		0xBB: "f64.promote_f32",
+		0xBC: "i32.reinterpret_f32",
+		0xBD: "i64.reinterpret_f64",
+		0xBE: "f32.reinterpret_i32",
+		0xBF: "f64.reinterpret_i64",
+	}
+	for i, op := range floatComparisonOps {
+		floatOpcodes[byte(0x5B+i)] = "f32." + op
+		floatOpcodes[byte(0x61+i)] = "f64." + op
+	}
+	for i, op := range floatUnaryAndBinaryOps {
+		floatOpcodes[byte(0x8B+i)] = "f32." + op
+		floatOpcodes[byte(0x99+i)] = "f64." + op
+	}
+
+	for opcode, name := range floatOpcodes {
+		opcodes[opcode] = forbiddenOpcode(name, CategoryFloatingPoint)
+	}
+	return opcodes
+}
+
+// skipImmediates advances the reader over the immediates of the opcode;
+// it returns the reported opcode, if any, and whether the rest of the body cannot be decoded
+func skipImmediates(reader *wasmmodule.Reader, opcode byte) (*opcodeInfo, bool, error) {
+	var err error
+	switch {
+	case opcode == 0x02 || opcode == 0x03 || opcode == 0x04:
+		err = skipBlockType(reader)
+	case opcode == 0x0C || opcode == 0x0D || opcode == 0x10 || opcode == 0xD2 ||
+		(opcode >= 0x20 && opcode <= 0x26):
+		_, err = reader.ReadU32()
+	case opcode == 0x0E:
+		err = skipU32Vector(reader, 1)
+	case opcode == 0x11 || (opcode >= 0x28 && opcode <= 0x3E):
+		err = skipU32s(reader, 2)
+	case opcode == 0x1C:
+		var numTypes uint32
+		numTypes, err = reader.ReadU32()
+		if err == nil {
+			err = reader.Skip(numTypes)
+		}
+	case opcode == 0x3F || opcode == opcodeMemoryGrow || opcode == 0xD0:
+		_, err = reader.ReadByte()
+	case opcode == 0x41:
+		err = reader.SkipSigned(32)
+	case opcode == 0x42:
+		err = reader.SkipSigned(64)
+	case opcode == 0x43:
+		err = reader.Skip(4)
+	case opcode == 0x44:
+		err = reader.Skip(8)
+	case opcode == opcodeMiscPrefix:
+		return skipMiscImmediates(reader)
+	case opcode == opcodeVectorPrefix:
+		// the SIMD immediates are not decoded, the rest of the body is skipped
+		return simdOpcode, true, nil
+	case opcode <= 0x01 || opcode == 0x05 || opcode == 0x0B || opcode == 0x0F ||
+		opcode == 0x1A || opcode == 0x1B || opcode == 0xD1 || (opcode >= 0x45 && opcode <= 0xC4):
+	default:
+		return nil, false, fmt.Errorf("%w: 0x%02X", ErrUnknownOpcode, opcode)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return singleByteOpcodes[opcode], false, nil
+}
+
+func skipMiscImmediates(reader *wasmmodule.Reader) (*opcodeInfo, bool, error) {
+	subOpcode, err := reader.ReadU32()
+	if err != nil {
+		return nil, false, err
+	}
+
+	switch subOpcode {
+	case 0, 1, 2, 3, 4, 5, 6, 7:
+	case 8:
+		_, err = reader.ReadU32()
+		if err == nil {
+			_, err = reader.ReadByte()
+		}
+	case 9, 13, 15, 16, 17:
+		_, err = reader.ReadU32()
+	case 10:
+		err = reader.Skip(2)
+	case 11:
+		_, err = reader.ReadByte()
+	case 12, 14:
+		err = skipU32s(reader, 2)
+	default:
+		return nil, false, fmt.Errorf("%w: 0xFC %d", ErrUnknownOpcode, subOpcode)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return miscOpcodes[subOpcode], false, nil
+}
+
+func skipBlockType(reader *wasmmodule.Reader) error {
+	blockType, err := reader.PeekByte()
+	if err != nil {
+		return err
+	}
+	switch blockType {
+	case 0x40, 0x7F, 0x7E, 0x7D, 0x7C, 0x7B, 0x70, 0x6F:
+		_, err = reader.ReadByte()
+		return err
+	default:
+		return reader.SkipSigned(33)
+	}
+}
+
+func skipU32s(reader *wasmmodule.Reader, count int) error {
+	for i := 0; i < count; i++ {
+		_, err := reader.ReadU32()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// skipU32Vector skips a vector of u32 values, followed by the given number of extra values
+func skipU32Vector(reader *wasmmodule.Reader, extra int) error {
+	length, err := reader.ReadU32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < length; i++ {
+		_, err = reader.ReadU32()
+		if err != nil {
+			return err
+		}
+	}
+	return skipU32s(reader, extra)
+}
+
+// scanFunctionBody counts the reported opcodes of the function body
+func scanFunctionBody(body []byte, opcodes map[*opcodeInfo]int) error {
+	reader := wasmmodule.NewReader(body)
+	numLocalDeclarations, err := reader.ReadU32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < numLocalDeclarations; i++ {
+		_, err = reader.ReadU32()
+		if err != nil {
+			return err
+		}
+		_, err = reader.ReadByte()
+		if err != nil {
+			return err
+		}
+	}
+
+	for !reader.IsAtEnd() {
+		opcode, err := reader.ReadByte()
+		if err != nil {
+			return err
+		}
+
+		info, skipBody, err := skipImmediates(reader, opcode)
+		if err != nil {
+			return err
+		}
+		if info != nil {
+			opcodes[info]++
+		}
+		if skipBody {
+			return nil
+		}
+	}
+
+	return nil
+}
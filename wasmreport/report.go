@@ -0,0 +1,244 @@
+// Package wasmreport produces a static analysis report of contract bytecode, before deployment.
+package wasmreport
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/contexts"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/wasmmodule"
+)
+
+// Report describes what a contract imports and exports, and whether the VM accepts it
+type Report struct {
+	CodeSize          int                  `json:"codeSize"`
+	Valid             bool                 `json:"valid"`
+	ValidationErrors  []string             `json:"validationErrors"`
+	ImportedVMHooks   map[string][]string  `json:"importedVMHooks"`
+	GatedVMHooks      []*GatedVMHook       `json:"gatedVMHooks"`
+	UnknownImports    []*wasmmodule.Import `json:"unknownImports"`
+	Endpoints         []*Endpoint          `json:"endpoints"`
+	Memory            *MemoryReport        `json:"memory"`
+	ForbiddenOpcodes  []*OpcodeUsage       `json:"forbiddenOpcodes"`
+	RestrictedOpcodes []*OpcodeUsage       `json:"restrictedOpcodes"`
+}
+
+// GatedVMHook is an imported VM hook which requires an activation flag
+type GatedVMHook struct {
+	Name           string `json:"name"`
+	ActivationFlag string `json:"activationFlag"`
+}
+
+// Endpoint is an exported function, with the result of ValidateCallbackName for it
+type Endpoint struct {
+	Name          string `json:"name"`
+	ValidCallback bool   `json:"validCallback"`
+	CallbackError string `json:"callbackError,omitempty"`
+}
+
+// MemoryLimits holds the initial and maximum number of 64KiB pages of a memory
+type MemoryLimits struct {
+	MinPages uint32  `json:"minPages"`
+	MaxPages *uint32 `json:"maxPages,omitempty"`
+}
+
+// MemoryReport describes the memory configuration of the module
+type MemoryReport struct {
+	Declared            bool          `json:"declared"`
+	Imported            bool          `json:"imported"`
+	Limits              *MemoryLimits `json:"limits,omitempty"`
+	InstanceLengthBytes uint32        `json:"instanceLengthBytes"`
+}
+
+// OpcodeUsage counts the occurrences of a forbidden or restricted opcode in the function bodies
+type OpcodeUsage struct {
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Count    int    `json:"count"`
+}
+
+// ArgsNewAnalyzer holds the arguments needed to create an Analyzer
+type ArgsNewAnalyzer struct {
+	Executor           executor.Executor
+	CodeValidator      *contexts.CodeValidator
+	CompilationOptions executor.CompilationOptions
+}
+
+// Analyzer reports on contract bytecode, instantiating it with the executor
+type Analyzer struct {
+	executor           executor.Executor
+	codeValidator      *contexts.CodeValidator
+	compilationOptions executor.CompilationOptions
+	vmHooks            map[string]*executor.VMHookDescription
+}
+
+// NewAnalyzer creates a new Analyzer
+func NewAnalyzer(args ArgsNewAnalyzer) (*Analyzer, error) {
+	if check.IfNil(args.Executor) {
+		return nil, ErrNilExecutor
+	}
+	if args.CodeValidator == nil {
+		return nil, ErrNilCodeValidator
+	}
+
+	hookDescriptions, err := executor.LoadVMHooksAPIDescription()
+	if err != nil {
+		return nil, err
+	}
+	vmHooks := make(map[string]*executor.VMHookDescription, len(hookDescriptions))
+	for _, hook := range hookDescriptions {
+		vmHooks[hook.Name] = hook
+	}
+
+	return &Analyzer{
+		executor:           args.Executor,
+		codeValidator:      args.CodeValidator,
+		compilationOptions: args.CompilationOptions,
+		vmHooks:            vmHooks,
+	}, nil
+}
+
+// Analyze produces the report of the code; it only fails if the code cannot be decoded at all,
+// the reasons for which the VM rejects the code being part of the report
+func (analyzer *Analyzer) Analyze(code []byte) (*Report, error) {
+	module, err := wasmmodule.Parse(code)
+	if err != nil {
+		return nil, err
+	}
+	opcodes := make(map[*opcodeInfo]int)
+	for i, body := range module.FunctionBodies {
+		err = scanFunctionBody(body, opcodes)
+		if err != nil {
+			return nil, fmt.Errorf("function body %d: %w", i, err)
+		}
+	}
+
+	report := &Report{
+		CodeSize:          len(code),
+		ValidationErrors:  make([]string, 0),
+		ImportedVMHooks:   make(map[string][]string),
+		GatedVMHooks:      make([]*GatedVMHook, 0),
+		UnknownImports:    make([]*wasmmodule.Import, 0),
+		Endpoints:         make([]*Endpoint, 0),
+		ForbiddenOpcodes:  make([]*OpcodeUsage, 0),
+		RestrictedOpcodes: make([]*OpcodeUsage, 0),
+	}
+
+	instance, err := analyzer.executor.NewInstanceWithOptions(code, analyzer.compilationOptions)
+	if err != nil {
+		report.ValidationErrors = append(report.ValidationErrors, "instance creation: "+err.Error())
+		instance = nil
+	} else {
+		defer instance.Clean()
+		err = analyzer.codeValidator.VerifyInstance(instance)
+		if err != nil {
+			report.ValidationErrors = append(report.ValidationErrors, err.Error())
+		}
+	}
+
+	analyzer.reportImports(report, module, instance)
+	analyzer.reportEndpoints(report, module, instance)
+	reportMemory(report, module, instance)
+	reportOpcodes(report, opcodes)
+
+	report.Valid = len(report.ValidationErrors) == 0
+	return report, nil
+}
+
+func (analyzer *Analyzer) reportImports(report *Report, module *wasmmodule.Module, instance executor.Instance) {
+	for _, imp := range module.Imports {
+		hook, isHook := analyzer.vmHooks[imp.Name]
+		if !isHook || imp.Kind != "function" {
+			report.UnknownImports = append(report.UnknownImports, imp)
+			continue
+		}
+		if instance != nil && !instance.IsFunctionImported(imp.Name) {
+			continue
+		}
+
+		report.ImportedVMHooks[hook.Group] = append(report.ImportedVMHooks[hook.Group], hook.Name)
+		if len(hook.ActivationFlag) > 0 {
+			report.GatedVMHooks = append(report.GatedVMHooks, &GatedVMHook{
+				Name:           hook.Name,
+				ActivationFlag: hook.ActivationFlag,
+			})
+		}
+	}
+
+	for _, hooks := range report.ImportedVMHooks {
+		sort.Strings(hooks)
+	}
+}
+
+func (analyzer *Analyzer) reportEndpoints(report *Report, module *wasmmodule.Module, instance executor.Instance) {
+	functionNames := module.ExportedFunctions
+	hasFunction := func(functionName string) bool {
+		for _, name := range module.ExportedFunctions {
+			if name == functionName {
+				return true
+			}
+		}
+		return false
+	}
+	if instance != nil {
+		functionNames = instance.GetFunctionNames()
+		hasFunction = instance.HasFunction
+	}
+
+	sortedNames := append(make([]string, 0, len(functionNames)), functionNames...)
+	sort.Strings(sortedNames)
+	for _, name := range sortedNames {
+		endpoint := &Endpoint{Name: name, ValidCallback: true}
+		err := analyzer.codeValidator.ValidateCallbackName(name, hasFunction)
+		if err != nil {
+			endpoint.ValidCallback = false
+			endpoint.CallbackError = err.Error()
+		}
+		report.Endpoints = append(report.Endpoints, endpoint)
+	}
+}
+
+func reportMemory(report *Report, module *wasmmodule.Module, instance executor.Instance) {
+	report.Memory = &MemoryReport{
+		Declared: len(module.Memories) > 0,
+		Imported: module.ImportedMemory,
+	}
+	if len(module.Memories) > 0 {
+		report.Memory.Limits = &MemoryLimits{
+			MinPages: module.Memories[0].Min,
+			MaxPages: module.Memories[0].Max,
+		}
+	}
+	if instance != nil && instance.HasMemory() {
+		report.Memory.InstanceLengthBytes = instance.MemLength()
+	}
+}
+
+func reportOpcodes(report *Report, opcodes map[*opcodeInfo]int) {
+	for info, count := range opcodes {
+		usage := &OpcodeUsage{
+			Name:     info.name,
+			Category: info.category,
+			Count:    count,
+		}
+		if info.forbidden {
+			report.ForbiddenOpcodes = append(report.ForbiddenOpcodes, usage)
+		} else {
+			report.RestrictedOpcodes = append(report.RestrictedOpcodes, usage)
+		}
+	}
+
+	sortOpcodeUsages(report.ForbiddenOpcodes)
+	sortOpcodeUsages(report.RestrictedOpcodes)
+}
+
+func sortOpcodeUsages(usages []*OpcodeUsage) {
+	sort.Slice(usages, func(i, j int) bool {
+		if usages[i].Category != usages[j].Category {
+			return usages[i].Category < usages[j].Category
+		}
+		return usages[i].Name < usages[j].Name
+	})
+}
@@ -0,0 +1,226 @@
+package wasmreport
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-vm-common-go/builtInFunctions"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	contextmock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/contexts"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/mock"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/wasmmodule"
+	"github.com/stretchr/testify/require"
+)
+
+var errCompilation = errors.New("compilation failed")
+
+type instanceStub struct {
+	executor.Instance
+	functionNames []string
+	imports       map[string]bool
+}
+
+func (stub *instanceStub) GetFunctionNames() []string {
+	return stub.functionNames
+}
+
+func (stub *instanceStub) HasFunction(functionName string) bool {
+	for _, name := range stub.functionNames {
+		if name == functionName {
+			return true
+		}
+	}
+	return false
+}
+
+func (stub *instanceStub) IsFunctionImported(name string) bool {
+	return stub.imports[name]
+}
+
+func (stub *instanceStub) ValidateFunctionArities() error {
+	return nil
+}
+
+func (stub *instanceStub) HasMemory() bool {
+	return true
+}
+
+func (stub *instanceStub) MemLength() uint32 {
+	return 2 * 65536
+}
+
+func (stub *instanceStub) Clean() bool {
+	return true
+}
+
+// executorStub only instantiates the code it knows, and fails to compile anything else
+type executorStub struct {
+	executor.Executor
+	instances map[string]executor.Instance
+}
+
+func (stub *executorStub) NewInstanceWithOptions(code []byte, _ executor.CompilationOptions) (executor.Instance, error) {
+	instance, ok := stub.instances[string(code)]
+	if !ok {
+		return nil, errCompilation
+	}
+	return instance, nil
+}
+
+func (stub *executorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func newTestAnalyzer(t *testing.T, instances map[string]executor.Instance) *Analyzer {
+	builtInFuncContainer := builtInFunctions.NewBuiltInFunctionContainer()
+	_ = builtInFuncContainer.Add("ESDTTransfer", &mock.BuiltInFunctionStub{})
+	codeValidator, err := contexts.NewCodeValidator(contextmock.NewExecutorMock(nil).FunctionNames(), builtInFuncContainer)
+	require.Nil(t, err)
+
+	analyzer, err := NewAnalyzer(ArgsNewAnalyzer{
+		Executor:      &executorStub{instances: instances},
+		CodeValidator: codeValidator,
+	})
+	require.Nil(t, err)
+	return analyzer
+}
+
+func readTestContract(t *testing.T, path string) []byte {
+	code, err := os.ReadFile(filepath.Join("../test/contracts", path))
+	require.Nil(t, err)
+	return code
+}
+
+// moduleWithImports builds a module importing the functions of type () -> () from "env"
+func moduleWithImports(names ...string) []byte {
+	typeSection := []byte{0x01, 0x04, 0x01, 0x60, 0x00, 0x00}
+
+	importContent := []byte{byte(len(names))}
+	for _, name := range names {
+		importContent = append(importContent, 0x03, 'e', 'n', 'v', byte(len(name)))
+		importContent = append(importContent, name...)
+		importContent = append(importContent, wasmmodule.ExternalKindFunction, 0x00)
+	}
+
+	module := append([]byte{}, wasmmodule.Header...)
+	module = append(module, typeSection...)
+	module = append(module, 0x02, byte(len(importContent)))
+	return append(module, importContent...)
+}
+
+func TestNewAnalyzer(t *testing.T) {
+	t.Parallel()
+
+	analyzer, err := NewAnalyzer(ArgsNewAnalyzer{CodeValidator: &contexts.CodeValidator{}})
+	require.Nil(t, analyzer)
+	require.Equal(t, ErrNilExecutor, err)
+
+	analyzer, err = NewAnalyzer(ArgsNewAnalyzer{Executor: &executorStub{}})
+	require.Nil(t, analyzer)
+	require.Equal(t, ErrNilCodeValidator, err)
+}
+
+func TestAnalyzer_ValidContract(t *testing.T) {
+	t.Parallel()
+
+	code := readTestContract(t, "counter/output/counter.wasm")
+	analyzer := newTestAnalyzer(t, map[string]executor.Instance{
+		string(code): &instanceStub{
+			functionNames: []string{"init", "increment", "decrement", "get"},
+			imports:       map[string]bool{"int64storageStore": true, "int64storageLoad": true, "int64finish": true},
+		},
+	})
+
+	report, err := analyzer.Analyze(code)
+	require.Nil(t, err)
+	require.True(t, report.Valid)
+	require.Empty(t, report.ValidationErrors)
+	require.Equal(t, map[string][]string{"SmallInt": {"int64finish", "int64storageLoad", "int64storageStore"}}, report.ImportedVMHooks)
+	require.Empty(t, report.GatedVMHooks)
+	require.Empty(t, report.UnknownImports)
+	require.Equal(t, []*Endpoint{
+		{Name: "decrement", ValidCallback: true},
+		{Name: "get", ValidCallback: true},
+		{Name: "increment", ValidCallback: true},
+		{Name: "init", ValidCallback: false, CallbackError: vmhost.ErrInvalidFunctionName.Error()},
+	}, report.Endpoints)
+	require.Equal(t, &MemoryReport{
+		Declared:            true,
+		Limits:              &MemoryLimits{MinPages: 2},
+		InstanceLengthBytes: 2 * 65536,
+	}, report.Memory)
+	require.Empty(t, report.ForbiddenOpcodes)
+}
+
+func TestAnalyzer_ForbiddenOpcodes(t *testing.T) {
+	t.Parallel()
+
+	analyzer := newTestAnalyzer(t, nil)
+	expectedOpcodes := map[string]*OpcodeUsage{
+		"forbidden-opcodes/data-drop/output/data-drop.wasm":     {Name: "data.drop", Category: CategoryBulkMemory, Count: 1},
+		"forbidden-opcodes/memory-copy/output/memory-copy.wasm": {Name: "memory.copy", Category: CategoryBulkMemory, Count: 1},
+		"forbidden-opcodes/memory-fill/output/memory-fill.wasm": {Name: "memory.fill", Category: CategoryBulkMemory, Count: 1},
+		"forbidden-opcodes/memory-init/output/memory-init.wasm": {Name: "memory.init", Category: CategoryBulkMemory, Count: 1},
+		"forbidden-opcodes/simd/output/simd.wasm":               {Name: "v128.*", Category: CategorySIMD, Count: 1},
+	}
+
+	for path, expectedOpcode := range expectedOpcodes {
+		report, err := analyzer.Analyze(readTestContract(t, path))
+		require.Nil(t, err, path)
+		require.False(t, report.Valid, path)
+		require.Equal(t, []string{"instance creation: " + errCompilation.Error()}, report.ValidationErrors, path)
+		require.Contains(t, report.ForbiddenOpcodes, expectedOpcode, path)
+	}
+
+	report, err := analyzer.Analyze(readTestContract(t, "num-with-fp/output/num-with-fp.wasm"))
+	require.Nil(t, err)
+	require.NotEmpty(t, report.ForbiddenOpcodes)
+	for _, usage := range report.ForbiddenOpcodes {
+		require.Equal(t, CategoryFloatingPoint, usage.Category)
+	}
+}
+
+func TestAnalyzer_RestrictedOpcodes(t *testing.T) {
+	t.Parallel()
+
+	analyzer := newTestAnalyzer(t, nil)
+	report, err := analyzer.Analyze(readTestContract(t, "memgrow-wrong/output/memgrow-wrong.wasm"))
+	require.Nil(t, err)
+	require.Empty(t, report.ForbiddenOpcodes)
+	require.Len(t, report.RestrictedOpcodes, 1)
+	require.Equal(t, "memory.grow", report.RestrictedOpcodes[0].Name)
+}
+
+func TestAnalyzer_GatedAndUnknownImports(t *testing.T) {
+	t.Parallel()
+
+	analyzer := newTestAnalyzer(t, nil)
+	report, err := analyzer.Analyze(moduleWithImports("managedCreateAsyncCallWithDeadline", "bigIntAdd", "unknownHook"))
+	require.Nil(t, err)
+
+	require.Equal(t, map[string][]string{
+		"BigInt":  {"bigIntAdd"},
+		"Managed": {"managedCreateAsyncCallWithDeadline"},
+	}, report.ImportedVMHooks)
+	require.Equal(t, []*GatedVMHook{{Name: "managedCreateAsyncCallWithDeadline", ActivationFlag: "AsyncCallDeadlinesFlag"}}, report.GatedVMHooks)
+	require.Equal(t, []*wasmmodule.Import{{Module: "env", Name: "unknownHook", Kind: "function"}}, report.UnknownImports)
+	require.False(t, report.Memory.Declared)
+}
+
+func TestAnalyzer_InvalidCode(t *testing.T) {
+	t.Parallel()
+
+	analyzer := newTestAnalyzer(t, nil)
+	report, err := analyzer.Analyze([]byte("not wasm"))
+	require.Nil(t, report)
+	require.Equal(t, wasmmodule.ErrInvalidWasmHeader, err)
+
+	truncated := moduleWithImports("bigIntAdd")
+	report, err = analyzer.Analyze(truncated[:len(truncated)-2])
+	require.Nil(t, report)
+	require.ErrorIs(t, err, wasmmodule.ErrUnexpectedEndOfCode)
+}